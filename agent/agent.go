@@ -8,16 +8,20 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/Sirupsen/logrus"
 	"github.com/atlassian/gostatsd/pkg/statsd"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sensu/sensu-go/agent/assetmanager"
+	"github.com/sensu/sensu-go/agent/queue"
 	"github.com/sensu/sensu-go/handler"
 	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
@@ -30,10 +34,18 @@ const (
 	// type that an agent will queue before rejecting messages.
 	MaxMessageBufferSize = 10
 
+	// maxQueuedMessagesBatch specifies the maximum number of queued messages
+	// sent at once, so the queue does not starve the keepalives
+	maxQueuedMessagesBatch = 100
+
 	// TCPSocketReadDeadline specifies the maximum time the TCP socket will wait
 	// to receive data.
 	TCPSocketReadDeadline = 500 * time.Millisecond
 
+	// AssetCacheGCInterval specifies the interval between the collections of
+	// the asset cache garbage.
+	AssetCacheGCInterval = 10 * time.Minute
+
 	// DefaultAPIHost specifies the default API Host
 	DefaultAPIHost = "127.0.0.1"
 	// DefaultAPIPort specifies the default API Port
@@ -42,14 +54,26 @@ const (
 	DefaultBackendURL = "ws://127.0.0.1:8081"
 	// DefaultEnvironment specifies the default environment
 	DefaultEnvironment = "default"
+	// DefaultEventQueueMaxAge specifies the default maximum age, in seconds, of
+	// the events held in the event queue
+	DefaultEventQueueMaxAge = 86400
+	// DefaultEventQueueMaxSize specifies the default maximum size, in bytes, of
+	// the event queue
+	DefaultEventQueueMaxSize = 100 * 1024 * 1024
 	// DefaultKeepaliveInterval specifies the default keepalive interval
 	DefaultKeepaliveInterval = 20
 	// DefaultKeepaliveTimeout specifies the default keepalive timeout
 	DefaultKeepaliveTimeout = 120
+	// DefaultInventoryInterval specifies the default interval, in seconds,
+	// between refreshes of the system inventory
+	DefaultInventoryInterval = 300
 	// DefaultOrganization specifies the default organization
 	DefaultOrganization = "default"
 	// DefaultPassword specifies the default password
 	DefaultPassword = "P@ssw0rd!"
+	// DefaultShutdownTimeout specifies the default time, in seconds, the agent
+	// waits for the checks in progress when stopping
+	DefaultShutdownTimeout = 30
 	// DefaultSocketHost specifies the default socket host
 	DefaultSocketHost = "127.0.0.1"
 	// DefaultSocketPort specifies the default socket port
@@ -64,12 +88,31 @@ const (
 	DefaultUser = "agent"
 )
 
+// errStopping is returned when an operation is aborted because the agent is
+// stopping
+var errStopping = errors.New("agent is stopping")
+
+// errInProgress is returned when a check is requested while an execution of
+// the check is already in progress
+var errInProgress = errors.New("check execution still in progress")
+
 // A Config specifies Agent configuration.
 type Config struct {
 	// AgentID is the entity ID for the running agent. Default is hostname.
 	AgentID string
 	// API contains the Sensu client HTTP API configuration
 	API *APIConfig
+	// AssetCacheMaxAge is the number of seconds after which an unused asset is
+	// removed from the cache. The age of assets is not limited if 0
+	AssetCacheMaxAge int
+	// AssetCacheMaxSize is the maximum size, in bytes, of the asset cache. The
+	// least recently used assets are removed beyond it. It is not limited if 0
+	AssetCacheMaxSize int64
+	// AssetRequireSignature rejects the assets that are not signed
+	AssetRequireSignature bool
+	// AssetTrustedKeys are the paths of the ASCII armored keyrings holding the
+	// keys trusted to sign assets
+	AssetTrustedKeys []string
 	// BackendURLs is a list of URLs for the Sensu Backend. Default:
 	// ws://127.0.0.1:8081
 	BackendURLs []string
@@ -81,9 +124,18 @@ type Config struct {
 	DeregistrationHandler string
 	// Environment sets the Agent's RBAC environment identifier
 	Environment string
+	// EventQueueMaxAge is the number of seconds after which an event that could
+	// not be sent to the backend is dropped from the event queue
+	EventQueueMaxAge int
+	// EventQueueMaxSize is the maximum size, in bytes, of the event queue. The
+	// oldest events are dropped once it is reached
+	EventQueueMaxSize int64
 	// ExtendedAttributes contains any custom attributes passed to the agent on
 	// start
 	ExtendedAttributes []byte
+	// InventoryInterval is the interval, in seconds, at which the system
+	// inventory of the agent entity is refreshed. It is never refreshed if 0
+	InventoryInterval int
 	// KeepaliveInterval is the interval, in seconds, when agents will send a
 	// keepalive to sensu-backend. Default: 60
 	KeepaliveInterval int
@@ -96,12 +148,23 @@ type Config struct {
 	Password string
 	// Redact contains the fields to redact when marshalling the agent's entity
 	Redact []string
+	// ShutdownTimeout is the time, in seconds, the agent waits for the checks
+	// in progress when stopping, before flushing its queues
+	ShutdownTimeout int
+	// StandaloneChecksDir is the directory holding the definitions of the
+	// checks scheduled by the agent itself, in JSON files
+	StandaloneChecksDir string
 	// Socket contains the Sensu client socket configuration
 	Socket *SocketConfig
 	// StatsdServer contains the statsd server configuration
 	StatsdServer *StatsdServerConfig
 	// Subscriptions is an array of subscription names. Default: empty array.
+	// The subscriptions pushed by the backend, persisted in the cache
+	// directory, take precedence.
 	Subscriptions []string
+	// SystemCollectors is a list of optional system collectors (cpu, kernel,
+	// memory or uptime) adding information to the system inventory
+	SystemCollectors []string
 	// TLS sets the TLSConfig for agent TLS options
 	TLS *types.TLSOptions
 	// User sets the Agent's username
@@ -113,6 +176,11 @@ type StatsdServerConfig struct {
 	Host          string
 	Port          int
 	FlushInterval int
+	// Handlers is a list of handlers for the statsd metric events
+	Handlers []string
+	// Tags is a list of default tags, in the name:value format, added to every
+	// statsd metric point
+	Tags []string
 }
 
 // SocketConfig contains the Socket configuration
@@ -133,10 +201,14 @@ func FixtureConfig() *Config {
 		BackendURLs:       []string{},
 		CacheDir:          path.SystemCacheDir("sensu-agent"),
 		Environment:       DefaultEnvironment,
+		EventQueueMaxAge:  DefaultEventQueueMaxAge,
+		EventQueueMaxSize: DefaultEventQueueMaxSize,
+		InventoryInterval: DefaultInventoryInterval,
 		KeepaliveInterval: DefaultKeepaliveInterval,
 		KeepaliveTimeout:  DefaultKeepaliveTimeout,
 		Organization:      DefaultOrganization,
 		Password:          DefaultPassword,
+		ShutdownTimeout:   DefaultShutdownTimeout,
 		Socket: &SocketConfig{
 			Host: DefaultSocketHost,
 			Port: DefaultSocketPort,
@@ -178,14 +250,22 @@ type Agent struct {
 	assetManager    *assetmanager.Manager
 	backendSelector BackendSelector
 	cancel          context.CancelFunc
+	checkRequests   map[string]*types.CheckRequest
+	checkRequestsMu sync.Mutex
 	config          *Config
 	conn            transport.Transport
 	context         context.Context
+	draining        chan struct{}
 	entity          *types.Entity
+	entityMu        sync.Mutex
+	executions      *sync.WaitGroup
 	handler         *handler.MessageHandler
 	header          http.Header
 	inProgress      map[string]*types.CheckConfig
 	inProgressMu    *sync.Mutex
+	metrics         *prometheus.Registry
+	queue           *queue.Queue
+	queueRetry      chan struct{}
 	statsdServer    *statsd.Server
 	sendq           chan *transport.Message
 	stopped         chan struct{}
@@ -200,19 +280,25 @@ func NewAgent(config *Config) *Agent {
 	agent := &Agent{
 		backendSelector: &RandomBackendSelector{Backends: config.BackendURLs},
 		cancel:          cancel,
+		checkRequests:   make(map[string]*types.CheckRequest),
 		context:         ctx,
 		config:          config,
+		draining:        make(chan struct{}),
+		executions:      &sync.WaitGroup{},
 		handler:         handler.NewMessageHandler(),
 		inProgress:      make(map[string]*types.CheckConfig),
 		inProgressMu:    &sync.Mutex{},
+		queueRetry:      make(chan struct{}, 1),
 		stopping:        make(chan struct{}),
 		stopped:         make(chan struct{}),
 		sendq:           make(chan *transport.Message, 10),
 		wg:              &sync.WaitGroup{},
 	}
 
+	agent.metrics = agent.newMetricsRegistry()
 	agent.statsdServer = NewStatsdServer(agent)
 	agent.handler.AddHandler(types.CheckRequestType, agent.handleCheck)
+	agent.handler.AddHandler(types.SubscriptionsUpdateType, agent.handleSubscriptionsUpdate)
 	agent.assetManager = assetmanager.New(config.CacheDir, agent.getAgentEntity())
 
 	return agent
@@ -223,44 +309,30 @@ func (a *Agent) receiveMessages(out chan *transport.Message) {
 	for {
 		m, err := a.conn.Receive()
 		if err != nil {
-			logger.WithError(err).Error("transport receive error")
-
-			// If we encountered a connection error, try to reconnect
-			if _, ok := err.(transport.ConnectionError); ok {
-				// The first step is to close the current websocket connection, which is
-				// no longer useful
-				if err := a.conn.Close(); err != nil {
-					logger.Debug(err)
-				}
+			// The transport is closed by the sendPump when the agent stops
+			if a.isStopping() {
+				return
+			}
 
-				// Now, we must attempt to reconnect to the backend, with exponential
-				// backoff
-				backoff := retry.ExponentialBackoff{
-					InitialDelayInterval: 500 * time.Millisecond,
-					MaxDelayInterval:     10 * time.Second,
-					MaxRetryAttempts:     0, // Unlimited attempts
-					Multiplier:           1.5,
-				}
-				if err := backoff.Retry(func(retry int) (bool, error) {
-					//if retry != 0 {
-					//	logger.Debugf("reconnection attempt #%d", retry)
-					//}
-
-					if err = a.conn.Reconnect(a.backendSelector.Select(), a.config.TLS, a.header); err != nil {
-						logger.WithError(err).Error("reconnection attempt failed")
-						return false, nil
-					}
-
-					// At this point, the attempt was successful
-					logger.Info("successfully reconnected")
-					return true, nil
-				}); err != nil {
-					logger.WithError(err).Fatal("could not reconnect to transport")
+			// The read deadline of the transport, and the sendPump closing it
+			// after a connection error, end up here as well
+			switch err.(type) {
+			case transport.ConnectionError, transport.ClosedError:
+				logger.WithError(err).Error("transport receive error, reconnecting")
+				if err := a.reconnect(); err != nil {
+					return
 				}
+			default:
+				logger.WithError(err).Error("transport receive error")
 			}
+			continue
+		}
 
+		select {
+		case out <- m:
+		case <-a.stopping:
+			return
 		}
-		out <- m
 	}
 }
 
@@ -292,8 +364,17 @@ func (a *Agent) receivePump() {
 }
 
 func (a *Agent) sendMessage(msgType string, payload []byte) {
+	// Persist the message in the event queue, if available, so it survives
+	// backend outages and agent restarts
+	if a.queue != nil {
+		err := a.queue.Enqueue(transport.Encode(msgType, payload))
+		if err == nil {
+			return
+		}
+		logger.WithError(err).Error("error adding message to the event queue")
+	}
+
 	// blocks until message can be enqueued.
-	// TODO(greg): ring buffer?
 	msg := &transport.Message{
 		Type:    msgType,
 		Payload: payload,
@@ -313,19 +394,153 @@ func (a *Agent) sendPump() {
 	}()
 
 	logger.Info("connected - starting sendPump")
-	ticker := time.NewTicker(100 * time.Millisecond)
-	defer ticker.Stop()
+
+	var queueReady <-chan struct{}
+	if a.queue != nil {
+		queueReady = a.queue.Ready()
+	}
 
 	for {
 		select {
 		case msg := <-a.sendq:
 			if err := a.conn.Send(msg); err != nil {
-				logger.WithError(err).Warning("transport send error")
+				a.handleSendError(err)
 			}
+		case <-queueReady:
+			a.sendQueuedMessages()
+		case <-a.queueRetry:
+			// Resume sending the queued messages, once reconnected or after a
+			// full batch
+			a.sendQueuedMessages()
 		case <-a.stopping:
+			a.flush()
+			return
+		}
+	}
+}
+
+// flush sends the messages waiting in the send queue and the event queue, then
+// the deregistration of the agent if it is ephemeral, before the transport is
+// closed by the sendPump.
+func (a *Agent) flush() {
+	for len(a.sendq) > 0 {
+		if err := a.conn.Send(<-a.sendq); err != nil {
+			logger.WithError(err).Warning("transport send error")
+		}
+	}
+
+	if a.queue != nil {
+		for remaining := a.queue.Len(); remaining > 0; {
+			a.sendQueuedMessages()
+			// Stop once the queue no longer drains, the transport being closed
+			if left := a.queue.Len(); left < remaining {
+				remaining = left
+			} else {
+				logger.WithField("messages", left).Warning("unable to flush the event queue")
+				break
+			}
+		}
+	}
+
+	if a.config.Deregister {
+		if err := a.sendDeregistration(); err != nil {
+			logger.WithError(err).Error("error sending deregistration")
+		}
+	}
+}
+
+// sendDeregistration sends the agent entity to the backend, which deregisters
+// it right away rather than once its keepalive times out.
+func (a *Agent) sendDeregistration() error {
+	logger.Info("sending deregistration")
+	deregistration := &types.Event{
+		Entity:    a.getAgentEntity(),
+		Timestamp: time.Now().Unix(),
+	}
+	msgBytes, err := json.Marshal(deregistration)
+	if err != nil {
+		return err
+	}
+
+	return a.conn.Send(&transport.Message{
+		Type:    transport.MessageTypeDeregistration,
+		Payload: msgBytes,
+	})
+}
+
+// sendQueuedMessages sends, in order, the messages held in the event queue. A
+// message is only removed from the queue once it has been sent, so the
+// remaining messages are replayed once the transport reconnects.
+func (a *Agent) sendQueuedMessages() {
+	if a.queue == nil {
+		return
+	}
+
+	for i := 0; i < maxQueuedMessagesBatch; i++ {
+		if a.conn.Closed() {
+			return
+		}
+
+		item, err := a.queue.Peek()
+		if err != nil {
+			if err != queue.ErrEmpty {
+				logger.WithError(err).Error("error reading the event queue")
+			}
+			return
+		}
+
+		msgType, payload, err := transport.Decode(item.Value)
+		if err == nil {
+			msg := &transport.Message{Type: msgType, Payload: payload}
+			if err := a.conn.Send(msg); err != nil {
+				a.handleSendError(err)
+				return
+			}
+		} else {
+			logger.WithError(err).Error("discarding invalid message from the event queue")
+		}
+
+		if err := a.queue.Remove(item); err != nil {
+			logger.WithError(err).Error("error removing message from the event queue")
 			return
 		}
 	}
+
+	// The remaining messages are sent with the next batch
+	a.retryQueue()
+}
+
+// handleSendError logs the error of a send, and closes the transport if the
+// connection failed, which has the receive loop reconnect to the backends.
+func (a *Agent) handleSendError(err error) {
+	logger.WithError(err).Warning("transport send error")
+	if _, ok := err.(transport.ConnectionError); !ok {
+		return
+	}
+	if err := a.conn.Close(); err != nil {
+		logger.Debug(err)
+	}
+}
+
+// retryQueue has the sendPump send the messages of the event queue again.
+func (a *Agent) retryQueue() {
+	select {
+	case a.queueRetry <- struct{}{}:
+	default:
+	}
+}
+
+// openQueue opens the event queue stored in the cache directory
+func (a *Agent) openQueue() (*queue.Queue, error) {
+	if err := os.MkdirAll(a.config.CacheDir, 0755); err != nil {
+		return nil, err
+	}
+
+	return queue.Open(queue.Config{
+		Path:    filepath.Join(a.config.CacheDir, "events.db"),
+		MaxAge:  time.Duration(a.config.EventQueueMaxAge) * time.Second,
+		MaxSize: a.config.EventQueueMaxSize,
+	})
 }
 
 func (a *Agent) sendKeepalive() error {
@@ -349,44 +564,254 @@ func (a *Agent) sendKeepalive() error {
 	return nil
 }
 
+// connect establishes the connection to one of the backends, trying each one
+// of them in turn until it succeeds.
+func (a *Agent) connect() (transport.Transport, error) {
+	var conn transport.Transport
+
+	backoff := newBackoff()
+	backoff.MaxRetryAttempts = len(a.config.BackendURLs)
+	if backoff.MaxRetryAttempts == 0 {
+		backoff.MaxRetryAttempts = 1
+	}
+
+	header := a.transportHeader()
+	err := backoff.Retry(func(retry int) (bool, error) {
+		backendURL := a.backendSelector.Select()
+		c, err := transport.Connect(backendURL, a.config.TLS, header)
+		if err != nil {
+			logger.WithError(err).WithField("backend", backendURL).Error("connection attempt failed")
+			return false, nil
+		}
+		conn = c
+		return true, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to any backend: %s", err)
+	}
+
+	return conn, nil
+}
+
+// reconnect closes the current connection and reconnects to the backends with
+// exponential backoff, without any limit on the number of attempts, until it
+// succeeds or the agent stops. An immediate keepalive is sent once reconnected,
+// so the backend can register the agent and its subscriptions right away.
+func (a *Agent) reconnect() error {
+	// The first step is to close the current websocket connection, which is no
+	// longer useful
+	if err := a.conn.Close(); err != nil {
+		logger.Debug(err)
+	}
+
+	// Refresh the headers, which carry the current subscriptions of the agent
+	a.refreshHeader()
+	header := a.transportHeader()
+
+	backoff := newBackoff()
+	err := backoff.Retry(func(retry int) (bool, error) {
+		if a.isStopping() {
+			return false, errStopping
+		}
+
+		backendURL := a.backendSelector.Select()
+		logger.WithField("backend", backendURL).Infof("reconnection attempt #%d", retry+1)
+		if err := a.conn.Reconnect(backendURL, a.config.TLS, header); err != nil {
+			logger.WithError(err).WithField("backend", backendURL).Error("reconnection attempt failed")
+			return false, nil
+		}
+
+		logger.WithField("backend", backendURL).Info("successfully reconnected")
+		reconnects.Inc()
+		return true, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// The messages queued while disconnected can be sent again
+	a.retryQueue()
+
+	if err := a.sendKeepalive(); err != nil {
+		logger.WithError(err).Error("error sending keepalive")
+	}
+
+	return nil
+}
+
+// newBackoff returns the exponential backoff, with jitter, used to connect to
+// the backends
+func newBackoff() retry.ExponentialBackoff {
+	return retry.ExponentialBackoff{
+		InitialDelayInterval: 500 * time.Millisecond,
+		MaxDelayInterval:     10 * time.Second,
+		Multiplier:           1.5,
+	}
+}
+
+func (a *Agent) isStopping() bool {
+	select {
+	case <-a.stopping:
+		return true
+	default:
+		return false
+	}
+}
+
+// isDraining returns true once the agent no longer accepts check executions
+// because it is stopping.
+func (a *Agent) isDraining() bool {
+	select {
+	case <-a.draining:
+		return true
+	default:
+		return false
+	}
+}
+
+// startCheck registers the execution of a check, which the agent waits for
+// when stopping. It returns an error if the agent no longer accepts executions,
+// or if an execution of the check is already in progress.
+func (a *Agent) startCheck(check *types.CheckConfig) error {
+	a.inProgressMu.Lock()
+	defer a.inProgressMu.Unlock()
+
+	if a.isDraining() {
+		return errStopping
+	}
+	if _, ok := a.inProgress[check.Name]; ok {
+		return fmt.Errorf("%s: %s", errInProgress, check.Name)
+	}
+	a.inProgress[check.Name] = check
+	a.executions.Add(1)
+	return nil
+}
+
+// finishCheck unregisters the execution of a check started with startCheck
+func (a *Agent) finishCheck(check *types.CheckConfig) {
+	a.inProgressMu.Lock()
+	delete(a.inProgress, check.Name)
+	a.inProgressMu.Unlock()
+	a.executions.Done()
+}
+
+// waitExecutions waits for the check executions in progress, and returns false
+// if some are still running after the timeout.
+func (a *Agent) waitExecutions(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		a.executions.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		select {
+		case <-done:
+			return true
+		default:
+			return false
+		}
+	}
+}
+
+// buildTransportHeaderMap returns the headers sent to the backend when
+// connecting, which identify and authenticate the agent.
 func (a *Agent) buildTransportHeaderMap() http.Header {
+	userCredentials := fmt.Sprintf("%s:%s", a.config.User, a.config.Password)
+	userCredentials = base64.StdEncoding.EncodeToString([]byte(userCredentials))
+
 	header := http.Header{}
+	header.Set("Authorization", "Basic "+userCredentials)
 	header.Set(transport.HeaderKeyAgentID, a.config.AgentID)
 	header.Set(transport.HeaderKeyEnvironment, a.config.Environment)
 	header.Set(transport.HeaderKeyOrganization, a.config.Organization)
 	header.Set(transport.HeaderKeyUser, a.config.User)
-	header.Set(transport.HeaderKeySubscriptions, strings.Join(a.config.Subscriptions, ","))
+	header.Set(transport.HeaderKeySubscriptions, strings.Join(a.getAgentEntity().Subscriptions, ","))
 
 	return header
 }
 
-// Run starts the Agent.
+// refreshHeader rebuilds the headers sent to the backends when connecting,
+// which are guarded by the entity mutex since they carry its subscriptions.
+func (a *Agent) refreshHeader() {
+	header := a.buildTransportHeaderMap()
+
+	a.entityMu.Lock()
+	a.header = header
+	a.entityMu.Unlock()
+}
+
+// transportHeader returns the headers sent to the backends when connecting.
+func (a *Agent) transportHeader() http.Header {
+	a.entityMu.Lock()
+	defer a.entityMu.Unlock()
+	return a.header
+}
+
+// Run starts the Agent. It returns an error if the standalone checks are
+// invalid.
 //
-// 1. Start a statsd server on the agent and logs the received metrics.
-// 2. Connect to the backend, return an error if unsuccessful.
-// 3. Start the socket listeners, return an error if unsuccessful.
-// 4. Start the send/receive pumps.
-// 5. Start sending keepalives.
-// 6. Start the API server, shutdown the agent if doing so fails.
+// 1. Open the event queue, falling back to an in-memory queue if unsuccessful.
+// 2. Start a statsd server on the agent and logs the received metrics.
+// 3. Connect to one of the backends, return an error if none is reachable.
+// 4. Start the socket listeners, return an error if unsuccessful.
+// 5. Start the send/receive pumps, the latter reconnecting when disconnected.
+// 6. Start sending keepalives and scheduling the standalone checks.
+// 7. Start the API server, shutdown the agent if doing so fails.
 func (a *Agent) Run() error {
-	userCredentials := fmt.Sprintf("%s:%s", a.config.User, a.config.Password)
-	userCredentials = base64.StdEncoding.EncodeToString([]byte(userCredentials))
-	a.header = a.buildTransportHeaderMap()
-	a.header.Set("Authorization", "Basic "+userCredentials)
+	if err := a.loadSubscriptions(); err != nil {
+		logger.WithError(err).Error("unable to load the persisted subscriptions")
+	}
+	a.refreshHeader()
+
+	if len(a.config.AssetTrustedKeys) > 0 || a.config.AssetRequireSignature {
+		verifier, err := assetmanager.NewSignatureVerifier(a.config.AssetTrustedKeys, a.config.AssetRequireSignature)
+		if err != nil {
+			return err
+		}
+		a.assetManager.SetVerifier(verifier)
+	}
+
+	var standaloneChecks []*types.CheckConfig
+	if a.config.StandaloneChecksDir != "" {
+		checks, err := a.loadStandaloneChecks(a.config.StandaloneChecksDir)
+		if err != nil {
+			return err
+		}
+		standaloneChecks = checks
+	}
+
+	q, err := a.openQueue()
+	if err != nil {
+		logger.WithError(err).Error("unable to open the event queue, events will not be persisted")
+	} else {
+		a.queue = q
+	}
 
 	logger.Info("starting statsd server on address: ", a.statsdServer.MetricsAddr)
 	go a.statsdServer.Run(a.context)
 
-	conn, err := transport.Connect(a.backendSelector.Select(), a.config.TLS, a.header)
+	conn, err := a.connect()
 	if err != nil {
+		if a.queue != nil {
+			_ = a.queue.Close()
+		}
 		return err
 	}
 
 	a.conn = conn
 
 	// These are in separate goroutines so that they can, theoretically, be executing
-	// concurrently.
-	go a.sendPump()
+	// concurrently. The event queue can only be closed once the sendPump has
+	// returned.
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		a.sendPump()
+	}()
 	go a.receivePump()
 
 	// Send an immediate keepalive once we've connected.
@@ -402,16 +827,76 @@ func (a *Agent) Run() error {
 				if err := a.sendKeepalive(); err != nil {
 					logger.WithError(err).Error("failed sending keepalive")
 				}
-			case <-a.stopping:
+			case <-a.draining:
+				// A keepalive sent after the deregistration would register
+				// the entity again
 				return
 			}
 
 		}
 	}()
 
+	for _, check := range standaloneChecks {
+		logger.Info("scheduling standalone check: ", check.Name)
+		a.storeCheckRequest(&types.CheckRequest{Config: check})
+		go a.scheduleStandaloneCheck(check)
+	}
+
+	if a.config.AssetCacheMaxAge > 0 || a.config.AssetCacheMaxSize > 0 {
+		go func() {
+			a.pruneAssetCache()
+			gcTicker := time.NewTicker(AssetCacheGCInterval)
+			defer gcTicker.Stop()
+			for {
+				select {
+				case <-gcTicker.C:
+					a.pruneAssetCache()
+				case <-a.stopping:
+					return
+				}
+			}
+		}()
+	}
+
+	if a.config.InventoryInterval > 0 {
+		go func() {
+			inventoryTicker := time.NewTicker(time.Duration(a.config.InventoryInterval) * time.Second)
+			defer inventoryTicker.Stop()
+			for {
+				select {
+				case <-inventoryTicker.C:
+					a.refreshAgentEntity()
+				case <-a.stopping:
+					return
+				}
+			}
+		}()
+	}
+
 	return nil
 }
 
+// pruneAssetCache removes the assets of the cache that are either older than
+// the maximum age or beyond the maximum size, starting with the least recently
+// used ones. The assets in use are kept.
+func (a *Agent) pruneAssetCache() {
+	removed, err := a.assetManager.Prune(assetmanager.PrunePolicy{
+		MaxAge:  time.Duration(a.config.AssetCacheMaxAge) * time.Second,
+		MaxSize: a.config.AssetCacheMaxSize,
+	})
+	if err != nil {
+		logger.WithError(err).Error("unable to prune the asset cache")
+		return
+	}
+
+	for _, asset := range removed {
+		logger.WithFields(logrus.Fields{
+			"sha512": asset.Sha512,
+			"size":   asset.Size,
+		}).Info("removed asset from the cache")
+	}
+}
+
 // StartAPI starts the Agent HTTP API. After attempting to start the API, if the
 // HTTP server encounters a fatal error, it will shutdown the rest of the agent.
 func (a *Agent) StartAPI() {
@@ -458,10 +943,31 @@ func (a *Agent) StartSocketListeners() {
 
 // Stop shuts down the agent. It will block until all listening goroutines
 // have returned.
+//
+// 1. Stop accepting check requests and sending keepalives.
+// 2. Wait for the checks in progress, up to the shutdown timeout.
+// 3. Flush the send queue and the event queue.
+// 4. Send the deregistration of the agent if it is ephemeral.
+// 5. Close the transport, the API server and the event queue.
 func (a *Agent) Stop() {
+	a.inProgressMu.Lock()
+	close(a.draining)
+	a.inProgressMu.Unlock()
+
+	timeout := time.Duration(a.config.ShutdownTimeout) * time.Second
+	if !a.waitExecutions(timeout) {
+		logger.Warning("shutdown timeout reached, abandoning the checks in progress")
+	}
+
 	a.cancel()
 	close(a.stopping)
 	a.wg.Wait()
+
+	if a.queue != nil {
+		if err := a.queue.Close(); err != nil {
+			logger.WithError(err).Error("error closing the event queue")
+		}
+	}
 }
 
 func (a *Agent) addHandler(msgType string, handlerFunc handler.MessageHandlerFunc) {
@@ -2,14 +2,22 @@ package agent
 
 import (
 	"encoding/json"
+	"errors"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/sensu/sensu-go/testing/mocktransport"
 	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 type testMessageType struct {
@@ -38,12 +46,17 @@ func TestSendLoop(t *testing.T) {
 
 	wsURL := strings.Replace(ts.URL, "http", "ws", 1)
 
+	cacheDir, err := ioutil.TempDir("", "sensu-agent")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(cacheDir) }()
+
 	cfg := FixtureConfig()
 	cfg.BackendURLs = []string{wsURL}
 	cfg.API.Port = 0
+	cfg.CacheDir = cacheDir
 	cfg.Socket.Port = 0
 	ta := NewAgent(cfg)
-	err := ta.Run()
+	err = ta.Run()
 	assert.NoError(t, err)
 	if err != nil {
 		assert.FailNow(t, "agent failed to run")
@@ -75,9 +88,14 @@ func TestReceiveLoop(t *testing.T) {
 	defer ts.Close()
 
 	wsURL := strings.Replace(ts.URL, "http", "ws", 1)
+
+	cacheDir, err := ioutil.TempDir("", "sensu-agent")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(cacheDir) }()
 	cfg := FixtureConfig()
 	cfg.BackendURLs = []string{wsURL}
 	cfg.API.Port = 0
+	cfg.CacheDir = cacheDir
 	cfg.Socket.Port = 0
 	ta := NewAgent(cfg)
 	ta.addHandler("testMessageType", func(payload []byte) error {
@@ -88,7 +106,7 @@ func TestReceiveLoop(t *testing.T) {
 		done <- struct{}{}
 		return nil
 	})
-	err := ta.Run()
+	err = ta.Run()
 	assert.NoError(t, err)
 	if err != nil {
 		assert.FailNow(t, "agent failed to run")
@@ -99,3 +117,179 @@ func TestReceiveLoop(t *testing.T) {
 	<-done
 	ta.Stop()
 }
+
+func TestSendQueuedMessages(t *testing.T) {
+	cacheDir, err := ioutil.TempDir("", "sensu-agent")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(cacheDir) }()
+
+	cfg := FixtureConfig()
+	cfg.CacheDir = cacheDir
+	ta := NewAgent(cfg)
+	ta.queue, err = ta.openQueue()
+	require.NoError(t, err)
+	defer func() { _ = ta.queue.Close() }()
+
+	conn := &mocktransport.MockTransport{}
+	ta.conn = conn
+
+	// The messages are queued while the transport is disconnected
+	conn.On("Closed").Return(true).Once()
+	ta.sendMessage("event", []byte("foo"))
+	ta.sendMessage("event", []byte("bar"))
+	ta.sendQueuedMessages()
+	assert.Equal(t, 2, ta.queue.Len())
+
+	// The first message fails to be sent and is kept in the queue
+	conn.On("Closed").Return(false)
+	conn.On("Send", mock.Anything).Return(errors.New("error")).Once()
+	ta.sendQueuedMessages()
+	assert.Equal(t, 2, ta.queue.Len())
+	conn.AssertNotCalled(t, "Close")
+
+	// The transport is closed when the connection fails, so it reconnects
+	conn.On("Send", mock.Anything).Return(transport.ConnectionError{Message: "error"}).Once()
+	conn.On("Close").Return(nil).Once()
+	ta.sendQueuedMessages()
+	assert.Equal(t, 2, ta.queue.Len())
+	conn.AssertCalled(t, "Close")
+
+	// The messages are replayed in order
+	var sent []string
+	conn.On("Send", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		msg := args.Get(0).(*transport.Message)
+		assert.Equal(t, "event", msg.Type)
+		sent = append(sent, string(msg.Payload))
+	})
+	ta.sendQueuedMessages()
+	assert.Equal(t, []string{"foo", "bar"}, sent)
+	assert.Equal(t, 0, ta.queue.Len())
+}
+
+func TestReconnect(t *testing.T) {
+	var connections int32
+	done := make(chan struct{})
+	server := transport.NewServer()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := server.Serve(w, r)
+		require.NoError(t, err)
+		n := atomic.AddInt32(&connections, 1)
+
+		// An immediate keepalive is expected on every connection
+		msg, err := conn.Receive()
+		require.NoError(t, err)
+		assert.Equal(t, transport.MessageTypeKeepalive, msg.Type)
+
+		if n == 1 {
+			// Simulate a backend restart
+			assert.NoError(t, conn.Close())
+			return
+		}
+		done <- struct{}{}
+	}))
+	defer ts.Close()
+
+	cacheDir, err := ioutil.TempDir("", "sensu-agent")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(cacheDir) }()
+
+	cfg := FixtureConfig()
+	cfg.BackendURLs = []string{strings.Replace(ts.URL, "http", "ws", 1)}
+	cfg.API.Port = 0
+	cfg.CacheDir = cacheDir
+	cfg.Socket.Port = 0
+	ta := NewAgent(cfg)
+	require.NoError(t, ta.Run())
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		assert.FailNow(t, "agent did not reconnect")
+	}
+	ta.Stop()
+	assert.Equal(t, int32(2), atomic.LoadInt32(&connections))
+}
+
+func TestConnectRotatesBackends(t *testing.T) {
+	server := transport.NewServer()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := server.Serve(w, r)
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	// A backend that is not reachable
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	cfg := FixtureConfig()
+	cfg.BackendURLs = []string{
+		strings.Replace(unreachable.URL, "http", "ws", 1),
+		strings.Replace(ts.URL, "http", "ws", 1),
+	}
+	ta := NewAgent(cfg)
+	ta.refreshHeader()
+
+	// Whatever the order of the backends, the reachable one is selected
+	for i := 0; i < 2; i++ {
+		conn, err := ta.connect()
+		require.NoError(t, err)
+		assert.NoError(t, conn.Close())
+	}
+
+	// No backend is reachable
+	cfg.BackendURLs = cfg.BackendURLs[:1]
+	ta = NewAgent(cfg)
+	_, err := ta.connect()
+	assert.Error(t, err)
+}
+
+func TestGracefulStop(t *testing.T) {
+	received := make(chan []string, 1)
+	server := transport.NewServer()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := server.Serve(w, r)
+		require.NoError(t, err)
+
+		types := []string{}
+		for {
+			msg, err := conn.Receive()
+			if err != nil {
+				break
+			}
+			types = append(types, msg.Type)
+			if msg.Type == transport.MessageTypeDeregistration {
+				break
+			}
+		}
+		received <- types
+	}))
+	defer ts.Close()
+
+	cacheDir, err := ioutil.TempDir("", "sensu-agent")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(cacheDir) }()
+
+	cfg := FixtureConfig()
+	cfg.BackendURLs = []string{strings.Replace(ts.URL, "http", "ws", 1)}
+	cfg.CacheDir = cacheDir
+	cfg.Deregister = true
+	cfg.ShutdownTimeout = 10
+	ta := NewAgent(cfg)
+	require.NoError(t, ta.Run())
+
+	check := types.FixtureCheckConfig("slow")
+	check.Command = "sleep 1"
+	require.NoError(t, ta.scheduleCheck(&types.CheckRequest{Config: check}))
+
+	// The check in progress is waited for, and its result sent before the
+	// deregistration
+	ta.Stop()
+	msgTypes := <-received
+	require.NotEmpty(t, msgTypes)
+	assert.Contains(t, msgTypes, transport.MessageTypeEvent)
+	assert.Equal(t, transport.MessageTypeDeregistration, msgTypes[len(msgTypes)-1])
+
+	// Checks are no longer accepted
+	assert.Equal(t, errStopping, ta.scheduleCheck(&types.CheckRequest{Config: check}))
+}
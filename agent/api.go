@@ -3,10 +3,13 @@ package agent
 import (
 	"encoding/json"
 	"fmt"
+	"mime"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/agent/assetmanager"
 	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
 )
@@ -15,6 +18,21 @@ import (
 type APIConfig struct {
 	Host string
 	Port int
+
+	// EnableExecution enables the endpoints executing checks and commands
+	EnableExecution bool
+}
+
+// queueInfo describes the depth of the outbound message queues
+type queueInfo struct {
+	// Pending is the number of messages waiting to be sent in memory
+	Pending int `json:"pending"`
+
+	// Queued is the number of messages held in the event queue
+	Queued int `json:"queued"`
+
+	// Dropped is the number of messages dropped from the event queue
+	Dropped int64 `json:"dropped"`
 }
 
 // newServer returns a new HTTP server
@@ -35,6 +53,26 @@ func newServer(a *Agent) *http.Server {
 func registerRoutes(a *Agent, r *mux.Router) {
 	r.HandleFunc("/events", addEvent(a)).Methods(http.MethodPost)
 	r.HandleFunc("/healthz", healthz(a.conn)).Methods(http.MethodGet)
+	r.HandleFunc("/entity", getEntity(a)).Methods(http.MethodGet)
+	r.HandleFunc("/subscriptions", getSubscriptions(a)).Methods(http.MethodGet)
+	r.HandleFunc("/checks/in-progress", getInProgressChecks(a)).Methods(http.MethodGet)
+	r.HandleFunc("/checks/{check}/execute", executeNamedCheck(a)).Methods(http.MethodPost)
+	r.HandleFunc("/execute", executeCommand(a)).Methods(http.MethodPost)
+	r.HandleFunc("/assets", getAssets(a)).Methods(http.MethodGet)
+	r.HandleFunc("/queue", getQueue(a)).Methods(http.MethodGet)
+	r.Handle("/metrics", metricsHandler(a)).Methods(http.MethodGet)
+}
+
+// writeJSON writes the value as the JSON body of the response
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
 }
 
 // healthz returns an OK status if the agent is up and connected to a backend.
@@ -79,3 +117,162 @@ func addEvent(a *Agent) http.HandlerFunc {
 		w.WriteHeader(http.StatusCreated)
 	}
 }
+
+// getEntity returns the current entity of the agent
+func getEntity(a *Agent) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, a.getAgentEntity())
+	}
+}
+
+// getSubscriptions returns the subscriptions of the agent
+func getSubscriptions(a *Agent) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		subscriptions := a.getAgentEntity().Subscriptions
+		if subscriptions == nil {
+			subscriptions = []string{}
+		}
+		writeJSON(w, subscriptions)
+	}
+}
+
+// getInProgressChecks returns the checks being executed, sorted by name
+func getInProgressChecks(a *Agent) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		a.inProgressMu.Lock()
+		checks := make([]*types.CheckConfig, 0, len(a.inProgress))
+		for _, check := range a.inProgress {
+			checks = append(checks, check)
+		}
+		a.inProgressMu.Unlock()
+
+		sort.Slice(checks, func(i, j int) bool {
+			return checks[i].Name < checks[j].Name
+		})
+		writeJSON(w, checks)
+	}
+}
+
+// executeNamedCheck executes the last received configuration of a check, and
+// returns the resulting event without sending it to the backend
+func executeNamedCheck(a *Agent) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !allowExecution(a, w, r) {
+			return
+		}
+
+		name := mux.Vars(r)["check"]
+		request, err := a.getCheckRequest(name)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		} else if request == nil {
+			http.Error(w, fmt.Sprintf("unknown check %q", name), http.StatusNotFound)
+			return
+		}
+
+		runLocalCheck(a, w, request)
+	}
+}
+
+// executeCommand executes an ad hoc check, whose configuration is given in the
+// JSON request body, and returns the resulting event without sending it to the
+// backend
+func executeCommand(a *Agent) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !allowExecution(a, w, r) {
+			return
+		}
+
+		check := &types.CheckConfig{}
+		if err := json.NewDecoder(r.Body).Decode(check); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if check.Command == "" {
+			http.Error(w, "command cannot be empty", http.StatusBadRequest)
+			return
+		}
+		if check.Name == "" {
+			check.Name = "adhoc"
+		}
+		if check.Organization == "" {
+			check.Organization = a.config.Organization
+		}
+		if check.Environment == "" {
+			check.Environment = a.config.Environment
+		}
+
+		runLocalCheck(a, w, &types.CheckRequest{Config: check})
+	}
+}
+
+// allowExecution writes an error to the response and returns false unless the
+// execution endpoints are enabled and the request is JSON, which browsers can't
+// send to another origin without its consent
+func allowExecution(a *Agent, w http.ResponseWriter, r *http.Request) bool {
+	if !a.config.API.EnableExecution {
+		http.Error(w, "execution is disabled", http.StatusForbidden)
+		return false
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != "application/json" {
+		http.Error(w, "expected a JSON request", http.StatusUnsupportedMediaType)
+		return false
+	}
+	return true
+}
+
+// runLocalCheck executes the requested check and writes the resulting event to
+// the response
+func runLocalCheck(a *Agent, w http.ResponseWriter, request *types.CheckRequest) {
+	if err := a.startCheck(request.Config); err == errStopping {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	defer a.finishCheck(request.Config)
+
+	if err := a.substituteTokens(request.Config); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	event, err := a.runCheck(request)
+	if err != nil {
+		a.failCheck(event, err)
+	}
+
+	writeJSON(w, event)
+}
+
+// getAssets returns the assets of the cache, from the least to the most
+// recently used
+func getAssets(a *Agent) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assets, err := a.assetManager.List()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if assets == nil {
+			assets = []assetmanager.CachedAsset{}
+		}
+		writeJSON(w, assets)
+	}
+}
+
+// getQueue returns the depth of the outbound message queues
+func getQueue(a *Agent) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		info := queueInfo{Pending: len(a.sendq)}
+		if a.queue != nil {
+			info.Queued = a.queue.Len()
+			info.Dropped = a.queue.Dropped()
+		}
+		writeJSON(w, info)
+	}
+}
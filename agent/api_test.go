@@ -6,12 +6,17 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/gorilla/mux"
 	"github.com/sensu/sensu-go/testing/mocktransport"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAddEvent(t *testing.T) {
@@ -105,3 +110,146 @@ func TestHealthz(t *testing.T) {
 		})
 	}
 }
+
+func serveAPI(agent *Agent, method, path string, body []byte, contentType string) *httptest.ResponseRecorder {
+	r, _ := http.NewRequest(method, path, bytes.NewBuffer(body))
+	if contentType != "" {
+		r.Header.Set("Content-Type", contentType)
+	}
+
+	router := mux.NewRouter()
+	registerRoutes(agent, router)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, r)
+	return w
+}
+
+func TestGetEntity(t *testing.T) {
+	config := FixtureConfig()
+	config.Subscriptions = []string{"linux", "web"}
+	agent := NewAgent(config)
+
+	w := serveAPI(agent, "GET", "/entity", nil, "")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	entity := &types.Entity{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), entity))
+	assert.Equal(t, config.AgentID, entity.ID)
+
+	w = serveAPI(agent, "GET", "/subscriptions", nil, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `["linux","web"]`, w.Body.String())
+}
+
+func TestGetInProgressChecks(t *testing.T) {
+	agent := NewAgent(FixtureConfig())
+	agent.inProgress["check_foo"] = types.FixtureCheckConfig("check_foo")
+
+	w := serveAPI(agent, "GET", "/checks/in-progress", nil, "")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var checks []*types.CheckConfig
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &checks))
+	require.Len(t, checks, 1)
+	assert.Equal(t, "check_foo", checks[0].Name)
+}
+
+func TestExecuteNamedCheck(t *testing.T) {
+	config := FixtureConfig()
+	agent := NewAgent(config)
+
+	check := types.FixtureCheckConfig("check_foo")
+	check.Command = testutil.CommandPath(filepath.Join(toolsDir, "true"))
+	agent.storeCheckRequest(&types.CheckRequest{Config: check})
+
+	// Execution is disabled by default
+	w := serveAPI(agent, "POST", "/checks/check_foo/execute", nil, "")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	config.API.EnableExecution = true
+	w = serveAPI(agent, "POST", "/checks/check_foo/execute", nil, "")
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+
+	w = serveAPI(agent, "POST", "/checks/check_bar/execute", nil, "application/json")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	// Only one execution of a check can be in progress
+	agent.inProgress["check_foo"] = check
+	w = serveAPI(agent, "POST", "/checks/check_foo/execute", nil, "application/json")
+	assert.Equal(t, http.StatusConflict, w.Code)
+	delete(agent.inProgress, "check_foo")
+
+	w = serveAPI(agent, "POST", "/checks/check_foo/execute", nil, "application/json")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	event := &types.Event{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), event))
+	assert.Equal(t, "check_foo", event.Check.Name)
+	assert.Equal(t, uint32(0), event.Check.Status)
+	assert.NotZero(t, event.Timestamp)
+
+	// The result is not sent to the backend
+	assert.Len(t, agent.sendq, 0)
+}
+
+func TestExecuteCommand(t *testing.T) {
+	config := FixtureConfig()
+	agent := NewAgent(config)
+
+	body := []byte(`{"command": "echo foo"}`)
+	w := serveAPI(agent, "POST", "/execute", body, "application/json")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	config.API.EnableExecution = true
+	w = serveAPI(agent, "POST", "/execute", body, "text/plain")
+	assert.Equal(t, http.StatusUnsupportedMediaType, w.Code)
+
+	w = serveAPI(agent, "POST", "/execute", []byte(`{}`), "application/json")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	// Only one execution of a check can be in progress
+	agent.inProgress["adhoc"] = types.FixtureCheckConfig("adhoc")
+	w = serveAPI(agent, "POST", "/execute", body, "application/json")
+	assert.Equal(t, http.StatusConflict, w.Code)
+	delete(agent.inProgress, "adhoc")
+
+	w = serveAPI(agent, "POST", "/execute", body, "application/json")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	event := &types.Event{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), event))
+	assert.Equal(t, "adhoc", event.Check.Name)
+	assert.Equal(t, "foo", strings.TrimSpace(event.Check.Output))
+	assert.Empty(t, agent.inProgress)
+}
+
+func TestGetQueue(t *testing.T) {
+	agent := NewAgent(FixtureConfig())
+	agent.sendq <- &transport.Message{}
+
+	w := serveAPI(agent, "GET", "/queue", nil, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"pending": 1, "queued": 0, "dropped": 0}`, w.Body.String())
+}
+
+func TestGetAssets(t *testing.T) {
+	agent := NewAgent(FixtureConfig())
+
+	w := serveAPI(agent, "GET", "/assets", nil, "")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var assets []interface{}
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &assets))
+}
+
+func TestGetMetrics(t *testing.T) {
+	agent := NewAgent(FixtureConfig())
+	agent.sendq <- &transport.Message{}
+
+	w := serveAPI(agent, "GET", "/metrics", nil, "")
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `sensu_agent_send_queue_depth{queue="memory"} 1`)
+	assert.Contains(t, w.Body.String(), `sensu_agent_send_queue_depth{queue="disk"} 0`)
+	assert.Contains(t, w.Body.String(), `sensu_agent_queue_dropped_total{reason="max_age"}`)
+	assert.Contains(t, w.Body.String(), `sensu_agent_queue_dropped_total{reason="max_size"}`)
+}
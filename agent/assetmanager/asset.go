@@ -3,12 +3,16 @@ package assetmanager
 import (
 	"crypto/sha512"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/mholt/archiver"
@@ -29,8 +33,15 @@ const (
 
 // A RuntimeAsset refers to an asset that is currently in use by the agent.
 type RuntimeAsset struct {
-	path  string
-	asset *types.Asset
+	path     string
+	asset    *types.Asset
+	verifier *SignatureVerifier
+
+	// users is the number of executions using the asset, which hold its
+	// lockfile until the last one is done.
+	mu       sync.Mutex
+	users    int
+	lockfile *lockfile.Lockfile
 }
 
 // NewRuntimeAsset given asset and pathPrefix return new managed asset
@@ -83,6 +94,24 @@ func (d *RuntimeAsset) markAsInstalled() error {
 	return err
 }
 
+// Update the modification time of the .used file of the asset directory to
+// record its last use.
+func (d *RuntimeAsset) markAsUsed() error {
+	usedfile := filepath.Join(d.path, usedFile)
+
+	now := time.Now()
+	if err := os.Chtimes(usedfile, now, now); err == nil || !os.IsNotExist(err) {
+		return err
+	}
+
+	file, err := os.Create(usedfile)
+	if err != nil {
+		return err
+	}
+
+	return file.Close()
+}
+
 // Avoid competing installation of assets
 func (d *RuntimeAsset) awaitLock() (*lockfile.Lockfile, error) {
 	lockfile, _ := lockfile.New(filepath.Join(d.path, ".lock"))
@@ -119,69 +148,66 @@ func (d *RuntimeAsset) awaitLock() (*lockfile.Lockfile, error) {
 	return &lockfile, nil
 }
 
-func (d *RuntimeAsset) fetch() (*http.Response, error) {
-	// GET asset w/ timeout
-	netClient := &http.Client{Timeout: fetchTimeout}
-	r, err := netClient.Get(d.asset.URL)
+// Opens the asset at the given location, either a local file or an HTTP(S)
+// URL requested with the headers of the asset.
+func (d *RuntimeAsset) fetch(assetURL string) (io.ReadCloser, error) {
+	u, err := url.Parse(assetURL)
 	if err != nil {
-		return r, fmt.Errorf("error fetching asset: %s", err.Error())
+		return nil, fmt.Errorf("error fetching asset: %s", err.Error())
 	}
 
-	return r, err
-}
+	if u.Scheme == "file" {
+		file, err := os.Open(filepath.FromSlash(u.Path))
+		if err != nil {
+			return nil, fmt.Errorf("error fetching asset: %s", err.Error())
+		}
+		return file, nil
+	}
 
-// Downloads the given depdencies asset to the cache directory.
-// TODO(james): ugly; too many responsibilities
-// nolint
-func (d *RuntimeAsset) install() error {
-	// Ensure that cache directory exists before we attempt to write the contents
-	// of our asset to it.
-	binDir := filepath.Join(d.path, "bin")
-	if err := os.MkdirAll(binDir, 0755); err != nil {
-		return fmt.Errorf("unable to create cache directory '%s': %s", d.path, err.Error())
+	req, err := http.NewRequest(http.MethodGet, assetURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching asset: %s", err.Error())
+	}
+	for key, value := range d.asset.Headers {
+		req.Header.Set(key, value)
 	}
 
-	// Obtain a lock to avoid clobbering competing installs
-	lockfile, err := d.awaitLock()
+	// GET asset w/ timeout
+	netClient := &http.Client{Timeout: fetchTimeout}
+	r, err := netClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("unable to obtain a lock for asset '%s' in a timely manner", d.asset.Name)
+		return nil, fmt.Errorf("error fetching asset: %s", err.Error())
 	}
-	defer lockfile.Unlock()
 
-	// Check that asset hasn't already been installed
-	if cached, err := d.isInstalled(); cached || err != nil {
-		return err
+	if r.StatusCode < 200 || r.StatusCode >= 300 {
+		_ = r.Body.Close()
+		return nil, fmt.Errorf("error fetching asset: unexpected status %s", r.Status)
 	}
 
-	// logger.WithFields(logrus.Fields{
-	//	"asset_name": d.asset.Name,
-	// }).Info("new dependency encountered; downloading")
+	return r.Body, nil
+}
 
-	// Download the asset
-	r, err := d.fetch()
+// Writes the asset fetched from the given location to the file, and checks
+// that it matches the checksum of the asset.
+func (d *RuntimeAsset) fetchTo(assetURL string, file *os.File) error {
+	r, err := d.fetch(assetURL)
 	if err != nil {
 		return err
 	}
+	defer r.Close()
 
-	// Write response to tmp
-	tmpFile, err := ioutil.TempFile(os.TempDir(), "sensu-asset")
-	if err != nil {
-		return fmt.Errorf("unable to obtain tmp file for asset '%s'", d.asset.Name)
+	// Rewind the file, which may hold the content of another mirror
+	if _, err := file.Seek(0, 0); err != nil {
+		return err
 	}
-	defer os.Remove(tmpFile.Name())
-
-	if _, err = io.Copy(tmpFile, r.Body); err != nil {
-		return fmt.Errorf("unable to write asset '%s' to tmp", d.asset.Name)
+	if err := file.Truncate(0); err != nil {
+		return err
 	}
 
-	// Ensure file contents are synced and rewound
-	tmpFile.Sync()
-	tmpFile.Seek(0, 0)
-
-	// Generate checksum for downloaded file
+	// Generate checksum while writing the file
 	h := sha512.New()
-	if _, err = io.Copy(h, tmpFile); err != nil {
-		return fmt.Errorf("generating checksum for asset failed: %s", err.Error())
+	if _, err = io.Copy(io.MultiWriter(file, h), r); err != nil {
+		return fmt.Errorf("unable to write asset '%s' to tmp: %s", d.asset.Name, err)
 	}
 
 	// Check that fetched file's checksum matches given
@@ -194,6 +220,120 @@ func (d *RuntimeAsset) install() error {
 		)
 	}
 
+	return file.Sync()
+}
+
+// Fetches the asset from its URL or, failing that, from its mirrors in order.
+func (d *RuntimeAsset) fetchAny(file *os.File) error {
+	var errs []string
+	for _, assetURL := range d.asset.URLs() {
+		err := d.fetchTo(assetURL, file)
+		if err == nil {
+			return nil
+		}
+
+		logger.WithError(err).WithField("url", assetURL).Warnf("unable to fetch asset '%s'", d.asset.Name)
+		errs = append(errs, err.Error())
+	}
+
+	return errors.New(strings.Join(errs, "; "))
+}
+
+// Ensures that the asset is installed and holds its lock, so it is not
+// removed from the cache until released.
+func (d *RuntimeAsset) acquire() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.users == 0 {
+		// Ensure that cache directory exists before we attempt to write the
+		// contents of our asset to it.
+		binDir := filepath.Join(d.path, "bin")
+		if err := os.MkdirAll(binDir, 0755); err != nil {
+			return fmt.Errorf("unable to create cache directory '%s': %s", d.path, err.Error())
+		}
+
+		// Obtain a lock to avoid clobbering competing installs
+		lockfile, err := d.awaitLock()
+		if err != nil {
+			return fmt.Errorf("unable to obtain a lock for asset '%s' in a timely manner", d.asset.Name)
+		}
+
+		if err := d.download(); err != nil {
+			_ = lockfile.Unlock()
+			return err
+		}
+		d.lockfile = lockfile
+	}
+	d.users++
+
+	if err := d.markAsUsed(); err != nil {
+		logger.WithError(err).Warnf("unable to record the use of asset '%s'", d.asset.Name)
+	}
+
+	return nil
+}
+
+// Releases the asset, unlocking it once it is no longer in use.
+func (d *RuntimeAsset) release() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.users == 0 {
+		return
+	}
+
+	d.users--
+	if d.users == 0 {
+		_ = d.lockfile.Unlock()
+		d.lockfile = nil
+	}
+}
+
+// Ensures that the asset is installed.
+func (d *RuntimeAsset) install() error {
+	if err := d.acquire(); err != nil {
+		return err
+	}
+	d.release()
+
+	return nil
+}
+
+// Downloads the given depdencies asset to the cache directory. The asset must
+// be locked.
+// TODO(james): ugly; too many responsibilities
+// nolint
+func (d *RuntimeAsset) download() error {
+	// Check that asset hasn't already been installed
+	if cached, err := d.isInstalled(); cached || err != nil {
+		return err
+	}
+
+	// logger.WithFields(logrus.Fields{
+	//	"asset_name": d.asset.Name,
+	// }).Info("new dependency encountered; downloading")
+
+	// Write the downloaded asset to tmp
+	tmpFile, err := ioutil.TempFile(os.TempDir(), "sensu-asset")
+	if err != nil {
+		return fmt.Errorf("unable to obtain tmp file for asset '%s'", d.asset.Name)
+	}
+	defer os.Remove(tmpFile.Name())
+	defer tmpFile.Close()
+
+	if err := d.fetchAny(tmpFile); err != nil {
+		return err
+	}
+
+	// Verify the signature of the asset
+	if d.verifier != nil {
+		tmpFile.Seek(0, 0)
+		if err := d.verifier.Verify(d.asset, tmpFile); err != nil {
+			return err
+		}
+	}
+
 	// Read header
 	header := make([]byte, 262)
 	tmpFile.Seek(0, 0)
@@ -225,8 +365,5 @@ func (d *RuntimeAsset) install() error {
 	// Write .completed file
 	d.markAsInstalled()
 
-	// Unlock directory so we allow others others to write again
-	lockfile.Unlock()
-
 	return nil
 }
@@ -68,9 +68,76 @@ func TestFetch(t *testing.T) {
 	defer server.Close()
 	defer test.Dispose(t)
 
-	res, err := test.runtimeAsset.fetch()
+	res, err := test.runtimeAsset.fetch(test.asset.URL)
 	require.NotNil(t, res)
 	require.NoError(t, err)
+	_ = res.Close()
+}
+
+func TestFetchHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer foo" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	asset := &RuntimeAsset{asset: &types.Asset{Name: "ruby24"}}
+	_, err := asset.fetch(server.URL)
+	assert.Error(t, err)
+
+	asset.asset.Headers = map[string]string{"Authorization": "Bearer foo"}
+	res, err := asset.fetch(server.URL)
+	require.NoError(t, err)
+	_ = res.Close()
+}
+
+func TestInstallMirrors(t *testing.T) {
+	server, test := newTest(t)
+	defer server.Close()
+	defer test.Dispose(t)
+
+	test.responseBody = readFixture("rubby-on-rails.tar")
+	test.asset.Sha512 = stringToSHA512(test.responseBody)
+
+	// The asset is fetched from the file mirror when its URL is unreachable
+	mirror := filepath.Join(test.workDir, "mirror.tar")
+	require.NoError(t, ioutil.WriteFile(mirror, []byte(test.responseBody), 0644))
+	test.asset.URL = "http://127.0.0.1:0/myfile"
+	test.asset.Mirrors = []string{"file://" + filepath.ToSlash(mirror)}
+	test.runtimeAsset.path = filepath.Join(test.workDir, "asset")
+
+	require.NoError(t, test.runtimeAsset.install())
+	installed, err := test.runtimeAsset.isInstalled()
+	require.NoError(t, err)
+	assert.True(t, installed)
+}
+
+func TestInstallSignature(t *testing.T) {
+	server, test := newTest(t)
+	defer server.Close()
+	defer test.Dispose(t)
+
+	test.responseBody = readFixture("rubby-on-rails.tar")
+	test.asset.Sha512 = stringToSHA512(test.responseBody)
+
+	keyring, signature := signAsset(t, test.workDir, test.responseBody)
+	verifier, err := NewSignatureVerifier([]string{keyring}, true)
+	require.NoError(t, err)
+	test.runtimeAsset.verifier = verifier
+
+	// Unsigned assets are rejected
+	test.runtimeAsset.path = filepath.Join(test.workDir, "unsigned")
+	assert.Error(t, test.runtimeAsset.install())
+
+	// Assets with a signature of another content are rejected
+	_, test.asset.Signature = signAsset(t, test.workDir, "foo")
+	test.runtimeAsset.path = filepath.Join(test.workDir, "invalid")
+	assert.Error(t, test.runtimeAsset.install())
+
+	test.asset.Signature = signature
+	test.runtimeAsset.path = filepath.Join(test.workDir, "signed")
+	assert.NoError(t, test.runtimeAsset.install())
 }
 
 func TestIsRelevant(t *testing.T) {
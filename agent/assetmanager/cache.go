@@ -0,0 +1,182 @@
+package assetmanager
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/nightlyone/lockfile"
+)
+
+const (
+	// file whose modification time is the last use of an asset
+	usedFile = ".used"
+
+	// length of the hex encoded sha512 naming the asset directories
+	sha512Length = 128
+)
+
+// A CachedAsset is an asset installed in a cache directory.
+type CachedAsset struct {
+	// Sha512 is the checksum of the asset, which names its directory
+	Sha512 string `json:"sha512"`
+
+	// Path is the directory of the asset
+	Path string `json:"path"`
+
+	// Size is the total size, in bytes, of the asset files
+	Size int64 `json:"size"`
+
+	// LastUsed is the last time the asset was used by an execution
+	LastUsed time.Time `json:"last_used"`
+
+	// InUse indicates that the asset is used by an execution of the agent
+	InUse bool `json:"in_use"`
+}
+
+// A PrunePolicy determines which assets are removed from a cache directory.
+// Assets that are in use are never removed.
+type PrunePolicy struct {
+	// MaxAge is the duration after which unused assets are removed. The age of
+	// assets is not limited if 0
+	MaxAge time.Duration
+
+	// MaxSize is the maximum size, in bytes, of the cache. The least recently
+	// used assets are removed beyond it. The size is not limited if 0
+	MaxSize int64
+
+	// All removes every asset
+	All bool
+}
+
+// ListCache returns the assets installed in the cache directory dir, from the
+// least to the most recently used. A missing directory holds no assets.
+func ListCache(dir string) ([]CachedAsset, error) {
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	assets := []CachedAsset{}
+	for _, file := range files {
+		if !file.IsDir() || !isSha512(file.Name()) {
+			continue
+		}
+
+		asset := CachedAsset{
+			Sha512:   file.Name(),
+			Path:     filepath.Join(dir, file.Name()),
+			LastUsed: file.ModTime(),
+		}
+
+		if info, err := os.Stat(filepath.Join(asset.Path, usedFile)); err == nil {
+			asset.LastUsed = info.ModTime()
+		}
+
+		if err := filepath.Walk(asset.Path, func(_ string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() {
+				asset.Size += info.Size()
+			}
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+
+		assets = append(assets, asset)
+	}
+
+	sort.Slice(assets, func(i, j int) bool {
+		return assets[i].LastUsed.Before(assets[j].LastUsed)
+	})
+
+	return assets, nil
+}
+
+// PruneCache removes the assets of the cache directory dir according to the
+// given policy, and returns the removed assets. Assets locked by another
+// process, while it installs or executes them, are kept.
+func PruneCache(dir string, policy PrunePolicy) ([]CachedAsset, error) {
+	return pruneCache(dir, policy, nil)
+}
+
+// holdFn holds the asset with the given checksum while it is removed, and
+// returns false if it is in use.
+type holdFn func(sha512 string) (release func(), ok bool)
+
+func pruneCache(dir string, policy PrunePolicy, hold holdFn) ([]CachedAsset, error) {
+	assets, err := ListCache(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var size int64
+	for _, asset := range assets {
+		size += asset.Size
+	}
+
+	now := time.Now()
+	removed := []CachedAsset{}
+	for _, asset := range assets {
+		expired := policy.MaxAge > 0 && now.Sub(asset.LastUsed) > policy.MaxAge
+		oversized := policy.MaxSize > 0 && size > policy.MaxSize
+		if !policy.All && !expired && !oversized {
+			continue
+		}
+
+		if !removeCachedAsset(asset, hold) {
+			continue
+		}
+
+		size -= asset.Size
+		removed = append(removed, asset)
+	}
+
+	return removed, nil
+}
+
+// Removes the asset directory unless the asset is in use, and returns true if
+// it was removed.
+func removeCachedAsset(asset CachedAsset, hold holdFn) bool {
+	if hold != nil {
+		release, ok := hold(asset.Sha512)
+		if !ok {
+			return false
+		}
+		defer release()
+	}
+
+	// The lock is held by the installations and executions of the asset
+	lock, err := lockfile.New(filepath.Join(asset.Path, ".lock"))
+	if err != nil {
+		logger.WithError(err).Error("unable to create lockfile")
+		return false
+	}
+	if err := lock.TryLock(); err != nil {
+		logger.WithError(err).Debugf("asset '%s' is locked, skipping", asset.Sha512)
+		return false
+	}
+
+	if err := os.RemoveAll(asset.Path); err != nil {
+		logger.WithError(err).Errorf("unable to remove asset '%s'", asset.Sha512)
+		_ = lock.Unlock()
+		return false
+	}
+
+	return true
+}
+
+func isSha512(name string) bool {
+	if len(name) != sha512Length {
+		return false
+	}
+	_, err := hex.DecodeString(name)
+	return err == nil
+}
@@ -0,0 +1,156 @@
+package assetmanager
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCacheDir(t *testing.T) string {
+	dir, err := ioutil.TempDir(os.TempDir(), "agent-cache-")
+	require.NoError(t, err)
+	return dir
+}
+
+// Installs a fake asset, of the given content, last used at the given time
+func writeCachedAsset(t *testing.T, dir, content string, lastUsed time.Time) CachedAsset {
+	sha512 := stringToSHA512(content)
+	path := filepath.Join(dir, sha512)
+	require.NoError(t, os.MkdirAll(filepath.Join(path, "bin"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(path, "bin", "asset"), []byte(content), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(path, usedFile), []byte{}, 0644))
+	require.NoError(t, os.Chtimes(filepath.Join(path, usedFile), lastUsed, lastUsed))
+
+	return CachedAsset{Sha512: sha512, Path: path, Size: int64(len(content))}
+}
+
+func TestListCache(t *testing.T) {
+	dir := newCacheDir(t)
+	defer os.RemoveAll(dir)
+
+	now := time.Now()
+	recent := writeCachedAsset(t, dir, "recent", now)
+	old := writeCachedAsset(t, dir, "old", now.Add(-time.Hour))
+
+	// Other cached data is ignored
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "events.db"), []byte("foo"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "deps"), 0755))
+
+	assets, err := ListCache(dir)
+	require.NoError(t, err)
+	require.Len(t, assets, 2)
+	assert.Equal(t, old.Sha512, assets[0].Sha512)
+	assert.Equal(t, recent.Sha512, assets[1].Sha512)
+	assert.Equal(t, int64(len("old")), assets[0].Size)
+	assert.WithinDuration(t, now.Add(-time.Hour), assets[0].LastUsed, time.Second)
+
+	assets, err = ListCache(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, assets)
+}
+
+func TestPruneCache(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name     string
+		policy   PrunePolicy
+		expected []string
+	}{
+		{
+			name:     "no limits",
+			policy:   PrunePolicy{},
+			expected: []string{},
+		},
+		{
+			name:     "max age",
+			policy:   PrunePolicy{MaxAge: 90 * time.Minute},
+			expected: []string{"oldest"},
+		},
+		{
+			name:     "max size",
+			policy:   PrunePolicy{MaxSize: 10},
+			expected: []string{"oldest", "older"},
+		},
+		{
+			name:     "all",
+			policy:   PrunePolicy{All: true},
+			expected: []string{"oldest", "older", "recent"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := newCacheDir(t)
+			defer os.RemoveAll(dir)
+
+			assets := map[string]CachedAsset{
+				"oldest": writeCachedAsset(t, dir, "oldest", now.Add(-2*time.Hour)),
+				"older":  writeCachedAsset(t, dir, "older", now.Add(-time.Hour)),
+				"recent": writeCachedAsset(t, dir, "recent", now),
+			}
+
+			removed, err := PruneCache(dir, tc.policy)
+			require.NoError(t, err)
+
+			removedNames := []string{}
+			for _, asset := range removed {
+				for name, a := range assets {
+					if a.Sha512 == asset.Sha512 {
+						removedNames = append(removedNames, name)
+					}
+				}
+				_, err := os.Stat(asset.Path)
+				assert.True(t, os.IsNotExist(err))
+			}
+			assert.Equal(t, tc.expected, removedNames)
+		})
+	}
+}
+
+func TestPruneCacheLocked(t *testing.T) {
+	dir := newCacheDir(t)
+	defer os.RemoveAll(dir)
+
+	asset := writeCachedAsset(t, dir, "locked", time.Now())
+
+	// The asset is locked by another running process
+	lock := fmt.Sprintf("%d\n", os.Getppid())
+	require.NoError(t, ioutil.WriteFile(filepath.Join(asset.Path, ".lock"), []byte(lock), 0644))
+
+	removed, err := PruneCache(dir, PrunePolicy{All: true})
+	require.NoError(t, err)
+	assert.Empty(t, removed)
+	_, err = os.Stat(asset.Path)
+	assert.NoError(t, err)
+}
+
+func TestManagerPrune(t *testing.T) {
+	test := newManagerTest(t)
+	defer test.Dispose(t)
+
+	cached := writeCachedAsset(t, test.cacheDir, "in use", time.Now())
+	asset := types.FixtureAsset("asset")
+	asset.Sha512 = cached.Sha512
+	runtimeAsset := test.manager.store.FetchAsset(asset, test.manager.factory.NewAsset)
+
+	// The asset is in use by an execution
+	runtimeAsset.users = 1
+	removed, err := test.manager.Prune(PrunePolicy{All: true})
+	require.NoError(t, err)
+	assert.Empty(t, removed)
+	_, err = os.Stat(cached.Path)
+	assert.NoError(t, err)
+
+	runtimeAsset.users = 0
+	removed, err = test.manager.Prune(PrunePolicy{All: true})
+	require.NoError(t, err)
+	assert.Len(t, removed, 1)
+}
@@ -10,11 +10,16 @@ type AssetFactory struct {
 
 	// CacheDir is the directory where assets are stored
 	CacheDir string
+
+	// Verifier verifies the signatures of the assets, if set
+	Verifier *SignatureVerifier
 }
 
 // NewAsset returns a new RuntimeAsset given an asset
 func (factory AssetFactory) NewAsset(asset *types.Asset) *RuntimeAsset {
-	return NewRuntimeAsset(asset, factory.CacheDir)
+	runtimeAsset := NewRuntimeAsset(asset, factory.CacheDir)
+	runtimeAsset.verifier = factory.Verifier
+	return runtimeAsset
 }
 
 // NewAssetSet returns a new RuntimeAsset given an asset
@@ -1,11 +1,18 @@
 package assetmanager
 
 import (
+	"bytes"
 	"crypto/sha512"
 	"encoding/hex"
 	"io/ioutil"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
 )
 
 func readFixture(file string) string {
@@ -20,3 +27,30 @@ func stringToSHA512(str string) string {
 	_, _ = h.Write([]byte(str))
 	return hex.EncodeToString(h.Sum(nil))
 }
+
+// signAsset signs the content with a new key, whose public key is written to
+// a keyring in dir, and returns the keyring path and the armored signature.
+func signAsset(t *testing.T, dir, content string) (string, string) {
+	entity, err := openpgp.NewEntity("sensu", "", "sensu@localhost", nil)
+	require.NoError(t, err)
+
+	var signature bytes.Buffer
+	require.NoError(t, openpgp.ArmoredDetachSign(&signature, entity, strings.NewReader(content), nil))
+
+	// Self-sign the identities of the key so that it can be serialized
+	require.NoError(t, entity.SerializePrivate(ioutil.Discard, nil))
+
+	var keyring bytes.Buffer
+	w, err := armor.Encode(&keyring, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+
+	keyringFile, err := ioutil.TempFile(dir, "keyring")
+	require.NoError(t, err)
+	_, err = keyringFile.Write(keyring.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, keyringFile.Close())
+
+	return keyringFile.Name(), signature.String()
+}
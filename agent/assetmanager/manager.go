@@ -56,6 +56,12 @@ func (mngrPtr *Manager) SetCacheDir(baseDir string) {
 	mngrPtr.store.Clear()
 }
 
+// SetVerifier sets the verifier of the asset signatures
+func (mngrPtr *Manager) SetVerifier(verifier *SignatureVerifier) {
+	mngrPtr.factory.Verifier = verifier
+	mngrPtr.store.Clear()
+}
+
 // Reset clears all knownAssets and env from state, this forces the agent to
 // recompute the next time a check is run.
 //
@@ -65,6 +71,44 @@ func (mngrPtr *Manager) Reset() {
 	mngrPtr.store.Clear()
 }
 
+// List returns the assets of the cache directory, from the least to the most
+// recently used, indicating the ones in use.
+func (mngrPtr *Manager) List() ([]CachedAsset, error) {
+	assets, err := ListCache(mngrPtr.factory.CacheDir)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range assets {
+		if asset := mngrPtr.store.getAsset(assets[i].Sha512); asset != nil {
+			asset.mu.Lock()
+			assets[i].InUse = asset.users > 0
+			asset.mu.Unlock()
+		}
+	}
+
+	return assets, nil
+}
+
+// Prune removes the assets of the cache directory according to the given
+// policy, except the ones in use, and returns the removed assets.
+func (mngrPtr *Manager) Prune(policy PrunePolicy) ([]CachedAsset, error) {
+	return pruneCache(mngrPtr.factory.CacheDir, policy, func(sha512 string) (func(), bool) {
+		asset := mngrPtr.store.getAsset(sha512)
+		if asset == nil {
+			return func() {}, true
+		}
+
+		// Hold the asset so that it can't be acquired while being removed
+		asset.mu.Lock()
+		if asset.users > 0 {
+			asset.mu.Unlock()
+			return nil, false
+		}
+		return asset.mu.Unlock, true
+	})
+}
+
 // Get system ENV variables and append any PATH, LD_LIBRARY_PATH,  & CPATH if
 // missing.
 func getSystemEnviron() []string {
@@ -33,6 +33,28 @@ func (setPtr *RuntimeAssetSet) InstallAll() error {
 	return nil
 }
 
+// Acquire - ensures that all assets are installed and holds them until
+// Release is called, so they are not removed from the cache while in use
+func (setPtr *RuntimeAssetSet) Acquire() error {
+	for i, asset := range setPtr.assets {
+		if err := asset.acquire(); err != nil {
+			for _, acquired := range setPtr.assets[:i] {
+				acquired.release()
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Release - releases the assets held by Acquire
+func (setPtr *RuntimeAssetSet) Release() {
+	for _, asset := range setPtr.assets {
+		asset.release()
+	}
+}
+
 // Env - includes all environment
 func (setPtr *RuntimeAssetSet) Env() []string {
 	return setPtr.env
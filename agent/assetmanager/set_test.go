@@ -103,3 +103,26 @@ func TestManagerInstall(t *testing.T) {
 
 	require.NoError(t, test.assetSet.InstallAll())
 }
+
+func TestManagerAcquire(t *testing.T) {
+	server, test := newAssetTest(t)
+	defer server.Close()
+	defer test.Dispose(t)
+
+	require.NoError(t, test.assetSet.Acquire())
+	require.NoError(t, test.assetSet.Acquire())
+	assert.Equal(t, 2, test.asset.users)
+	_, err := os.Stat(filepath.Join(test.asset.path, ".lock"))
+	assert.NoError(t, err)
+	_, err = os.Stat(filepath.Join(test.asset.path, usedFile))
+	assert.NoError(t, err)
+
+	test.assetSet.Release()
+	_, err = os.Stat(filepath.Join(test.asset.path, ".lock"))
+	assert.NoError(t, err)
+
+	test.assetSet.Release()
+	assert.Equal(t, 0, test.asset.users)
+	_, err = os.Stat(filepath.Join(test.asset.path, ".lock"))
+	assert.True(t, os.IsNotExist(err), "unlocks the asset once released")
+}
@@ -0,0 +1,71 @@
+package assetmanager
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/sensu/sensu-go/types"
+	"golang.org/x/crypto/openpgp"
+)
+
+// A SignatureVerifier verifies the detached signatures of assets against a set
+// of trusted public keys.
+type SignatureVerifier struct {
+	keyring openpgp.EntityList
+
+	// Require rejects the assets without signature
+	Require bool
+}
+
+// NewSignatureVerifier returns a verifier trusting the public keys of the
+// given ASCII armored keyring files.
+func NewSignatureVerifier(keyringPaths []string, require bool) (*SignatureVerifier, error) {
+	verifier := &SignatureVerifier{Require: require}
+
+	for _, path := range keyringPaths {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+
+		keys, err := openpgp.ReadArmoredKeyRing(file)
+		_ = file.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read keyring '%s': %s", path, err)
+		}
+
+		verifier.keyring = append(verifier.keyring, keys...)
+	}
+
+	if require && len(verifier.keyring) == 0 {
+		return nil, errors.New("signatures can't be required without trusted keys")
+	}
+
+	return verifier, nil
+}
+
+// Verify checks that the signature of the asset was made by one of the trusted
+// keys over the content of r. Assets without signature are only rejected if
+// signatures are required.
+func (v *SignatureVerifier) Verify(asset *types.Asset, r io.Reader) error {
+	if asset.Signature == "" {
+		if v.Require {
+			return fmt.Errorf("asset '%s' is not signed", asset.Name)
+		}
+		return nil
+	}
+
+	if len(v.keyring) == 0 {
+		return fmt.Errorf("no trusted keys to verify the signature of asset '%s'", asset.Name)
+	}
+
+	signature := strings.NewReader(asset.Signature)
+	if _, err := openpgp.CheckArmoredDetachedSignature(v.keyring, r, signature); err != nil {
+		return fmt.Errorf("invalid signature for asset '%s': %s", asset.Name, err)
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/sensu/sensu-go/agent/transformers"
 	"github.com/sensu/sensu-go/command"
 	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
@@ -22,41 +23,60 @@ func (a *Agent) handleCheck(payload []byte) error {
 		return errors.New("given check configuration appears invalid")
 	}
 
-	// only schedule check execution if its not already in progress
+	return a.scheduleCheck(request)
+}
+
+// scheduleCheck executes the requested check, unless an execution of the check
+// is already in progress.
+func (a *Agent) scheduleCheck(request *types.CheckRequest) error {
+	// The executions are waited for when the agent stops, and no longer
+	// accepted once it does. Only one execution of a check can be in progress
 	// ** check hooks are part of a checks execution
-	a.inProgressMu.Lock()
-	_, in := a.inProgress[request.Config.Name]
-	a.inProgressMu.Unlock()
-	if !in {
-		logger.Info("scheduling check execution: ", request.Config.Name)
-
-		if ok := a.prepareCheck(request.Config); !ok {
-			// An error occured during the preparation of the check and the error has
-			// been sent back to the server. At this point we should not execute the
-			// check and wait for the next check request
-			return nil
-		}
+	if err := a.startCheck(request.Config); err != nil {
+		return err
+	}
+	logger.Info("scheduling check execution: ", request.Config.Name)
 
-		go a.executeCheck(request)
-	} else {
-		return fmt.Errorf("check execution still in progress: %s", request.Config.Name)
+	// Remember the check, before its token substitution, so it can be
+	// executed through the API
+	a.storeCheckRequest(request)
+
+	if ok := a.prepareCheck(request.Config); !ok {
+		// An error occured during the preparation of the check and the error has
+		// been sent back to the server. At this point we should not execute the
+		// check and wait for the next check request
+		a.finishCheck(request.Config)
+		return nil
 	}
 
+	go func() {
+		defer a.finishCheck(request.Config)
+		a.executeCheck(request)
+	}()
+
 	return nil
 }
 
 func (a *Agent) executeCheck(request *types.CheckRequest) {
-	a.inProgressMu.Lock()
-	a.inProgress[request.Config.Name] = request.Config
-	a.inProgressMu.Unlock()
-	defer func() {
-		a.inProgressMu.Lock()
-		delete(a.inProgress, request.Config.Name)
-		a.inProgressMu.Unlock()
-	}()
+	event, err := a.runCheck(request)
+	if err != nil {
+		a.sendFailure(event, err)
+		return
+	}
+
+	msg, err := json.Marshal(event)
+	if err != nil {
+		logger.WithError(err).Error("error marshaling check result")
+		return
+	}
+
+	a.sendMessage(transport.MessageTypeEvent, msg)
+}
 
+// runCheck executes the requested check and returns the resulting event. If the
+// check could not be executed, the event is returned along with the error.
+func (a *Agent) runCheck(request *types.CheckRequest) (*types.Event, error) {
 	checkConfig := request.Config
-	checkAssets := request.Assets
 	checkHooks := request.Hooks
 
 	// Instantiate Event
@@ -66,9 +86,39 @@ func (a *Agent) executeCheck(request *types.CheckRequest) {
 		Check: check,
 	}
 
+	// Prometheus checks scrape an endpoint rather than executing a command
+	if checkConfig.Prometheus != nil {
+		a.scrapePrometheus(event)
+	} else if err := a.executeCommand(request, event); err != nil {
+		return event, err
+	}
+
+	checksExecuted.WithLabelValues(checkConfig.Name).Inc()
+	checkDuration.WithLabelValues(checkConfig.Name).Observe(event.Check.Duration)
+
+	event.Entity = a.getAgentEntity()
+	event.Timestamp = time.Now().Unix()
+
+	if len(checkHooks) != 0 {
+		event.Check.Hooks = a.ExecuteHooks(request, int(event.Check.Status))
+	}
+
+	// Extract the metrics from the check output if a metric format is provided
+	if checkConfig.OutputMetricFormat != "" {
+		a.extractMetrics(event)
+	}
+
+	return event, nil
+}
+
+// executeCommand executes the command of the check and records its result in
+// the event. An error is returned if the command could not be executed.
+func (a *Agent) executeCommand(request *types.CheckRequest, event *types.Event) error {
+	checkConfig := request.Config
+
 	// Ensure that the asset manager is aware of all the assets required to
 	// execute the given check.
-	assets := a.assetManager.RegisterSet(checkAssets)
+	assets := a.assetManager.RegisterSet(request.Assets)
 
 	// Inject the dependenices into PATH, LD_LIBRARY_PATH & CPATH so that they are
 	// availabe when when the command is executed.
@@ -78,45 +128,128 @@ func (a *Agent) executeCheck(request *types.CheckRequest) {
 		Timeout: int(checkConfig.Timeout),
 	}
 
+	applySandbox(ex, checkConfig.Sandbox)
+
 	// If stdin is true, add JSON event data to command execution.
 	if checkConfig.Stdin {
 		input, err := json.Marshal(event)
 		if err != nil {
-			a.sendFailure(event, fmt.Errorf("error marshaling json from event: %s", err))
-			return
+			return fmt.Errorf("error marshaling json from event: %s", err)
 		}
 		ex.Input = string(input)
 	}
 
-	// Ensure that all the dependencies are installed.
-	if err := assets.InstallAll(); err != nil {
-		a.sendFailure(event, fmt.Errorf("error installing dependencies: %s", err))
-		return
+	// Ensure that all the dependencies are installed, and keep them in the
+	// cache until the check is executed.
+	if err := assets.Acquire(); err != nil {
+		return fmt.Errorf("error installing dependencies: %s", err)
 	}
+	defer assets.Release()
 
 	if _, err := command.ExecuteCommand(context.Background(), ex); err != nil {
 		event.Check.Output = err.Error()
 	} else {
 		event.Check.Output = ex.Output
+		event.Check.Stdout = ex.Stdout
+		event.Check.Stderr = ex.Stderr
+		event.Check.OutputTruncated = ex.OutputTruncated
 	}
 
 	event.Check.Duration = ex.Duration
 	event.Check.Status = uint32(ex.Status)
 
-	event.Entity = a.getAgentEntity()
-	event.Timestamp = time.Now().Unix()
+	return nil
+}
 
-	if len(checkHooks) != 0 {
-		event.Check.Hooks = a.ExecuteHooks(request, ex.Status)
+// applySandbox restricts the execution of a check or hook command with the
+// sandbox of the check, if any.
+func applySandbox(ex *command.Execution, sandbox *types.Sandbox) {
+	if sandbox == nil {
+		return
 	}
 
-	msg, err := json.Marshal(event)
+	ex.User = sandbox.User
+	ex.Group = sandbox.Group
+	ex.Limits = command.ResourceLimits{
+		CPUTime:   sandbox.CPUTime,
+		Memory:    sandbox.Memory,
+		OpenFiles: sandbox.OpenFiles,
+		Processes: sandbox.Processes,
+	}
+	ex.MaxOutputSize = int(sandbox.MaxOutputSize)
+}
+
+// storeCheckRequest keeps a copy of the check request, replacing the previous
+// request of the check
+func (a *Agent) storeCheckRequest(request *types.CheckRequest) {
+	config, err := copyCheckConfig(request.Config)
 	if err != nil {
-		logger.WithError(err).Error("error marshaling check result")
+		logger.WithError(err).Error("could not copy the check configuration")
 		return
 	}
 
-	a.sendMessage(transport.MessageTypeEvent, msg)
+	a.checkRequestsMu.Lock()
+	defer a.checkRequestsMu.Unlock()
+	a.checkRequests[config.Name] = &types.CheckRequest{
+		Config: config,
+		Assets: request.Assets,
+		Hooks:  request.Hooks,
+	}
+}
+
+// getCheckRequest returns a copy of the last request of the check, or nil if
+// the check is unknown
+func (a *Agent) getCheckRequest(name string) (*types.CheckRequest, error) {
+	a.checkRequestsMu.Lock()
+	request, ok := a.checkRequests[name]
+	a.checkRequestsMu.Unlock()
+	if !ok {
+		return nil, nil
+	}
+
+	config, err := copyCheckConfig(request.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CheckRequest{
+		Config: config,
+		Assets: request.Assets,
+		Hooks:  request.Hooks,
+	}, nil
+}
+
+// copyCheckConfig returns a deep copy of the check configuration
+func copyCheckConfig(check *types.CheckConfig) (*types.CheckConfig, error) {
+	b, err := check.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	config := &types.CheckConfig{}
+	if err := config.Unmarshal(b); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// extractMetrics parses the output of the check according to its output metric
+// format and adds the extracted metric points to the event
+func (a *Agent) extractMetrics(event *types.Event) {
+	points, err := transformers.Transform(event.Check.OutputMetricFormat, event.Check.Output, event.Check.Executed)
+	if err != nil {
+		logger.WithError(err).Error("unable to extract metrics from check output")
+		return
+	}
+	if len(points) == 0 {
+		return
+	}
+
+	event.Metrics = &types.Metrics{
+		Handlers: event.Check.OutputMetricHandlers,
+		Points:   points,
+	}
 }
 
 // prepareCheck prepares a check before its execution by validating the
@@ -136,38 +269,43 @@ func (a *Agent) prepareCheck(cfg *types.CheckConfig) bool {
 		return false
 	}
 
+	if err := a.substituteTokens(cfg); err != nil {
+		a.sendFailure(event, err)
+		return false
+	}
+
+	return true
+}
+
+// substituteTokens substitutes the tokens within the check configuration with
+// the attributes of the agent entity
+func (a *Agent) substituteTokens(cfg *types.CheckConfig) error {
 	// Extract the extended attributes from the entity and combine them at the
 	// top-level so they can be easily accessed using token substitution
 	synthesizedEntity, err := dynamic.Synthesize(a.getAgentEntity())
 	if err != nil {
-		a.sendFailure(event, fmt.Errorf("could not synthesize the entity: %s", err))
-		return false
+		return fmt.Errorf("could not synthesize the entity: %s", err)
 	}
 
 	// Substitute tokens within the check configuration with the synthesized
 	// entity
 	checkBytes, err := TokenSubstitution(synthesizedEntity, cfg)
 	if err != nil {
-		a.sendFailure(event, err)
-		return false
+		return err
 	}
 
 	// Unmarshal the check configuration obtained after the token substitution
 	// back into the check config struct
 	err = json.Unmarshal(checkBytes, cfg)
 	if err != nil {
-		a.sendFailure(event, fmt.Errorf("could not unmarshal the check: %s", err))
-		return false
+		return fmt.Errorf("could not unmarshal the check: %s", err)
 	}
 
-	return true
+	return nil
 }
 
 func (a *Agent) sendFailure(event *types.Event, err error) {
-	event.Check.Output = err.Error()
-	event.Check.Status = 3
-	event.Entity = a.getAgentEntity()
-	event.Timestamp = time.Now().Unix()
+	a.failCheck(event, err)
 
 	if msg, err := json.Marshal(event); err != nil {
 		logger.WithError(err).Error("error marshaling check failure")
@@ -175,3 +313,11 @@ func (a *Agent) sendFailure(event *types.Event, err error) {
 		a.sendMessage(transport.MessageTypeEvent, msg)
 	}
 }
+
+// failCheck marks the check of the event as failed with the given error
+func (a *Agent) failCheck(event *types.Event, err error) {
+	event.Check.Output = err.Error()
+	event.Check.Status = 3
+	event.Entity = a.getAgentEntity()
+	event.Timestamp = time.Now().Unix()
+}
@@ -94,6 +94,21 @@ func TestExecuteCheck(t *testing.T) {
 	assert.NoError(json.Unmarshal(msg.Payload, event))
 	assert.NotZero(event.Timestamp)
 	assert.EqualValues(int32(2), event.Check.Status)
+
+	checkConfig.Command = "echo foobarbaz && echo quxquuxquuz 1>&2"
+	checkConfig.Timeout = 10
+	checkConfig.Sandbox = &types.Sandbox{MaxOutputSize: 6}
+
+	agent.executeCheck(request)
+
+	msg = <-ch
+
+	event = &types.Event{}
+	assert.NoError(json.Unmarshal(msg.Payload, event))
+	assert.Equal("foobar", event.Check.Output)
+	assert.Equal("foobar", event.Check.Stdout)
+	assert.Equal("quxquu", event.Check.Stderr)
+	assert.True(event.Check.OutputTruncated)
 }
 
 func TestPrepareCheck(t *testing.T) {
@@ -111,3 +126,34 @@ func TestPrepareCheck(t *testing.T) {
 	check.Interval = 60
 	assert.True(agent.prepareCheck(check))
 }
+
+func TestExtractMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	config := FixtureConfig()
+	agent := NewAgent(config)
+
+	// Valid metrics
+	event := types.FixtureEvent("entity", "check")
+	event.Check.Output = "foo.bar 42 1520000000\nfoo.baz 21 1520000000"
+	event.Check.OutputMetricFormat = types.GraphiteOutputMetricFormat
+	event.Check.OutputMetricHandlers = []string{"influxdb"}
+	agent.extractMetrics(event)
+	assert.NotNil(event.Metrics)
+	assert.Equal([]string{"influxdb"}, event.Metrics.Handlers)
+	assert.Len(event.Metrics.Points, 2)
+
+	// No metrics in the output
+	event = types.FixtureEvent("entity", "check")
+	event.Check.Output = "OK"
+	event.Check.OutputMetricFormat = types.NagiosOutputMetricFormat
+	agent.extractMetrics(event)
+	assert.Nil(event.Metrics)
+
+	// Unknown metric format
+	event = types.FixtureEvent("entity", "check")
+	event.Check.Output = "foo.bar 42 1520000000"
+	event.Check.OutputMetricFormat = "foo"
+	agent.extractMetrics(event)
+	assert.Nil(event.Metrics)
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sensu/sensu-go/agent/assetmanager"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagAll = "all"
+)
+
+func newAssetsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assets",
+		Short: "Manage the assets installed in the cache of the sensu agent",
+	}
+
+	cmd.AddCommand(newAssetsListCommand())
+	cmd.AddCommand(newAssetsPruneCommand())
+
+	return cmd
+}
+
+func newAssetsListCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the assets of the cache, from the least to the most recently used",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			assets, err := assetmanager.ListCache(viper.GetString(flagCacheDir))
+			if err != nil {
+				return err
+			}
+
+			printAssets(os.Stdout, assets)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagCacheDir, viper.GetString(flagCacheDir), "path to store cached data")
+
+	return cmd
+}
+
+func newAssetsPruneCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Remove the assets of the cache that are not in use",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := viper.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			policy := assetmanager.PrunePolicy{
+				MaxAge:  time.Duration(viper.GetInt(flagAssetCacheMaxAge)) * time.Second,
+				MaxSize: viper.GetInt64(flagAssetCacheMaxSize),
+				All:     viper.GetBool(flagAll),
+			}
+			if policy.MaxAge == 0 && policy.MaxSize == 0 && !policy.All {
+				return errors.New("one of the --asset-cache-max-age, --asset-cache-max-size or --all flags is required")
+			}
+
+			removed, err := assetmanager.PruneCache(viper.GetString(flagCacheDir), policy)
+			if err != nil {
+				return err
+			}
+
+			printAssets(os.Stdout, removed)
+			return nil
+		},
+	}
+
+	cmd.Flags().Bool(flagAll, false, "remove all the assets that are not in use")
+	cmd.Flags().Int(flagAssetCacheMaxAge, viper.GetInt(flagAssetCacheMaxAge), "number of seconds after which unused assets are removed")
+	cmd.Flags().Int64(flagAssetCacheMaxSize, viper.GetInt64(flagAssetCacheMaxSize), "maximum size, in bytes, of the cache beyond which the least recently used assets are removed")
+	cmd.Flags().String(flagCacheDir, viper.GetString(flagCacheDir), "path to store cached data")
+
+	return cmd
+}
+
+func printAssets(w io.Writer, assets []assetmanager.CachedAsset) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "SHA512\tSIZE\tLAST USED")
+	for _, asset := range assets {
+		fmt.Fprintf(tw, "%s\t%d\t%s\n",
+			asset.Sha512[:12],
+			asset.Size,
+			asset.LastUsed.Format(time.RFC3339),
+		)
+	}
+	_ = tw.Flush()
+}
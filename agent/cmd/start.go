@@ -12,6 +12,7 @@ import (
 
 	"github.com/Sirupsen/logrus"
 	"github.com/sensu/sensu-go/agent"
+	"github.com/sensu/sensu-go/system"
 	"github.com/sensu/sensu-go/types/dynamic"
 	"github.com/sensu/sensu-go/util/path"
 	"github.com/sensu/sensu-go/util/url"
@@ -31,26 +32,39 @@ const (
 	DefaultBackendPort = "8081"
 
 	flagAgentID               = "id"
+	flagAPIEnableExecution    = "api-enable-execution"
 	flagAPIHost               = "api-host"
 	flagAPIPort               = "api-port"
+	flagAssetCacheMaxAge      = "asset-cache-max-age"
+	flagAssetCacheMaxSize     = "asset-cache-max-size"
+	flagAssetRequireSignature = "asset-require-signature"
+	flagAssetTrustedKeys      = "asset-trusted-keys"
 	flagBackendURL            = "backend-url"
 	flagCacheDir              = "cache-dir"
 	flagConfigFile            = "config-file"
 	flagDeregister            = "deregister"
 	flagDeregistrationHandler = "deregistration-handler"
 	flagEnvironment           = "environment"
+	flagEventQueueMaxAge      = "event-queue-max-age"
+	flagEventQueueMaxSize     = "event-queue-max-size"
 	flagExtendedAttributes    = "custom-attributes"
+	flagInventoryInterval     = "inventory-interval"
 	flagKeepaliveInterval     = "keepalive-interval"
 	flagKeepaliveTimeout      = "keepalive-timeout"
 	flagOrganization          = "organization"
 	flagPassword              = "password"
 	flagRedact                = "redact"
+	flagShutdownTimeout       = "shutdown-timeout"
 	flagSocketHost            = "socket-host"
 	flagSocketPort            = "socket-port"
+	flagStandaloneChecksDir   = "standalone-checks-dir"
+	flagStatsdEventHandlers   = "statsd-event-handlers"
+	flagStatsdEventTags       = "statsd-event-tags"
 	flagStatsdFlushInterval   = "statsd-flush-interval"
 	flagStatsdMetricsHost     = "statsd-metrics-host"
 	flagStatsdMetricsPort     = "statsd-metrics-port"
 	flagSubscriptions         = "subscriptions"
+	flagSystemCollectors      = "system-collectors"
 	flagUser                  = "user"
 	flagDisableAPI            = "disable-api"
 	flagDisableSockets        = "disable-sockets"
@@ -64,6 +78,7 @@ func init() {
 
 	rootCmd.AddCommand(newVersionCommand())
 	rootCmd.AddCommand(newStartCommand())
+	rootCmd.AddCommand(newAssetsCommand())
 
 	viper.SetEnvPrefix("sensu")
 	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
@@ -109,19 +124,29 @@ func newStartCommand() *cobra.Command {
 			}
 
 			cfg := agent.NewConfig()
+			cfg.API.EnableExecution = viper.GetBool(flagAPIEnableExecution)
 			cfg.API.Host = viper.GetString(flagAPIHost)
 			cfg.API.Port = viper.GetInt(flagAPIPort)
+			cfg.AssetCacheMaxAge = viper.GetInt(flagAssetCacheMaxAge)
+			cfg.AssetCacheMaxSize = viper.GetInt64(flagAssetCacheMaxSize)
+			cfg.AssetRequireSignature = viper.GetBool(flagAssetRequireSignature)
+			cfg.AssetTrustedKeys = viper.GetStringSlice(flagAssetTrustedKeys)
 			cfg.CacheDir = viper.GetString(flagCacheDir)
 			cfg.Deregister = viper.GetBool(flagDeregister)
 			cfg.DeregistrationHandler = viper.GetString(flagDeregistrationHandler)
 			cfg.Environment = viper.GetString(flagEnvironment)
+			cfg.EventQueueMaxAge = viper.GetInt(flagEventQueueMaxAge)
+			cfg.EventQueueMaxSize = viper.GetInt64(flagEventQueueMaxSize)
 			cfg.ExtendedAttributes = []byte(viper.GetString(flagExtendedAttributes))
+			cfg.InventoryInterval = viper.GetInt(flagInventoryInterval)
 			cfg.KeepaliveInterval = viper.GetInt(flagKeepaliveInterval)
 			cfg.KeepaliveTimeout = uint32(viper.GetInt(flagKeepaliveTimeout))
 			cfg.Organization = viper.GetString(flagOrganization)
 			cfg.Password = viper.GetString(flagPassword)
+			cfg.ShutdownTimeout = viper.GetInt(flagShutdownTimeout)
 			cfg.Socket.Host = viper.GetString(flagSocketHost)
 			cfg.Socket.Port = viper.GetInt(flagSocketPort)
+			cfg.StandaloneChecksDir = viper.GetString(flagStandaloneChecksDir)
 			cfg.StatsdServer.FlushInterval = viper.GetInt(flagStatsdFlushInterval)
 			cfg.StatsdServer.Host = viper.GetString(flagStatsdMetricsHost)
 			cfg.StatsdServer.Port = viper.GetInt(flagStatsdMetricsPort)
@@ -156,6 +181,35 @@ func newStartCommand() *cobra.Command {
 				cfg.Subscriptions = viper.GetStringSlice(flagSubscriptions)
 			}
 
+			// Get a single or a list of statsd event handlers
+			statsdHandlers := viper.GetString(flagStatsdEventHandlers)
+			if statsdHandlers != "" {
+				cfg.StatsdServer.Handlers = splitAndTrim(statsdHandlers)
+			} else {
+				cfg.StatsdServer.Handlers = viper.GetStringSlice(flagStatsdEventHandlers)
+			}
+
+			// Get a single or a list of statsd event tags
+			statsdTags := viper.GetString(flagStatsdEventTags)
+			if statsdTags != "" {
+				cfg.StatsdServer.Tags = splitAndTrim(statsdTags)
+			} else {
+				cfg.StatsdServer.Tags = viper.GetStringSlice(flagStatsdEventTags)
+			}
+
+			// Get a single or a list of system collectors
+			collectors := viper.GetString(flagSystemCollectors)
+			if collectors != "" {
+				cfg.SystemCollectors = splitAndTrim(collectors)
+			} else {
+				cfg.SystemCollectors = viper.GetStringSlice(flagSystemCollectors)
+			}
+			for _, name := range cfg.SystemCollectors {
+				if _, ok := system.Collectors[name]; !ok {
+					return fmt.Errorf("unknown system collector %q", name)
+				}
+			}
+
 			sensuAgent := agent.NewAgent(cfg)
 			if err := sensuAgent.Run(); err != nil {
 				return err
@@ -208,24 +262,37 @@ func newStartCommand() *cobra.Command {
 
 	// Flag defaults
 	viper.SetDefault(flagAgentID, agent.GetDefaultAgentID())
+	viper.SetDefault(flagAPIEnableExecution, false)
 	viper.SetDefault(flagAPIHost, agent.DefaultAPIHost)
 	viper.SetDefault(flagAPIPort, agent.DefaultAPIPort)
+	viper.SetDefault(flagAssetCacheMaxAge, 0)
+	viper.SetDefault(flagAssetCacheMaxSize, 0)
+	viper.SetDefault(flagAssetRequireSignature, false)
+	viper.SetDefault(flagAssetTrustedKeys, []string{})
 	viper.SetDefault(flagBackendURL, []string{agent.DefaultBackendURL})
 	viper.SetDefault(flagCacheDir, path.SystemCacheDir("sensu-agent"))
 	viper.SetDefault(flagDeregister, false)
 	viper.SetDefault(flagDeregistrationHandler, "")
 	viper.SetDefault(flagEnvironment, agent.DefaultEnvironment)
+	viper.SetDefault(flagEventQueueMaxAge, agent.DefaultEventQueueMaxAge)
+	viper.SetDefault(flagEventQueueMaxSize, agent.DefaultEventQueueMaxSize)
+	viper.SetDefault(flagInventoryInterval, agent.DefaultInventoryInterval)
 	viper.SetDefault(flagKeepaliveInterval, agent.DefaultKeepaliveInterval)
 	viper.SetDefault(flagKeepaliveTimeout, agent.DefaultKeepaliveTimeout)
 	viper.SetDefault(flagOrganization, agent.DefaultOrganization)
 	viper.SetDefault(flagPassword, agent.DefaultPassword)
 	viper.SetDefault(flagRedact, dynamic.DefaultRedactFields)
+	viper.SetDefault(flagShutdownTimeout, agent.DefaultShutdownTimeout)
 	viper.SetDefault(flagSocketHost, agent.DefaultSocketHost)
 	viper.SetDefault(flagSocketPort, agent.DefaultSocketPort)
+	viper.SetDefault(flagStandaloneChecksDir, "")
+	viper.SetDefault(flagStatsdEventHandlers, []string{})
+	viper.SetDefault(flagStatsdEventTags, []string{})
 	viper.SetDefault(flagStatsdFlushInterval, agent.DefaultStatsdFlushInterval)
 	viper.SetDefault(flagStatsdMetricsHost, agent.DefaultStatsdMetricsHost)
 	viper.SetDefault(flagStatsdMetricsPort, agent.DefaultStatsdMetricsPort)
 	viper.SetDefault(flagSubscriptions, []string{})
+	viper.SetDefault(flagSystemCollectors, []string{})
 	viper.SetDefault(flagUser, agent.DefaultUser)
 	viper.SetDefault(flagDisableAPI, false)
 	viper.SetDefault(flagDisableSockets, false)
@@ -238,6 +305,12 @@ func newStartCommand() *cobra.Command {
 	cmd.Flags().Bool(flagDeregister, viper.GetBool(flagDeregister), "ephemeral agent")
 	cmd.Flags().Int(flagAPIPort, viper.GetInt(flagAPIPort), "port the Sensu client HTTP API listens on")
 	cmd.Flags().Int(flagKeepaliveInterval, viper.GetInt(flagKeepaliveInterval), "number of seconds to send between keepalive events")
+	cmd.Flags().Int(flagInventoryInterval, viper.GetInt(flagInventoryInterval), "number of seconds between refreshes of the system inventory (0 to disable)")
+	cmd.Flags().Int(flagShutdownTimeout, viper.GetInt(flagShutdownTimeout), "number of seconds to wait for the checks in progress when stopping")
+	cmd.Flags().Int(flagAssetCacheMaxAge, viper.GetInt(flagAssetCacheMaxAge), "number of seconds after which unused assets are removed from the cache (0 to disable)")
+	cmd.Flags().Int64(flagAssetCacheMaxSize, viper.GetInt64(flagAssetCacheMaxSize), "maximum size, in bytes, of the asset cache beyond which the least recently used assets are removed (0 to disable)")
+	cmd.Flags().Int(flagEventQueueMaxAge, viper.GetInt(flagEventQueueMaxAge), "number of seconds after which queued events that could not be sent are dropped")
+	cmd.Flags().Int64(flagEventQueueMaxSize, viper.GetInt64(flagEventQueueMaxSize), "maximum size, in bytes, of the queue holding events that could not be sent")
 	cmd.Flags().Int(flagSocketPort, viper.GetInt(flagSocketPort), "port the Sensu client socket listens on")
 	cmd.Flags().String(flagAgentID, viper.GetString(flagAgentID), "agent ID (defaults to hostname)")
 	cmd.Flags().String(flagAPIHost, viper.GetString(flagAPIHost), "address to bind the Sensu client HTTP API to")
@@ -249,14 +322,21 @@ func newStartCommand() *cobra.Command {
 	cmd.Flags().String(flagPassword, viper.GetString(flagPassword), "agent password")
 	cmd.Flags().String(flagRedact, viper.GetString(flagRedact), "comma-delimited customized list of fields to redact")
 	cmd.Flags().String(flagSocketHost, viper.GetString(flagSocketHost), "address to bind the Sensu client socket to")
+	cmd.Flags().String(flagStandaloneChecksDir, viper.GetString(flagStandaloneChecksDir), "path to the JSON definitions of checks scheduled by the agent itself")
+	cmd.Flags().String(flagStatsdEventHandlers, viper.GetString(flagStatsdEventHandlers), "comma-delimited list of handlers for statsd metric events")
+	cmd.Flags().String(flagStatsdEventTags, viper.GetString(flagStatsdEventTags), "comma-delimited list of name:value tags added to statsd metric points")
 	cmd.Flags().Int(flagStatsdFlushInterval, viper.GetInt(flagStatsdFlushInterval), "number of seconds between statsd flush")
 	cmd.Flags().String(flagStatsdMetricsHost, viper.GetString(flagStatsdMetricsHost), "address used for the statsd metrics server")
 	cmd.Flags().String(flagStatsdMetricsPort, viper.GetString(flagStatsdMetricsPort), "port used for the statsd metrics server")
 	cmd.Flags().String(flagSubscriptions, viper.GetString(flagSubscriptions), "comma-delimited list of agent subscriptions")
+	cmd.Flags().String(flagSystemCollectors, viper.GetString(flagSystemCollectors), "comma-delimited list of optional system collectors (cpu, kernel, memory, uptime)")
 	cmd.Flags().String(flagUser, viper.GetString(flagUser), "agent user")
+	cmd.Flags().StringSlice(flagAssetTrustedKeys, viper.GetStringSlice(flagAssetTrustedKeys), "path to an ASCII armored keyring of the keys trusted to sign assets (to specify multiple keyrings use this flag multiple times)")
+	cmd.Flags().Bool(flagAssetRequireSignature, viper.GetBool(flagAssetRequireSignature), "reject the assets that are not signed by a trusted key")
 	cmd.Flags().StringSlice(flagBackendURL, viper.GetStringSlice(flagBackendURL), "ws/wss URL of Sensu backend server (to specify multiple backends use this flag multiple times)")
 	cmd.Flags().Uint32(flagKeepaliveTimeout, uint32(viper.GetInt(flagKeepaliveTimeout)), "number of seconds until agent is considered dead by backend")
 	cmd.Flags().Bool(flagDisableAPI, viper.GetBool(flagDisableAPI), "disable the Agent HTTP API")
+	cmd.Flags().Bool(flagAPIEnableExecution, viper.GetBool(flagAPIEnableExecution), "enable the Agent HTTP API endpoints executing checks and commands")
 	cmd.Flags().Bool(flagDisableSockets, viper.GetBool(flagDisableSockets), "disable the Agent TCP and UDP event sockets")
 
 	if err := viper.ReadInConfig(); err != nil && configFile != "" {
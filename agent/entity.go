@@ -9,47 +9,104 @@ import (
 )
 
 func (a *Agent) getAgentEntity() *types.Entity {
+	a.entityMu.Lock()
+	defer a.entityMu.Unlock()
+
 	if a.entity == nil {
-		e := &types.Entity{
-			Class:            types.EntityAgentClass,
-			Deregister:       a.config.Deregister,
-			Environment:      a.config.Environment,
-			ID:               a.config.AgentID,
-			KeepaliveTimeout: a.config.KeepaliveTimeout,
-			Organization:     a.config.Organization,
-			Redact:           a.config.Redact,
-			Subscriptions:    a.config.Subscriptions,
-			User:             a.config.User,
-		}
+		a.entity = a.newAgentEntity()
+	}
+
+	return a.entity
+}
+
+// withEntity replaces the agent entity with a copy modified by fn, within a
+// single critical section so concurrent changes are not lost. The previous
+// entity is left untouched since it may be in use.
+func (a *Agent) withEntity(fn func(*types.Entity)) {
+	a.entityMu.Lock()
+	defer a.entityMu.Unlock()
+
+	if a.entity == nil {
+		a.entity = a.newAgentEntity()
+	}
+
+	entity := *a.entity
+	fn(&entity)
+	a.entity = &entity
+}
+
+// newAgentEntity returns the entity of the agent built from its configuration
+// and system inventory.
+func (a *Agent) newAgentEntity() *types.Entity {
+	e := &types.Entity{
+		Class:            types.EntityAgentClass,
+		Deregister:       a.config.Deregister,
+		Environment:      a.config.Environment,
+		ID:               a.config.AgentID,
+		KeepaliveTimeout: a.config.KeepaliveTimeout,
+		Organization:     a.config.Organization,
+		Redact:           a.config.Redact,
+		Subscriptions:    a.config.Subscriptions,
+		User:             a.config.User,
+	}
 
-		if a.config.DeregistrationHandler != "" {
-			e.Deregistration = types.Deregistration{
-				Handler: a.config.DeregistrationHandler,
-			}
+	if a.config.DeregistrationHandler != "" {
+		e.Deregistration = types.Deregistration{
+			Handler: a.config.DeregistrationHandler,
 		}
+	}
 
-		// Set any extended attributes in the entity
-		var attrMap map[string]interface{}
-		err := json.Unmarshal(a.config.ExtendedAttributes, &attrMap)
+	// Set any extended attributes in the entity
+	var attrMap map[string]interface{}
+	err := json.Unmarshal(a.config.ExtendedAttributes, &attrMap)
+	if err != nil {
+		logger.WithError(err)
+	}
+	for k, v := range attrMap {
+		err = dynamic.SetField(e, k, v)
 		if err != nil {
 			logger.WithError(err)
 		}
-		for k, v := range attrMap {
-			err = dynamic.SetField(e, k, v)
-			if err != nil {
-				logger.WithError(err)
-			}
-		}
+	}
+
+	s, err := a.systemInfo()
+	if err == nil {
+		e.System = s
+	}
+
+	return e
+}
+
+// systemInfo returns the system inventory of the agent, including the
+// information gathered by the configured system collectors. Failing collectors
+// are logged and skipped.
+func (a *Agent) systemInfo() (types.System, error) {
+	s, err := system.Info()
+	if err != nil {
+		return s, err
+	}
 
-		s, err := system.Info()
-		if err == nil {
-			e.System = s
+	for _, name := range a.config.SystemCollectors {
+		if err := system.Collect(&s, []string{name}); err != nil {
+			logger.WithError(err).Error("error collecting system inventory")
 		}
+	}
 
-		a.entity = e
+	return s, nil
+}
+
+// refreshAgentEntity collects the system inventory again and replaces the
+// agent entity with a copy holding it, so it is sent with the next keepalive.
+func (a *Agent) refreshAgentEntity() {
+	s, err := a.systemInfo()
+	if err != nil {
+		logger.WithError(err).Error("error refreshing system inventory")
+		return
 	}
 
-	return a.entity
+	a.withEntity(func(entity *types.Entity) {
+		entity.System = s
+	})
 }
 
 // getEntities receives an event and verifies if we have a proxy entity, so it
@@ -1,6 +1,7 @@
 package agent
 
 import (
+	"sync"
 	"testing"
 
 	"github.com/sensu/sensu-go/types"
@@ -55,6 +56,49 @@ func TestGetAgentEntity(t *testing.T) {
 	}
 }
 
+func TestRefreshAgentEntity(t *testing.T) {
+	agent := &Agent{
+		config: &Config{
+			AgentID:          "foo",
+			SystemCollectors: []string{"cpu"},
+		},
+	}
+
+	entity := agent.getAgentEntity()
+	assert.NotZero(t, entity.System.CPUs)
+
+	// The refreshed entity is a copy, the previous one is left untouched
+	entity.System.CPUs = 0
+	agent.refreshAgentEntity()
+	refreshed := agent.getAgentEntity()
+	assert.Equal(t, "foo", refreshed.ID)
+	assert.NotZero(t, refreshed.System.CPUs)
+	assert.Zero(t, entity.System.CPUs)
+}
+
+func TestConcurrentEntityUpdates(t *testing.T) {
+	agent := &Agent{config: &Config{AgentID: "foo"}}
+
+	// The inventory refreshes don't revert the subscriptions set concurrently
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			agent.refreshAgentEntity()
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		agent.setSubscriptions([]string{"linux"})
+	}()
+	wg.Wait()
+
+	entity := agent.getAgentEntity()
+	assert.Equal(t, []string{"linux"}, entity.Subscriptions)
+	assert.NotEmpty(t, entity.System.Hostname)
+}
+
 func TestGetEntities(t *testing.T) {
 	assert := assert.New(t)
 
@@ -32,7 +32,7 @@ func (a *Agent) ExecuteHooks(request *types.CheckRequest, status int) []*types.H
 				// code and severity (ex. 0, ok)
 				in := hookInList(hookConfig.Name, executedHooks)
 				if !in {
-					hook := a.executeHook(hookConfig)
+					hook := a.executeHook(request, hookConfig)
 					executedHooks = append(executedHooks, hook)
 				}
 			}
@@ -41,7 +41,9 @@ func (a *Agent) ExecuteHooks(request *types.CheckRequest, status int) []*types.H
 	return executedHooks
 }
 
-func (a *Agent) executeHook(hookConfig *types.HookConfig) *types.Hook {
+// executeHook executes a hook of the check request, restricted by the sandbox
+// of its check like the check command.
+func (a *Agent) executeHook(request *types.CheckRequest, hookConfig *types.HookConfig) *types.Hook {
 	// Instantiate Event and Hook
 	event := &types.Event{
 		Check: &types.Check{},
@@ -57,6 +59,7 @@ func (a *Agent) executeHook(hookConfig *types.HookConfig) *types.Hook {
 		Command: hookConfig.Command,
 		Timeout: int(hookConfig.Timeout),
 	}
+	applySandbox(ex, request.Config.Sandbox)
 
 	// If stdin is true, add JSON event data to command execution.
 	if hookConfig.Stdin {
@@ -24,7 +24,8 @@ func TestExecuteHook(t *testing.T) {
 	truePath := testutil.CommandPath(filepath.Join(toolsDir, "true"))
 	hookConfig.Command = truePath
 
-	hook := agent.executeHook(hookConfig)
+	request := &types.CheckRequest{Config: types.FixtureCheckConfig("check")}
+	hook := agent.executeHook(request, hookConfig)
 
 	assert.NotZero(hook.Executed)
 	assert.Equal(hook.Status, int32(0))
@@ -32,11 +33,18 @@ func TestExecuteHook(t *testing.T) {
 
 	hookConfig.Command = "printf hello"
 
-	hook = agent.executeHook(hookConfig)
+	hook = agent.executeHook(request, hookConfig)
 
 	assert.NotZero(hook.Executed)
 	assert.Equal(hook.Status, int32(0))
 	assert.Equal(hook.Output, "hello")
+
+	// The sandbox of the check applies to its hooks
+	request.Config.Sandbox = &types.Sandbox{MaxOutputSize: 3}
+
+	hook = agent.executeHook(request, hookConfig)
+
+	assert.Equal(hook.Output, "hel")
 }
 
 func TestPrepareHook(t *testing.T) {
@@ -0,0 +1,87 @@
+package agent
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var (
+	checksExecuted = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sensu_agent_checks_executed_total",
+			Help: "Number of checks executed by the agent",
+		},
+		[]string{"check"},
+	)
+
+	checkDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "sensu_agent_check_duration_seconds",
+			Help:    "Duration of the check executions",
+			Buckets: prometheus.ExponentialBuckets(0.01, 4, 8),
+		},
+		[]string{"check"},
+	)
+
+	reconnects = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "sensu_agent_reconnects_total",
+			Help: "Number of times the agent reconnected to a backend",
+		},
+	)
+
+	statsdMetrics = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sensu_agent_statsd_metrics_total",
+			Help: "Number of StatsD metrics flushed by the agent",
+		},
+		[]string{"type"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(checksExecuted, checkDuration, reconnects, statsdMetrics)
+}
+
+// newMetricsRegistry returns the registry of the metrics describing the state
+// of the agent, as opposed to the package metrics shared by all the agents of
+// the process.
+func (a *Agent) newMetricsRegistry() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+
+	registry.MustRegister(
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Name:        "sensu_agent_send_queue_depth",
+				Help:        "Number of messages waiting to be sent to the backend",
+				ConstLabels: prometheus.Labels{"queue": "memory"},
+			},
+			func() float64 {
+				return float64(len(a.sendq))
+			},
+		),
+		prometheus.NewGaugeFunc(
+			prometheus.GaugeOpts{
+				Name:        "sensu_agent_send_queue_depth",
+				Help:        "Number of messages waiting to be sent to the backend",
+				ConstLabels: prometheus.Labels{"queue": "disk"},
+			},
+			func() float64 {
+				if a.queue == nil {
+					return 0
+				}
+				return float64(a.queue.Len())
+			},
+		),
+	)
+
+	return registry
+}
+
+// metricsHandler exposes the metrics of the agent in the Prometheus text format
+func metricsHandler(a *Agent) http.Handler {
+	gatherers := prometheus.Gatherers{prometheus.DefaultGatherer, a.metrics}
+	return promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{})
+}
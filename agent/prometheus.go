@@ -0,0 +1,313 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/prometheus/common/model"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// DefaultPrometheusTimeout is the timeout, in seconds, of the Prometheus
+	// scrapes of the checks without timeout
+	DefaultPrometheusTimeout = 10
+
+	// DefaultPrometheusMaxSize is the maximum size, in bytes, of the responses
+	// scraped by the checks without a maximum output size
+	DefaultPrometheusMaxSize = 10 * 1024 * 1024
+
+	// prometheusAcceptHeader negotiates the exposition formats understood by
+	// the agent, the protocol buffer format being preferred
+	prometheusAcceptHeader = `application/vnd.google.protobuf;proto=io.prometheus.client.MetricFamily;encoding=delimited;q=0.7,text/plain;version=0.0.4;q=0.3,*/*;q=0.1`
+
+	// prometheusFailureStatus is the status of the checks whose endpoint can't
+	// be scraped
+	prometheusFailureStatus = 2
+)
+
+// prometheusRelabel is a compiled relabeling rule
+type prometheusRelabel struct {
+	types.PrometheusRelabel
+	regex *regexp.Regexp
+}
+
+// prometheusScraper scrapes the metrics of a Prometheus exposition endpoint,
+// and filters and relabels them according to the scrape configuration
+type prometheusScraper struct {
+	config  *types.PrometheusScrape
+	allow   map[model.LabelName]*regexp.Regexp
+	deny    map[model.LabelName]*regexp.Regexp
+	relabel []prometheusRelabel
+	maxSize int64
+}
+
+// sizeLimitedReader reads from r until more than max bytes were read, then
+// records an error rather than silently truncating the response
+type sizeLimitedReader struct {
+	r    io.Reader
+	max  int64
+	read int64
+	err  error
+}
+
+// scrapePrometheus scrapes the Prometheus endpoint of the check, and records
+// the result in the event. The scraped metric points are handled by the output
+// metric handlers of the check.
+func (a *Agent) scrapePrometheus(event *types.Event) {
+	check := event.Check
+	started := time.Now()
+	defer func() {
+		check.Duration = time.Since(started).Seconds()
+	}()
+
+	timeout := time.Duration(check.Timeout) * time.Second
+	if timeout == 0 {
+		timeout = DefaultPrometheusTimeout * time.Second
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	scraper, err := newPrometheusScraper(check.Prometheus)
+	if err == nil {
+		scraper.maxSize = DefaultPrometheusMaxSize
+		if check.Sandbox != nil && check.Sandbox.MaxOutputSize > 0 {
+			scraper.maxSize = int64(check.Sandbox.MaxOutputSize)
+		}
+
+		var points []*types.MetricPoint
+		points, err = scraper.scrape(ctx, check.Executed)
+		if err == nil {
+			check.Output = fmt.Sprintf("scraped %d metric points from %s\n", len(points), check.Prometheus.URL)
+			if len(points) > 0 {
+				event.Metrics = &types.Metrics{
+					Handlers: check.OutputMetricHandlers,
+					Points:   points,
+				}
+			}
+			return
+		}
+	}
+
+	check.Output = fmt.Sprintf("unable to scrape %s: %s\n", check.Prometheus.URL, err)
+	check.Status = prometheusFailureStatus
+}
+
+func newPrometheusScraper(config *types.PrometheusScrape) (*prometheusScraper, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	s := &prometheusScraper{
+		config: config,
+		allow:  make(map[model.LabelName]*regexp.Regexp, len(config.AllowLabels)),
+		deny:   make(map[model.LabelName]*regexp.Regexp, len(config.DenyLabels)),
+	}
+
+	// The expressions were validated above
+	for label, expr := range config.AllowLabels {
+		s.allow[model.LabelName(label)], _ = types.PrometheusRegexp(expr)
+	}
+	for label, expr := range config.DenyLabels {
+		s.deny[model.LabelName(label)], _ = types.PrometheusRegexp(expr)
+	}
+
+	for _, rule := range config.Relabel {
+		if rule.Action == "" {
+			rule.Action = types.PrometheusRelabelReplace
+		}
+		if rule.Regex == "" {
+			rule.Regex = types.DefaultPrometheusRelabelRegex
+		}
+		if rule.TargetLabel == "" {
+			rule.TargetLabel = rule.SourceLabel
+		}
+		if rule.Replacement == "" {
+			rule.Replacement = types.DefaultPrometheusRelabelReplacement
+		}
+		regex, _ := types.PrometheusRegexp(rule.Regex)
+		s.relabel = append(s.relabel, prometheusRelabel{PrometheusRelabel: rule, regex: regex})
+	}
+
+	return s, nil
+}
+
+// scrape fetches the metrics of the endpoint and returns them as metric points.
+// The timestamp is used for the samples that do not provide their own.
+func (s *prometheusScraper) scrape(ctx context.Context, timestamp int64) ([]*types.MetricPoint, error) {
+	req, err := http.NewRequest(http.MethodGet, s.config.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", prometheusAcceptHeader)
+
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+
+	// The decoders may not report the read errors, so the size is checked once
+	// the response is parsed
+	body := &sizeLimitedReader{r: resp.Body, max: s.maxSize}
+	points, err := s.parse(body, expfmt.ResponseFormat(resp.Header), timestamp)
+	if body.err != nil {
+		return nil, body.err
+	}
+	return points, err
+}
+
+func (l *sizeLimitedReader) Read(p []byte) (int, error) {
+	if l.err != nil {
+		return 0, l.err
+	}
+	// Read one byte past the limit, so a response of exactly max bytes is
+	// accepted
+	if remaining := l.max + 1 - l.read; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.max {
+		l.err = fmt.Errorf("response exceeds %d bytes", l.max)
+		return n, l.err
+	}
+	return n, err
+}
+
+// parse decodes the samples of the exposition format and returns the metric
+// points of the ones passing the filters, once relabeled
+func (s *prometheusScraper) parse(r io.Reader, format expfmt.Format, timestamp int64) ([]*types.MetricPoint, error) {
+	decoder := &expfmt.SampleDecoder{
+		Dec: expfmt.NewDecoder(r, format),
+		Opts: &expfmt.DecodeOptions{
+			Timestamp: model.TimeFromUnix(timestamp),
+		},
+	}
+
+	points := []*types.MetricPoint{}
+	for {
+		var samples model.Vector
+		if err := decoder.Decode(&samples); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, sample := range samples {
+			if point := s.transform(sample); point != nil {
+				points = append(points, point)
+			}
+		}
+	}
+
+	// The text format is decoded in an arbitrary order of metric families
+	sort.SliceStable(points, func(i, j int) bool {
+		return points[i].Name < points[j].Name
+	})
+
+	return points, nil
+}
+
+// transform converts the sample into a metric point, or returns nil if the
+// sample is filtered out
+func (s *prometheusScraper) transform(sample *model.Sample) *types.MetricPoint {
+	// Metric points are serialized as JSON, which can't represent these values
+	value := float64(sample.Value)
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return nil
+	}
+
+	labels := sample.Metric
+	if !s.filter(labels) {
+		return nil
+	}
+	labels = s.relabelMetric(labels)
+
+	name := string(labels[model.MetricNameLabel])
+	if name == "" {
+		return nil
+	}
+
+	point := &types.MetricPoint{
+		Name:      name,
+		Value:     value,
+		Timestamp: sample.Timestamp.Unix(),
+		Tags:      []*types.MetricTag{},
+	}
+	for label, value := range labels {
+		if label == model.MetricNameLabel {
+			continue
+		}
+		point.Tags = append(point.Tags, &types.MetricTag{Name: string(label), Value: string(value)})
+	}
+	sort.Slice(point.Tags, func(i, j int) bool {
+		return point.Tags[i].Name < point.Tags[j].Name
+	})
+
+	return point
+}
+
+// filter returns true if the labels match every allowed expression and none of
+// the denied ones. Missing labels have an empty value.
+func (s *prometheusScraper) filter(labels model.Metric) bool {
+	for label, regex := range s.allow {
+		if !regex.MatchString(string(labels[label])) {
+			return false
+		}
+	}
+	for label, regex := range s.deny {
+		if regex.MatchString(string(labels[label])) {
+			return false
+		}
+	}
+	return true
+}
+
+// relabelMetric applies the relabeling rules, in order, to a copy of the labels
+func (s *prometheusScraper) relabelMetric(labels model.Metric) model.Metric {
+	if len(s.relabel) == 0 {
+		return labels
+	}
+
+	labels = labels.Clone()
+	for _, rule := range s.relabel {
+		switch rule.Action {
+		case types.PrometheusRelabelLabelDrop:
+			for label := range labels {
+				if rule.regex.MatchString(string(label)) {
+					delete(labels, label)
+				}
+			}
+		default:
+			value := string(labels[model.LabelName(rule.SourceLabel)])
+			match := rule.regex.FindStringSubmatchIndex(value)
+			if match == nil {
+				continue
+			}
+
+			target := model.LabelName(rule.TargetLabel)
+			replacement := rule.regex.ExpandString(nil, rule.Replacement, value, match)
+			if len(replacement) == 0 {
+				delete(labels, target)
+			} else {
+				labels[target] = model.LabelValue(replacement)
+			}
+		}
+	}
+
+	return labels
+}
@@ -0,0 +1,135 @@
+package agent
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/common/expfmt"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const prometheusFixture = `# HELP http_requests_total The total number of HTTP requests.
+# TYPE http_requests_total counter
+http_requests_total{method="post",code="200",instance="web-1:8080"} 1027 1520000000000
+http_requests_total{method="post",code="400",instance="web-1:8080"} 3 1520000000000
+# HELP request_duration_seconds Duration of the requests.
+# TYPE request_duration_seconds histogram
+request_duration_seconds_bucket{le="0.5"} 10
+request_duration_seconds_bucket{le="+Inf"} 12
+request_duration_seconds_sum 4.2
+request_duration_seconds_count 12
+# TYPE temperature gauge
+temperature NaN
+`
+
+func parsePrometheusFixture(t *testing.T, config *types.PrometheusScrape) []*types.MetricPoint {
+	config.URL = "http://localhost:9100/metrics"
+	scraper, err := newPrometheusScraper(config)
+	require.NoError(t, err)
+
+	points, err := scraper.parse(strings.NewReader(prometheusFixture), expfmt.FmtText, 1530000000)
+	require.NoError(t, err)
+	return points
+}
+
+func TestPrometheusParse(t *testing.T) {
+	points := parsePrometheusFixture(t, &types.PrometheusScrape{})
+
+	// The NaN gauge is skipped
+	require.Len(t, points, 6)
+
+	assert.Equal(t, "http_requests_total", points[0].Name)
+	assert.Equal(t, float64(1027), points[0].Value)
+	assert.Equal(t, int64(1520000000), points[0].Timestamp)
+	assert.Equal(t, []*types.MetricTag{
+		{Name: "code", Value: "200"},
+		{Name: "instance", Value: "web-1:8080"},
+		{Name: "method", Value: "post"},
+	}, points[0].Tags)
+
+	// Samples without timestamp are given the one of the execution
+	assert.Equal(t, "request_duration_seconds_bucket", points[2].Name)
+	assert.Equal(t, int64(1530000000), points[2].Timestamp)
+	assert.Equal(t, []*types.MetricTag{{Name: "le", Value: "0.5"}}, points[2].Tags)
+	assert.Equal(t, "request_duration_seconds_count", points[4].Name)
+	assert.Equal(t, float64(12), points[4].Value)
+}
+
+func TestPrometheusFilters(t *testing.T) {
+	points := parsePrometheusFixture(t, &types.PrometheusScrape{
+		AllowLabels: map[string]string{"__name__": "http_.*"},
+		DenyLabels:  map[string]string{"code": "4.."},
+	})
+
+	require.Len(t, points, 1)
+	assert.Equal(t, "http_requests_total", points[0].Name)
+	assert.Equal(t, float64(1027), points[0].Value)
+}
+
+func TestPrometheusRelabel(t *testing.T) {
+	points := parsePrometheusFixture(t, &types.PrometheusScrape{
+		AllowLabels: map[string]string{"code": "200"},
+		Relabel: []types.PrometheusRelabel{
+			{SourceLabel: "instance", Regex: "(.*):.*", TargetLabel: "host"},
+			{Action: types.PrometheusRelabelLabelDrop, Regex: "instance|method"},
+			{SourceLabel: "__name__", Regex: "http_(.*)", Replacement: "web_$1"},
+			{SourceLabel: "code", Regex: ".*", Replacement: "${2}"},
+		},
+	})
+
+	require.Len(t, points, 1)
+	assert.Equal(t, "web_requests_total", points[0].Name)
+	assert.Equal(t, []*types.MetricTag{{Name: "host", Value: "web-1"}}, points[0].Tags)
+}
+
+func TestScrapePrometheus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", string(expfmt.FmtText))
+		fmt.Fprint(w, prometheusFixture)
+	}))
+	defer server.Close()
+
+	agent := NewAgent(FixtureConfig())
+
+	event := types.FixtureEvent("entity", "check")
+	event.Check.Command = ""
+	event.Check.Prometheus = &types.PrometheusScrape{URL: server.URL}
+	event.Check.OutputMetricHandlers = []string{"influxdb"}
+	agent.scrapePrometheus(event)
+
+	assert.Equal(t, uint32(0), event.Check.Status)
+	require.NotNil(t, event.Metrics)
+	assert.Equal(t, []string{"influxdb"}, event.Metrics.Handlers)
+	assert.Len(t, event.Metrics.Points, 6)
+
+	// Responses larger than the maximum output size are rejected
+	event = types.FixtureEvent("entity", "check")
+	event.Check.Prometheus = &types.PrometheusScrape{URL: server.URL}
+	event.Check.Sandbox = &types.Sandbox{MaxOutputSize: uint64(len(prometheusFixture) - 1)}
+	agent.scrapePrometheus(event)
+
+	assert.Equal(t, uint32(prometheusFailureStatus), event.Check.Status)
+	assert.Contains(t, event.Check.Output, "exceeds")
+	assert.Nil(t, event.Metrics)
+
+	event = types.FixtureEvent("entity", "check")
+	event.Check.Prometheus = &types.PrometheusScrape{URL: server.URL}
+	event.Check.Sandbox = &types.Sandbox{MaxOutputSize: uint64(len(prometheusFixture))}
+	agent.scrapePrometheus(event)
+	assert.Equal(t, uint32(0), event.Check.Status)
+
+	// Endpoint failure
+	server.Config.Handler = http.NotFoundHandler()
+	event = types.FixtureEvent("entity", "check")
+	event.Check.Prometheus = &types.PrometheusScrape{URL: server.URL}
+	agent.scrapePrometheus(event)
+
+	assert.Equal(t, uint32(prometheusFailureStatus), event.Check.Status)
+	assert.Contains(t, event.Check.Output, "404")
+	assert.Nil(t, event.Metrics)
+}
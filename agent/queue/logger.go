@@ -0,0 +1,11 @@
+package queue
+
+import "github.com/Sirupsen/logrus"
+
+var logger *logrus.Entry
+
+func init() {
+	logger = logrus.WithFields(logrus.Fields{
+		"component": "queue",
+	})
+}
@@ -0,0 +1,332 @@
+// Package queue provides a durable, on-disk FIFO queue used by the agent to
+// hold its outbound messages while the backend is unreachable.
+package queue
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	bolt "github.com/coreos/bbolt"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	bucketName = []byte("queue")
+
+	// ErrEmpty is returned by Peek when the queue holds no item.
+	ErrEmpty = errors.New("queue is empty")
+
+	droppedCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sensu_agent_queue_dropped_total",
+			Help: "Number of items dropped from the agent queue",
+		},
+		[]string{"reason"},
+	)
+)
+
+const (
+	// timestampSize is the number of bytes used to store the enqueue time of
+	// an item, which precedes its value
+	timestampSize = 8
+
+	dropReasonAge  = "max_age"
+	dropReasonSize = "max_size"
+)
+
+func init() {
+	prometheus.MustRegister(droppedCounter)
+
+	// Expose the counters before the first drop
+	droppedCounter.WithLabelValues(dropReasonAge)
+	droppedCounter.WithLabelValues(dropReasonSize)
+}
+
+// Config specifies the configuration of a Queue.
+type Config struct {
+	// Path is the path of the file backing the queue.
+	Path string
+	// MaxSize is the maximum number of bytes the queue can hold. When it is
+	// reached, the oldest items are dropped. A value of zero means no limit.
+	MaxSize int64
+	// MaxAge is the duration after which an item is dropped from the queue. A
+	// value of zero means no limit.
+	MaxAge time.Duration
+}
+
+// changes accumulates the changes of a transaction, which are only applied to
+// the queue once the transaction commits.
+type changes struct {
+	size    int64
+	dropped []string
+}
+
+// An Item is a value stored in a Queue.
+type Item struct {
+	key   []byte
+	Value []byte
+}
+
+// Queue is a bounded FIFO queue persisted to disk. It is safe for concurrent
+// use.
+type Queue struct {
+	db      *bolt.DB
+	config  Config
+	dropped int64
+	mu      *sync.Mutex
+	size    int64
+	ready   chan struct{}
+}
+
+// Open opens, or creates, the queue stored at the path of the given
+// configuration. Items left in the queue by a previous execution are kept.
+func Open(config Config) (*Queue, error) {
+	db, err := bolt.Open(config.Path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+
+	q := &Queue{
+		db:     db,
+		config: config,
+		mu:     &sync.Mutex{},
+		ready:  make(chan struct{}, 1),
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(bucketName)
+		if err != nil {
+			return err
+		}
+		return b.ForEach(func(k, v []byte) error {
+			q.size += int64(len(v))
+			return nil
+		})
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+
+	if q.size > 0 {
+		q.notify()
+	}
+
+	return q, nil
+}
+
+// Close closes the file backing the queue.
+func (q *Queue) Close() error {
+	return q.db.Close()
+}
+
+// Enqueue adds a value at the end of the queue. The oldest items are dropped
+// if the queue exceeds its maximum size.
+func (q *Queue) Enqueue(value []byte) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	data := make([]byte, timestampSize+len(value))
+	binary.BigEndian.PutUint64(data, uint64(time.Now().UnixNano()))
+	copy(data[timestampSize:], value)
+
+	var ch changes
+	err := q.db.Update(func(tx *bolt.Tx) error {
+		ch = changes{}
+		b := tx.Bucket(bucketName)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		if err := b.Put(itob(seq), data); err != nil {
+			return err
+		}
+		ch.size += int64(len(data))
+
+		if err := q.dropExpired(b, &ch); err != nil {
+			return err
+		}
+		if q.config.MaxSize <= 0 {
+			return nil
+		}
+		// Items are always dropped from the head of the queue, so the cursor is
+		// moved back to the first item after each deletion
+		c := b.Cursor()
+		for k, v := c.First(); k != nil && q.size+ch.size > q.config.MaxSize; k, v = c.First() {
+			if err := drop(c, v, dropReasonSize, &ch); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	q.apply(ch)
+
+	q.notify()
+	return nil
+}
+
+// Peek returns the item at the head of the queue without removing it, or
+// ErrEmpty if the queue holds no item. Items older than the maximum age of the
+// queue are skipped, and dropped in a separate write transaction.
+func (q *Queue) Peek() (*Item, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.size == 0 {
+		return nil, ErrEmpty
+	}
+
+	var (
+		item    *Item
+		expired bool
+	)
+	err := q.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(bucketName).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if q.expired(v) {
+				expired = true
+				continue
+			}
+
+			// The key and value are only valid during the transaction
+			item = &Item{
+				key:   append([]byte{}, k...),
+				Value: append([]byte{}, v[timestampSize:]...),
+			}
+			return nil
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if expired {
+		var ch changes
+		err := q.db.Update(func(tx *bolt.Tx) error {
+			ch = changes{}
+			return q.dropExpired(tx.Bucket(bucketName), &ch)
+		})
+		if err != nil {
+			return nil, err
+		}
+		q.apply(ch)
+	}
+
+	if item == nil {
+		return nil, ErrEmpty
+	}
+	return item, nil
+}
+
+// Remove removes the given item from the queue, once it has been processed.
+func (q *Queue) Remove(item *Item) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	var ch changes
+	err := q.db.Update(func(tx *bolt.Tx) error {
+		ch = changes{}
+		b := tx.Bucket(bucketName)
+		v := b.Get(item.key)
+		if v == nil {
+			// The item was already dropped
+			return nil
+		}
+		ch.size -= int64(len(v))
+		return b.Delete(item.key)
+	})
+	if err != nil {
+		return err
+	}
+	q.apply(ch)
+	return nil
+}
+
+// Ready returns a channel that receives a value whenever items are added to
+// the queue.
+func (q *Queue) Ready() <-chan struct{} {
+	return q.ready
+}
+
+// Len returns the number of items in the queue.
+func (q *Queue) Len() int {
+	var n int
+	_ = q.db.View(func(tx *bolt.Tx) error {
+		n = tx.Bucket(bucketName).Stats().KeyN
+		return nil
+	})
+	return n
+}
+
+// Dropped returns the number of items dropped from the queue since it was
+// opened, because of its size or age limits.
+func (q *Queue) Dropped() int64 {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.dropped
+}
+
+// dropExpired drops the items older than the maximum age of the queue, which
+// are all at its head. It must be called within a writable transaction.
+func (q *Queue) dropExpired(b *bolt.Bucket, ch *changes) error {
+	c := b.Cursor()
+	for k, v := c.First(); k != nil && q.expired(v); k, v = c.First() {
+		if err := drop(c, v, dropReasonAge, ch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// drop deletes the item at the position of the cursor, and records it in the
+// changes of the transaction. It must be called within a writable transaction.
+func drop(c *bolt.Cursor, v []byte, reason string, ch *changes) error {
+	if err := c.Delete(); err != nil {
+		return err
+	}
+	ch.size -= int64(len(v))
+	ch.dropped = append(ch.dropped, reason)
+	return nil
+}
+
+// apply applies the changes of a committed transaction to the queue. It must be
+// called with the queue lock held.
+func (q *Queue) apply(ch changes) {
+	q.size += ch.size
+	for _, reason := range ch.dropped {
+		q.dropped++
+		droppedCounter.WithLabelValues(reason).Inc()
+		logger.WithFields(logrus.Fields{
+			"reason": reason,
+			"path":   q.config.Path,
+		}).Warn("dropped item from queue")
+	}
+}
+
+func (q *Queue) expired(v []byte) bool {
+	if q.config.MaxAge <= 0 || len(v) < timestampSize {
+		return false
+	}
+	enqueued := time.Unix(0, int64(binary.BigEndian.Uint64(v)))
+	return time.Since(enqueued) > q.config.MaxAge
+}
+
+func (q *Queue) notify() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+func itob(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
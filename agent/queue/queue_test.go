@@ -0,0 +1,159 @@
+package queue
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testQueue(t *testing.T, config Config) (*Queue, func()) {
+	dir, err := ioutil.TempDir("", "queue")
+	require.NoError(t, err)
+
+	config.Path = filepath.Join(dir, "queue.db")
+	q, err := Open(config)
+	require.NoError(t, err)
+
+	return q, func() {
+		_ = q.Close()
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func TestQueueOrder(t *testing.T) {
+	q, cleanup := testQueue(t, Config{})
+	defer cleanup()
+
+	_, err := q.Peek()
+	assert.Equal(t, ErrEmpty, err)
+
+	for _, v := range []string{"foo", "bar", "baz"} {
+		require.NoError(t, q.Enqueue([]byte(v)))
+	}
+	assert.Equal(t, 3, q.Len())
+
+	select {
+	case <-q.Ready():
+	default:
+		t.Fatal("queue should be ready")
+	}
+
+	for _, v := range []string{"foo", "bar", "baz"} {
+		item, err := q.Peek()
+		require.NoError(t, err)
+		assert.Equal(t, v, string(item.Value))
+
+		// Peeking again returns the same item until it's removed
+		again, err := q.Peek()
+		require.NoError(t, err)
+		assert.Equal(t, item.Value, again.Value)
+
+		require.NoError(t, q.Remove(item))
+	}
+
+	_, err = q.Peek()
+	assert.Equal(t, ErrEmpty, err)
+	assert.Equal(t, 0, q.Len())
+}
+
+func TestQueuePersistence(t *testing.T) {
+	q, cleanup := testQueue(t, Config{})
+	defer cleanup()
+
+	require.NoError(t, q.Enqueue([]byte("foo")))
+	require.NoError(t, q.Enqueue([]byte("bar")))
+	require.NoError(t, q.Close())
+
+	q, err := Open(q.config)
+	require.NoError(t, err)
+	assert.Equal(t, 2, q.Len())
+	assert.Equal(t, int64(2*(timestampSize+3)), q.size)
+
+	item, err := q.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, "foo", string(item.Value))
+	require.NoError(t, q.Close())
+}
+
+func TestQueueMaxSize(t *testing.T) {
+	q, cleanup := testQueue(t, Config{MaxSize: 2 * (timestampSize + 3)})
+	defer cleanup()
+
+	for _, v := range []string{"foo", "bar", "baz"} {
+		require.NoError(t, q.Enqueue([]byte(v)))
+	}
+	assert.Equal(t, 2, q.Len())
+	assert.Equal(t, int64(1), q.Dropped())
+
+	// The oldest item was dropped
+	item, err := q.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, "bar", string(item.Value))
+}
+
+func TestQueueMaxAge(t *testing.T) {
+	q, cleanup := testQueue(t, Config{MaxAge: 50 * time.Millisecond})
+	defer cleanup()
+
+	require.NoError(t, q.Enqueue([]byte("foo")))
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, q.Enqueue([]byte("bar")))
+
+	item, err := q.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, "bar", string(item.Value))
+	assert.Equal(t, int64(1), q.Dropped())
+	assert.Equal(t, 1, q.Len())
+}
+
+func TestQueuePeekExpired(t *testing.T) {
+	q, cleanup := testQueue(t, Config{MaxAge: 50 * time.Millisecond})
+	defer cleanup()
+
+	require.NoError(t, q.Enqueue([]byte("foo")))
+	time.Sleep(100 * time.Millisecond)
+
+	_, err := q.Peek()
+	assert.Equal(t, ErrEmpty, err)
+	assert.Equal(t, int64(1), q.Dropped())
+	assert.Equal(t, 0, q.Len())
+	assert.Equal(t, int64(0), q.size)
+}
+
+func TestQueuePeekReadOnly(t *testing.T) {
+	q, cleanup := testQueue(t, Config{MaxAge: time.Minute})
+	defer cleanup()
+
+	// Peeking neither an empty queue nor an item writes to the disk
+	writes := q.db.Stats().TxStats.Write
+	_, err := q.Peek()
+	assert.Equal(t, ErrEmpty, err)
+	assert.Equal(t, writes, q.db.Stats().TxStats.Write)
+
+	require.NoError(t, q.Enqueue([]byte("foo")))
+	writes = q.db.Stats().TxStats.Write
+	item, err := q.Peek()
+	require.NoError(t, err)
+	assert.Equal(t, "foo", string(item.Value))
+	assert.Equal(t, writes, q.db.Stats().TxStats.Write)
+}
+
+func TestQueueRemoveDropped(t *testing.T) {
+	q, cleanup := testQueue(t, Config{MaxSize: timestampSize + 3})
+	defer cleanup()
+
+	require.NoError(t, q.Enqueue([]byte("foo")))
+	item, err := q.Peek()
+	require.NoError(t, err)
+
+	// The peeked item is dropped before being removed
+	require.NoError(t, q.Enqueue([]byte("bar")))
+	require.NoError(t, q.Remove(item))
+	assert.Equal(t, 1, q.Len())
+	assert.Equal(t, int64(timestampSize+3), q.size)
+}
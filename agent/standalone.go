@@ -0,0 +1,123 @@
+package agent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/util/schedule"
+	sensutime "github.com/sensu/sensu-go/util/time"
+)
+
+// loadStandaloneChecks reads the standalone check definitions of the JSON
+// files found in dir. A file contains either a single check configuration or a
+// list of them. Checks without an organization or environment are given the
+// ones of the agent. A missing directory holds no checks.
+func (a *Agent) loadStandaloneChecks(dir string) ([]*types.CheckConfig, error) {
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var checks []*types.CheckConfig
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".json" {
+			continue
+		}
+
+		path := filepath.Join(dir, file.Name())
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var fileChecks []*types.CheckConfig
+		if strings.HasPrefix(strings.TrimSpace(string(b)), "[") {
+			err = json.Unmarshal(b, &fileChecks)
+		} else {
+			check := &types.CheckConfig{}
+			err = json.Unmarshal(b, check)
+			fileChecks = append(fileChecks, check)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not parse standalone checks of %s: %s", path, err)
+		}
+
+		for _, check := range fileChecks {
+			if check.Organization == "" {
+				check.Organization = a.config.Organization
+			}
+			if check.Environment == "" {
+				check.Environment = a.config.Environment
+			}
+			if err := check.Validate(); err != nil {
+				return nil, fmt.Errorf("invalid standalone check in %s: %s", path, err)
+			}
+			checks = append(checks, check)
+		}
+	}
+
+	return checks, nil
+}
+
+// scheduleStandaloneCheck executes the check on its own interval or cron
+// schedule until the agent stops. Results go through the regular check
+// execution path, so they are queued while the backend is unreachable.
+func (a *Agent) scheduleStandaloneCheck(check *types.CheckConfig) {
+	logger := logger.WithFields(logrus.Fields{"check": check.Name})
+
+	var timer schedule.CheckTimer
+	if check.Cron != "" {
+		if cronTimer := schedule.NewCronTimer(check.Name, check.Cron); cronTimer != nil {
+			timer = cronTimer
+		}
+	}
+	if timer == nil {
+		timer = schedule.NewIntervalTimer(check.Name, uint(check.Interval))
+	}
+
+	timer.Start()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-a.draining:
+			return
+		case <-timer.C():
+			timer.SetDuration(check.Cron, uint(check.Interval))
+			timer.Next()
+
+			if subdue := check.GetSubdue(); subdue != nil {
+				isSubdued, err := sensutime.InWindows(time.Now(), *subdue)
+				if err != nil {
+					logger.WithError(err).Error("unexpected error with time windows")
+					continue
+				}
+				if isSubdued {
+					logger.Debug("check is not scheduled to be executed")
+					continue
+				}
+			}
+
+			// The check configuration is modified by the token substitution, so
+			// every execution is given its own copy
+			config, err := copyCheckConfig(check)
+			if err != nil {
+				logger.WithError(err).Error("could not copy the standalone check")
+				continue
+			}
+
+			if err := a.scheduleCheck(&types.CheckRequest{Config: config}); err != nil {
+				logger.WithError(err).Error("could not execute standalone check")
+			}
+		}
+	}
+}
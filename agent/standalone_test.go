@@ -0,0 +1,80 @@
+package agent
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/transport"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadStandaloneChecks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "standalone-checks")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	agent := NewAgent(FixtureConfig())
+
+	// A missing directory holds no checks
+	checks, err := agent.loadStandaloneChecks(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, checks)
+
+	writeFile := func(name, content string) {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+	writeFile("disk.json", `{"name": "disk", "command": "check-disk", "interval": 60}`)
+	writeFile("list.json", `[
+		{"name": "cpu", "command": "check-cpu", "interval": 10, "organization": "acme"},
+		{"name": "mem", "command": "check-mem", "cron": "* * * * *"}
+	]`)
+	writeFile("README", "not a check")
+
+	checks, err = agent.loadStandaloneChecks(dir)
+	require.NoError(t, err)
+	require.Len(t, checks, 3)
+	assert.Equal(t, "disk", checks[0].Name)
+	assert.Equal(t, DefaultOrganization, checks[0].Organization)
+	assert.Equal(t, DefaultEnvironment, checks[0].Environment)
+	assert.Equal(t, "acme", checks[1].Organization)
+	assert.Equal(t, "* * * * *", checks[2].Cron)
+
+	// Invalid checks are reported
+	writeFile("invalid.json", `{"name": "invalid", "command": "true"}`)
+	_, err = agent.loadStandaloneChecks(dir)
+	assert.Error(t, err)
+
+	writeFile("invalid.json", `{"name":`)
+	_, err = agent.loadStandaloneChecks(dir)
+	assert.Error(t, err)
+}
+
+func TestScheduleStandaloneCheck(t *testing.T) {
+	agent := NewAgent(FixtureConfig())
+	ch := make(chan *transport.Message, 5)
+	agent.sendq = ch
+
+	check := types.FixtureCheckConfig("standalone")
+	check.Command = testutil.CommandPath(filepath.Join(toolsDir, "true"))
+	check.Interval = 1
+
+	go agent.scheduleStandaloneCheck(check)
+	defer close(agent.stopping)
+
+	select {
+	case msg := <-ch:
+		event := &types.Event{}
+		require.NoError(t, json.Unmarshal(msg.Payload, event))
+		assert.Equal(t, "standalone", event.Check.Name)
+		assert.EqualValues(t, 0, event.Check.Status)
+	case <-time.After(5 * time.Second):
+		t.Fatal("standalone check was not executed")
+	}
+}
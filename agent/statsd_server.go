@@ -13,6 +13,7 @@ import (
 
 	"github.com/atlassian/gostatsd"
 	"github.com/atlassian/gostatsd/pkg/statsd"
+	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
 	"github.com/spf13/viper"
 )
@@ -75,21 +76,25 @@ func prepareMetrics(metrics *gostatsd.MetricMap) []*types.MetricPoint {
 	now := time.Now().Unix()
 	var metricsPoints []*types.MetricPoint
 	metrics.Counters.Each(func(key, tagsKey string, counter gostatsd.Counter) {
+		statsdMetrics.WithLabelValues("counter").Inc()
 		tags := composeMetricTags(tagsKey)
 		counters := composeCounterPoints(counter, key, tags, now)
 		metricsPoints = append(metricsPoints, counters...)
 	})
 	metrics.Timers.Each(func(key, tagsKey string, timer gostatsd.Timer) {
+		statsdMetrics.WithLabelValues("timer").Inc()
 		tags := composeMetricTags(tagsKey)
 		timers := composeTimerPoints(timer, key, tags, now)
 		metricsPoints = append(metricsPoints, timers...)
 	})
 	metrics.Gauges.Each(func(key, tagsKey string, gauge gostatsd.Gauge) {
+		statsdMetrics.WithLabelValues("gauge").Inc()
 		tags := composeMetricTags(tagsKey)
 		gauges := composeGaugePoints(gauge, key, tags, now)
 		metricsPoints = append(metricsPoints, gauges...)
 	})
 	metrics.Sets.Each(func(key, tagsKey string, set gostatsd.Set) {
+		statsdMetrics.WithLabelValues("set").Inc()
 		tags := composeMetricTags(tagsKey)
 		sets := composeSetPoints(set, key, tags, now)
 		metricsPoints = append(metricsPoints, sets...)
@@ -98,8 +103,19 @@ func prepareMetrics(metrics *gostatsd.MetricMap) []*types.MetricPoint {
 }
 
 func (c Client) sendMetrics(points []*types.MetricPoint) (retErr error) {
+	if len(points) == 0 {
+		return nil
+	}
+
+	cfg := c.agent.config.StatsdServer
+	defaultTags := composeMetricTags(strings.Join(cfg.Tags, ","))
+	for _, point := range points {
+		point.Tags = mergeMetricTags(point.Tags, defaultTags)
+	}
+
 	metrics := &types.Metrics{
-		Points: points,
+		Handlers: cfg.Handlers,
+		Points:   points,
 	}
 	event := &types.Event{
 		Entity:    c.agent.getAgentEntity(),
@@ -107,19 +123,41 @@ func (c Client) sendMetrics(points []*types.MetricPoint) (retErr error) {
 		Metrics:   metrics,
 	}
 
-	_, err := json.Marshal(event)
-	// msg, err := json.Marshal(event)
+	msg, err := json.Marshal(event)
 	if err != nil {
 		logger.WithError(err).Error("error marshaling metric event")
 		return err
 	}
 
-	// TODO: Swallow the message until we can handle metric events
-	// https://github.com/sensu/sensu-go/issues/1247
-	// c.agent.sendMessage(transport.MessageTypeEvent, msg)
+	c.agent.sendMessage(transport.MessageTypeEvent, msg)
 	return nil
 }
 
+// mergeMetricTags returns the given tags, followed by every default tag whose
+// name is not already present in tags. The tags slice is never modified since
+// it may be shared between several metric points.
+func mergeMetricTags(tags, defaults []*types.MetricTag) []*types.MetricTag {
+	if len(defaults) == 0 {
+		return tags
+	}
+
+	merged := make([]*types.MetricTag, 0, len(tags)+len(defaults))
+	merged = append(merged, tags...)
+	for _, d := range defaults {
+		found := false
+		for _, t := range tags {
+			if t.Name == d.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			merged = append(merged, d)
+		}
+	}
+	return merged
+}
+
 func composeMetricTags(tagsKey string) []*types.MetricTag {
 	tagsKeys := strings.Split(tagsKey, ",")
 	var tags []*types.MetricTag
@@ -1,12 +1,15 @@
 package agent
 
 import (
+	"encoding/json"
 	"testing"
 	"time"
 
 	"github.com/atlassian/gostatsd"
+	"github.com/sensu/sensu-go/transport"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewStatsdServer(t *testing.T) {
@@ -160,3 +163,53 @@ func TestComposeSetPoints(t *testing.T) {
 		assert.Equal(t, point.Tags, tags)
 	}
 }
+
+func TestMergeMetricTags(t *testing.T) {
+	tags := composeMetricTags("foo:bar,baz:qux")
+	defaults := composeMetricTags("foo:default,env:prod")
+
+	merged := mergeMetricTags(tags, defaults)
+	assert.Equal(t, []*types.MetricTag{
+		{Name: "foo", Value: "bar"},
+		{Name: "baz", Value: "qux"},
+		{Name: "env", Value: "prod"},
+	}, merged)
+
+	// The original tags must not be modified
+	assert.Len(t, tags, 2)
+
+	assert.Equal(t, tags, mergeMetricTags(tags, nil))
+}
+
+func TestSendMetrics(t *testing.T) {
+	c := FixtureConfig()
+	c.StatsdServer.Handlers = []string{"influxdb"}
+	c.StatsdServer.Tags = []string{"env:prod"}
+	a := &Agent{
+		config: c,
+		entity: types.FixtureEntity("entity"),
+		sendq:  make(chan *transport.Message, 1),
+	}
+	client := Client{agent: a}
+
+	// No message is sent when there are no points
+	require.NoError(t, client.sendMetrics(nil))
+	assert.Len(t, a.sendq, 0)
+
+	points := composeGaugePoints(gostatsd.Gauge{Value: 3}, "foo", nil, time.Now().Unix())
+	require.NoError(t, client.sendMetrics(points))
+	require.Len(t, a.sendq, 1)
+
+	msg := <-a.sendq
+	assert.Equal(t, transport.MessageTypeEvent, msg.Type)
+
+	var event types.Event
+	require.NoError(t, json.Unmarshal(msg.Payload, &event))
+	require.NoError(t, event.Validate())
+	assert.False(t, event.HasCheck())
+	require.True(t, event.HasMetrics())
+	assert.Equal(t, []string{"influxdb"}, event.Metrics.Handlers)
+	require.Len(t, event.Metrics.Points, 1)
+	assert.Equal(t, "foo.value", event.Metrics.Points[0].Name)
+	assert.Equal(t, []*types.MetricTag{{Name: "env", Value: "prod"}}, event.Metrics.Points[0].Tags)
+}
@@ -0,0 +1,85 @@
+package agent
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// subscriptionsFile is the file of the cache directory persisting the
+// subscriptions pushed by the backend, which take precedence over the
+// configured ones
+const subscriptionsFile = "subscriptions.json"
+
+// handleSubscriptionsUpdate replaces the subscriptions of the agent with the
+// ones pushed by the backend, and persists them so they survive a restart. The
+// session of the agent is already subscribed to them.
+func (a *Agent) handleSubscriptionsUpdate(payload []byte) error {
+	update := &types.SubscriptionsUpdate{}
+	if err := json.Unmarshal(payload, update); err != nil {
+		return err
+	}
+
+	// The entity subscription is added by the backend
+	entitySubscription := types.GetEntitySubscription(a.config.AgentID)
+	subscriptions := []string{}
+	for _, sub := range update.Subscriptions {
+		if sub != entitySubscription {
+			subscriptions = append(subscriptions, sub)
+		}
+	}
+
+	logger.WithField("subscriptions", subscriptions).Info("subscriptions updated by the backend")
+	a.setSubscriptions(subscriptions)
+
+	if err := a.saveSubscriptions(subscriptions); err != nil {
+		logger.WithError(err).Error("unable to persist the subscriptions")
+	}
+
+	// Send the new subscriptions with the entity right away
+	return a.sendKeepalive()
+}
+
+// setSubscriptions replaces the agent entity with a copy holding the given
+// subscriptions, which are sent with the next keepalive and when reconnecting.
+func (a *Agent) setSubscriptions(subscriptions []string) {
+	a.withEntity(func(entity *types.Entity) {
+		entity.Subscriptions = subscriptions
+	})
+}
+
+// loadSubscriptions replaces the subscriptions of the agent with the ones
+// persisted in the cache directory, if any.
+func (a *Agent) loadSubscriptions() error {
+	b, err := ioutil.ReadFile(filepath.Join(a.config.CacheDir, subscriptionsFile))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	subscriptions := []string{}
+	if err := json.Unmarshal(b, &subscriptions); err != nil {
+		return err
+	}
+
+	a.setSubscriptions(subscriptions)
+	return nil
+}
+
+// saveSubscriptions persists the subscriptions in the cache directory
+func (a *Agent) saveSubscriptions(subscriptions []string) error {
+	if err := os.MkdirAll(a.config.CacheDir, 0755); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(subscriptions)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(a.config.CacheDir, subscriptionsFile), b, 0644)
+}
@@ -0,0 +1,55 @@
+package agent
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/sensu/sensu-go/transport"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleSubscriptionsUpdate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "subscriptions")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	config := FixtureConfig()
+	config.CacheDir = dir
+	config.Subscriptions = []string{"linux"}
+	agent := NewAgent(config)
+
+	// Nothing is persisted yet
+	require.NoError(t, agent.loadSubscriptions())
+	assert.Equal(t, []string{"linux"}, agent.getAgentEntity().Subscriptions)
+
+	entity := agent.getAgentEntity()
+	update := &types.SubscriptionsUpdate{
+		Subscriptions: []string{"web", types.GetEntitySubscription(config.AgentID)},
+	}
+	payload, err := json.Marshal(update)
+	require.NoError(t, err)
+	require.NoError(t, agent.handleSubscriptionsUpdate(payload))
+
+	// The entity is replaced and sent with a keepalive
+	assert.Equal(t, []string{"linux"}, entity.Subscriptions)
+	assert.Equal(t, []string{"web"}, agent.getAgentEntity().Subscriptions)
+	assert.Equal(t, "web", agent.buildTransportHeaderMap().Get(transport.HeaderKeySubscriptions))
+
+	msg := <-agent.sendq
+	assert.Equal(t, transport.MessageTypeKeepalive, msg.Type)
+	keepalive := &types.Event{}
+	require.NoError(t, json.Unmarshal(msg.Payload, keepalive))
+	assert.Equal(t, []string{"web"}, keepalive.Entity.Subscriptions)
+
+	// The subscriptions are restored by a new agent
+	agent = NewAgent(config)
+	require.NoError(t, agent.loadSubscriptions())
+	assert.Equal(t, []string{"web"}, agent.getAgentEntity().Subscriptions)
+
+	// Invalid payloads are rejected
+	assert.Error(t, agent.handleSubscriptionsUpdate([]byte("{")))
+}
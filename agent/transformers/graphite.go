@@ -0,0 +1,69 @@
+package transformers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/types"
+)
+
+// ParseGraphite parses the Graphite plaintext protocol, where each line is
+// formatted as "metric.path value timestamp". Graphite tags are supported
+// using the "metric.path;tag1=value1;tag2=value2" syntax.
+func ParseGraphite(output string, timestamp int64) []*types.MetricPoint {
+	var points []*types.MetricPoint
+	for _, line := range lines(output) {
+		point, err := parseGraphiteLine(line, timestamp)
+		if err != nil {
+			logger.WithError(err).WithFields(logrus.Fields{
+				"format": types.GraphiteOutputMetricFormat,
+				"line":   line,
+			}).Warn("unable to parse metric")
+			continue
+		}
+		points = append(points, point)
+	}
+	return points
+}
+
+func parseGraphiteLine(line string, timestamp int64) (*types.MetricPoint, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 2 || len(fields) > 3 {
+		return nil, fmt.Errorf("expected 2 or 3 fields, got %d", len(fields))
+	}
+
+	path := strings.Split(fields[0], ";")
+	point := &types.MetricPoint{
+		Name:      path[0],
+		Timestamp: timestamp,
+		Tags:      []*types.MetricTag{},
+	}
+	if point.Name == "" {
+		return nil, fmt.Errorf("empty metric path")
+	}
+	for _, tag := range path[1:] {
+		t, err := splitTag(tag, "=")
+		if err != nil {
+			return nil, err
+		}
+		point.Tags = append(point.Tags, t)
+	}
+
+	value, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value: %s", err)
+	}
+	point.Value = value
+
+	if len(fields) == 3 {
+		ts, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp: %s", err)
+		}
+		point.Timestamp = normalizeTimestamp(ts)
+	}
+
+	return point, nil
+}
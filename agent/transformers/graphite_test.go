@@ -0,0 +1,58 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseGraphite(t *testing.T) {
+	testCases := []struct {
+		name     string
+		output   string
+		expected []*types.MetricPoint
+	}{
+		{
+			name:     "empty output",
+			output:   "",
+			expected: nil,
+		},
+		{
+			name:   "metrics with and without timestamp",
+			output: "foo.bar 42 1520000000\nfoo.baz 3.14\n",
+			expected: []*types.MetricPoint{
+				{Name: "foo.bar", Value: 42, Timestamp: 1520000000, Tags: []*types.MetricTag{}},
+				{Name: "foo.baz", Value: 3.14, Timestamp: 10, Tags: []*types.MetricTag{}},
+			},
+		},
+		{
+			name:   "tagged metric",
+			output: "foo.bar;dc=east;env=prod 1 1520000000",
+			expected: []*types.MetricPoint{
+				{Name: "foo.bar", Value: 1, Timestamp: 1520000000, Tags: []*types.MetricTag{
+					{Name: "dc", Value: "east"},
+					{Name: "env", Value: "prod"},
+				}},
+			},
+		},
+		{
+			name:   "invalid lines are skipped",
+			output: "foo\nfoo.bar baz 1520000000\nfoo.bar 1 qux\nfoo.bar;dc 1\nfoo.bar 1 1520000000",
+			expected: []*types.MetricPoint{
+				{Name: "foo.bar", Value: 1, Timestamp: 1520000000, Tags: []*types.MetricTag{}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ParseGraphite(tc.output, 10))
+		})
+	}
+}
+
+func TestParseGraphiteLineFields(t *testing.T) {
+	_, err := parseGraphiteLine("foo.bar 1 1520000000 baz", 10)
+	assert.EqualError(t, err, "expected 2 or 3 fields, got 4")
+}
@@ -0,0 +1,157 @@
+package transformers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/types"
+)
+
+// ParseInflux parses the InfluxDB line protocol, where each line is formatted
+// as "measurement[,tag=value...] field=value[,field=value...] [timestamp]".
+// A metric point named "measurement.field" is created for every numeric field
+// of the line, and timestamps are expected to be expressed in nanoseconds.
+func ParseInflux(output string, timestamp int64) []*types.MetricPoint {
+	var points []*types.MetricPoint
+	for _, line := range lines(output) {
+		// Lines starting with a hash are comments
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		p, err := parseInfluxLine(line, timestamp)
+		if err != nil {
+			logger.WithError(err).WithFields(logrus.Fields{
+				"format": types.InfluxDBOutputMetricFormat,
+				"line":   line,
+			}).Warn("unable to parse metric")
+			continue
+		}
+		points = append(points, p...)
+	}
+	return points
+}
+
+func parseInfluxLine(line string, timestamp int64) ([]*types.MetricPoint, error) {
+	sections := splitEscaped(line, ' ')
+	if len(sections) < 2 || len(sections) > 3 {
+		return nil, fmt.Errorf("expected 2 or 3 sections, got %d", len(sections))
+	}
+
+	if len(sections) == 3 {
+		ts, err := strconv.ParseInt(sections[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timestamp: %s", err)
+		}
+		timestamp = normalizeTimestamp(ts)
+	}
+
+	key := splitEscaped(sections[0], ',')
+	measurement := unescape(key[0])
+	if measurement == "" {
+		return nil, fmt.Errorf("empty measurement")
+	}
+	tags := make([]*types.MetricTag, 0, len(key)-1)
+	for _, tag := range key[1:] {
+		name, value, ok := splitKeyValue(tag)
+		if !ok {
+			return nil, fmt.Errorf("invalid tag: %q", tag)
+		}
+		tags = append(tags, &types.MetricTag{Name: unescape(name), Value: unescape(value)})
+	}
+
+	var points []*types.MetricPoint
+	for _, field := range splitEscaped(sections[1], ',') {
+		name, raw, ok := splitKeyValue(field)
+		if !ok {
+			return nil, fmt.Errorf("invalid field: %q", field)
+		}
+		value, ok := parseInfluxValue(raw)
+		if !ok {
+			// Only numeric and boolean values can be represented as metric points
+			continue
+		}
+		points = append(points, &types.MetricPoint{
+			Name:      measurement + "." + unescape(name),
+			Value:     value,
+			Timestamp: timestamp,
+			Tags:      tags,
+		})
+	}
+
+	if len(points) == 0 {
+		return nil, fmt.Errorf("no numeric field")
+	}
+
+	return points, nil
+}
+
+// parseInfluxValue parses a field value, which can either be a float, an
+// integer (suffixed by "i"), a boolean or a string.
+func parseInfluxValue(s string) (float64, bool) {
+	switch s {
+	case "t", "T", "true", "True", "TRUE":
+		return 1, true
+	case "f", "F", "false", "False", "FALSE":
+		return 0, true
+	}
+	value, err := strconv.ParseFloat(strings.TrimSuffix(s, "i"), 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
+
+// splitEscaped splits s around every occurrence of sep that is neither
+// escaped with a backslash nor enclosed in double quotes.
+func splitEscaped(s string, sep rune) []string {
+	var (
+		result  []string
+		escaped bool
+		quoted  bool
+		start   int
+	)
+	for i, c := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '"':
+			quoted = !quoted
+		case c == sep && !quoted:
+			if i > start {
+				result = append(result, s[start:i])
+			}
+			start = i + 1
+		}
+	}
+	if start < len(s) {
+		result = append(result, s[start:])
+	}
+	return result
+}
+
+// splitKeyValue splits s around its first equal sign that is not escaped with
+// a backslash, so tag keys and values and field keys can contain "\=".
+func splitKeyValue(s string) (string, string, bool) {
+	var escaped bool
+	for i, c := range s {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '=':
+			return s[:i], s[i+1:], i > 0
+		}
+	}
+	return "", "", false
+}
+
+var unescaper = strings.NewReplacer(`\,`, ",", `\ `, " ", `\=`, "=")
+
+func unescape(s string) string {
+	return unescaper.Replace(s)
+}
@@ -0,0 +1,66 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInflux(t *testing.T) {
+	tags := []*types.MetricTag{
+		{Name: "host", Value: "server 01"},
+		{Name: "region", Value: "us-west"},
+	}
+
+	testCases := []struct {
+		name     string
+		output   string
+		expected []*types.MetricPoint
+	}{
+		{
+			name:     "empty output",
+			output:   "",
+			expected: nil,
+		},
+		{
+			name:   "multiple fields with tags",
+			output: `cpu,host=server\ 01,region=us-west value=0.64,count=3i,up=true,msg="a b" 1520000000000000000`,
+			expected: []*types.MetricPoint{
+				{Name: "cpu.value", Value: 0.64, Timestamp: 1520000000, Tags: tags},
+				{Name: "cpu.count", Value: 3, Timestamp: 1520000000, Tags: tags},
+				{Name: "cpu.up", Value: 1, Timestamp: 1520000000, Tags: tags},
+			},
+		},
+		{
+			name:   "metric without timestamp",
+			output: "# comment\ncpu value=1",
+			expected: []*types.MetricPoint{
+				{Name: "cpu.value", Value: 1, Timestamp: 10, Tags: []*types.MetricTag{}},
+			},
+		},
+		{
+			name:   "escaped tag keys, tag values and field keys",
+			output: `cpu,a\=b=c\,d,path=x\=y v\=1=2 1520000000`,
+			expected: []*types.MetricPoint{
+				{Name: "cpu.v=1", Value: 2, Timestamp: 1520000000, Tags: []*types.MetricTag{
+					{Name: "a=b", Value: "c,d"},
+					{Name: "path", Value: "x=y"},
+				}},
+			},
+		},
+		{
+			name:   "invalid lines are skipped",
+			output: "cpu\ncpu value=1 foo\ncpu,host value=1\ncpu value\ncpu msg=\"foo\"\ncpu,host\\=a value=1\ncpu value=2",
+			expected: []*types.MetricPoint{
+				{Name: "cpu.value", Value: 2, Timestamp: 10, Tags: []*types.MetricTag{}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ParseInflux(tc.output, 10))
+		})
+	}
+}
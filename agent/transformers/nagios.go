@@ -0,0 +1,110 @@
+package transformers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/types"
+)
+
+// ParseNagios parses the performance data of a Nagios plugin output, which
+// follows the pipe character and is formatted as
+// "'label'=value[UOM];[warn];[crit];[min];[max]", with space separated
+// entries. The performance data can span several lines, as supported by the
+// Nagios plugin API.
+func ParseNagios(output string, timestamp int64) []*types.MetricPoint {
+	var points []*types.MetricPoint
+	for _, perfdata := range nagiosPerfdata(output) {
+		point, err := parseNagiosPerfdata(perfdata, timestamp)
+		if err != nil {
+			logger.WithError(err).WithFields(logrus.Fields{
+				"format":   types.NagiosOutputMetricFormat,
+				"perfdata": perfdata,
+			}).Warn("unable to parse metric")
+			continue
+		}
+		points = append(points, point)
+	}
+	return points
+}
+
+// nagiosPerfdata returns the individual performance data entries found in the
+// output. The first line holds performance data after a pipe character, and
+// the long text that may follow holds additional performance data after
+// another pipe character.
+func nagiosPerfdata(output string) []string {
+	var (
+		entries []string
+		found   bool
+	)
+	for i, line := range lines(output) {
+		if i == 0 || !found {
+			idx := strings.Index(line, "|")
+			if idx < 0 {
+				continue
+			}
+			line = line[idx+1:]
+			// Any pipe in the long text indicates that the rest of the output
+			// is performance data
+			found = i > 0
+		}
+		entries = append(entries, splitNagiosEntries(line)...)
+	}
+	return entries
+}
+
+// splitNagiosEntries splits a line of performance data into its entries,
+// while taking single quoted labels, which can contain spaces, into account.
+func splitNagiosEntries(line string) []string {
+	var (
+		entries []string
+		quoted  bool
+		start   int
+	)
+	for i, c := range line {
+		switch {
+		case c == '\'':
+			quoted = !quoted
+		case c == ' ' && !quoted:
+			if i > start {
+				entries = append(entries, line[start:i])
+			}
+			start = i + 1
+		}
+	}
+	if start < len(line) {
+		entries = append(entries, line[start:])
+	}
+	return entries
+}
+
+func parseNagiosPerfdata(perfdata string, timestamp int64) (*types.MetricPoint, error) {
+	idx := strings.LastIndex(perfdata, "=")
+	if idx <= 0 {
+		return nil, fmt.Errorf("missing label")
+	}
+	label := strings.Trim(perfdata[:idx], "'")
+	if label == "" {
+		return nil, fmt.Errorf("missing label")
+	}
+
+	// Only the value is kept, without its unit of measurement nor the warning,
+	// critical, minimum and maximum values
+	value := strings.Split(perfdata[idx+1:], ";")[0]
+	value = strings.TrimRightFunc(value, func(r rune) bool {
+		return !strings.ContainsRune("0123456789.", r)
+	})
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value: %s", err)
+	}
+
+	return &types.MetricPoint{
+		Name:      label,
+		Value:     v,
+		Timestamp: timestamp,
+		Tags:      []*types.MetricTag{},
+	}, nil
+}
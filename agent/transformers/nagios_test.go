@@ -0,0 +1,52 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseNagios(t *testing.T) {
+	testCases := []struct {
+		name     string
+		output   string
+		expected []*types.MetricPoint
+	}{
+		{
+			name:     "no performance data",
+			output:   "PING OK - Packet loss = 0%",
+			expected: nil,
+		},
+		{
+			name:   "single line",
+			output: "PING OK - Packet loss = 0%, RTA = 0.80 ms | percent_packet_loss=0%;60;80;0;100 rta=0.80ms",
+			expected: []*types.MetricPoint{
+				{Name: "percent_packet_loss", Value: 0, Timestamp: 10, Tags: []*types.MetricTag{}},
+				{Name: "rta", Value: 0.8, Timestamp: 10, Tags: []*types.MetricTag{}},
+			},
+		},
+		{
+			name:   "long text with performance data",
+			output: "DISK OK - free space: / 3326 MB (56%); | /=2643MB;5948;5958;0;5968\n/ 15272 MB (77%);\n/boot 68 MB (69%); | /boot=68MB;88;93;0;98\n'/home dir'=69357MB;253404;253409;0;253414",
+			expected: []*types.MetricPoint{
+				{Name: "/", Value: 2643, Timestamp: 10, Tags: []*types.MetricTag{}},
+				{Name: "/boot", Value: 68, Timestamp: 10, Tags: []*types.MetricTag{}},
+				{Name: "/home dir", Value: 69357, Timestamp: 10, Tags: []*types.MetricTag{}},
+			},
+		},
+		{
+			name:   "invalid entries are skipped",
+			output: "OK | foo =1 bar=baz qux=U time=1.5s",
+			expected: []*types.MetricPoint{
+				{Name: "time", Value: 1.5, Timestamp: 10, Tags: []*types.MetricTag{}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ParseNagios(tc.output, 10))
+		})
+	}
+}
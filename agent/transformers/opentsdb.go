@@ -0,0 +1,65 @@
+package transformers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/types"
+)
+
+// ParseOpenTSDB parses the OpenTSDB telnet line protocol, where each line is
+// formatted as "[put] metric timestamp value tagk1=tagv1 tagk2=tagv2".
+// Timestamps can either be expressed in seconds or milliseconds.
+func ParseOpenTSDB(output string, timestamp int64) []*types.MetricPoint {
+	var points []*types.MetricPoint
+	for _, line := range lines(output) {
+		point, err := parseOpenTSDBLine(line)
+		if err != nil {
+			logger.WithError(err).WithFields(logrus.Fields{
+				"format": types.OpenTSDBOutputMetricFormat,
+				"line":   line,
+			}).Warn("unable to parse metric")
+			continue
+		}
+		points = append(points, point)
+	}
+	return points
+}
+
+func parseOpenTSDBLine(line string) (*types.MetricPoint, error) {
+	fields := strings.Fields(line)
+	if len(fields) > 0 && fields[0] == "put" {
+		fields = fields[1:]
+	}
+	if len(fields) < 3 {
+		return nil, fmt.Errorf("expected at least 3 fields, got %d", len(fields))
+	}
+
+	ts, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timestamp: %s", err)
+	}
+
+	value, err := strconv.ParseFloat(fields[2], 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value: %s", err)
+	}
+
+	point := &types.MetricPoint{
+		Name:      fields[0],
+		Value:     value,
+		Timestamp: normalizeTimestamp(ts),
+		Tags:      []*types.MetricTag{},
+	}
+	for _, tag := range fields[3:] {
+		t, err := splitTag(tag, "=")
+		if err != nil {
+			return nil, err
+		}
+		point.Tags = append(point.Tags, t)
+	}
+
+	return point, nil
+}
@@ -0,0 +1,46 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOpenTSDB(t *testing.T) {
+	testCases := []struct {
+		name     string
+		output   string
+		expected []*types.MetricPoint
+	}{
+		{
+			name:     "empty output",
+			output:   "",
+			expected: nil,
+		},
+		{
+			name:   "metrics with put command and milliseconds",
+			output: "put sys.cpu.user 1520000000 42.5 host=webserver01 cpu=0\nsys.cpu.nice 1520000000123 18",
+			expected: []*types.MetricPoint{
+				{Name: "sys.cpu.user", Value: 42.5, Timestamp: 1520000000, Tags: []*types.MetricTag{
+					{Name: "host", Value: "webserver01"},
+					{Name: "cpu", Value: "0"},
+				}},
+				{Name: "sys.cpu.nice", Value: 18, Timestamp: 1520000000, Tags: []*types.MetricTag{}},
+			},
+		},
+		{
+			name:   "invalid lines are skipped",
+			output: "sys.cpu.user 1520000000\nsys.cpu.user foo 1\nsys.cpu.user 1520000000 foo\nsys.cpu.user 1520000000 1 host\nsys.cpu.user 1520000000 1",
+			expected: []*types.MetricPoint{
+				{Name: "sys.cpu.user", Value: 1, Timestamp: 1520000000, Tags: []*types.MetricTag{}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ParseOpenTSDB(tc.output, 10))
+		})
+	}
+}
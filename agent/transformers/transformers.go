@@ -0,0 +1,63 @@
+// Package transformers extracts metric points from the output of checks, which
+// can follow one of the metric formats listed in types.OutputMetricFormats.
+package transformers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// A ParseFunc parses the output of a check into a list of metric points. The
+// timestamp is used for every point that does not provide its own. Lines that
+// can't be parsed are logged and skipped.
+type ParseFunc func(output string, timestamp int64) []*types.MetricPoint
+
+var parsers = map[string]ParseFunc{
+	types.GraphiteOutputMetricFormat: ParseGraphite,
+	types.InfluxDBOutputMetricFormat: ParseInflux,
+	types.NagiosOutputMetricFormat:   ParseNagios,
+	types.OpenTSDBOutputMetricFormat: ParseOpenTSDB,
+}
+
+// Transform parses the output of a check according to the given metric format
+// and returns the extracted metric points.
+func Transform(format, output string, timestamp int64) ([]*types.MetricPoint, error) {
+	parse, ok := parsers[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown output metric format: %q", format)
+	}
+	return parse(output, timestamp), nil
+}
+
+// lines returns the non-empty, trimmed lines of the output.
+func lines(output string) []string {
+	var result []string
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+// splitTag splits a name=value (or name:value) tag pair.
+func splitTag(tag, sep string) (*types.MetricTag, error) {
+	kv := strings.SplitN(tag, sep, 2)
+	if len(kv) != 2 || kv[0] == "" {
+		return nil, fmt.Errorf("invalid tag: %q", tag)
+	}
+	return &types.MetricTag{Name: kv[0], Value: kv[1]}, nil
+}
+
+// normalizeTimestamp converts timestamps expressed in milliseconds,
+// microseconds or nanoseconds into seconds, which is the precision used by the
+// agent for every event.
+func normalizeTimestamp(ts int64) int64 {
+	for ts > 1e10 {
+		ts /= 1000
+	}
+	return ts
+}
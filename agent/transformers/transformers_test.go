@@ -0,0 +1,25 @@
+package transformers
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransform(t *testing.T) {
+	points, err := Transform(types.GraphiteOutputMetricFormat, "foo.bar 42 123456789", 0)
+	require.NoError(t, err)
+	require.Len(t, points, 1)
+	assert.Equal(t, "foo.bar", points[0].Name)
+
+	_, err = Transform("foo", "foo.bar 42 123456789", 0)
+	assert.Error(t, err)
+}
+
+func TestNormalizeTimestamp(t *testing.T) {
+	assert.Equal(t, int64(1520000000), normalizeTimestamp(1520000000))
+	assert.Equal(t, int64(1520000000), normalizeTimestamp(1520000000123))
+	assert.Equal(t, int64(1520000000), normalizeTimestamp(1520000000123456789))
+}
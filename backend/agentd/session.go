@@ -9,6 +9,7 @@ import (
 
 	"github.com/Sirupsen/logrus"
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/handler"
@@ -16,6 +17,17 @@ import (
 	"github.com/sensu/sensu-go/types"
 )
 
+var sessionsGauge = prometheus.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "sensu_agentd_sessions",
+		Help: "Number of agent sessions connected to the backend",
+	},
+)
+
+func init() {
+	prometheus.MustRegister(sessionsGauge)
+}
+
 // SessionStore specifies the storage requirements of the Session.
 type SessionStore interface {
 	store.EntityStore
@@ -40,13 +52,15 @@ type Session struct {
 	checkChannel chan interface{}
 	bus          messaging.MessageBus
 
-	subscriptions chan messaging.Subscription
+	subscriptions   map[string]messaging.Subscription
+	subscriptionsMu sync.Mutex
 }
 
 func newSessionHandler(s *Session) *handler.MessageHandler {
 	handler := handler.NewMessageHandler()
 	handler.AddHandler(transport.MessageTypeKeepalive, s.handleKeepalive)
 	handler.AddHandler(transport.MessageTypeEvent, s.handleEvent)
+	handler.AddHandler(transport.MessageTypeDeregistration, s.handleDeregistration)
 
 	return handler
 }
@@ -92,7 +106,7 @@ func NewSession(cfg SessionConfig, conn transport.Transport, bus messaging.Messa
 		checkChannel:  make(chan interface{}, 100),
 		store:         store,
 		bus:           bus,
-		subscriptions: make(chan messaging.Subscription, len(cfg.Subscriptions)),
+		subscriptions: make(map[string]messaging.Subscription, len(cfg.Subscriptions)),
 	}
 	s.handler = newSessionHandler(s)
 	return s, nil
@@ -151,28 +165,35 @@ func (s *Session) subPump() {
 	for {
 		select {
 		case c := <-s.checkChannel:
-			request, ok := c.(*types.CheckRequest)
-			if !ok {
+			switch msg := c.(type) {
+			case *types.CheckRequest:
+				s.sendCheckRequest(msg)
+			case *types.SubscriptionsUpdate:
+				if err := s.updateSubscriptions(msg.Subscriptions); err != nil {
+					logger.WithError(err).Error("session failed to update subscriptions")
+				}
+			default:
 				logger.Error("session received non-config over check channel")
-				continue
-			}
-
-			configBytes, err := json.Marshal(request)
-			if err != nil {
-				logger.WithError(err).Error("session failed to serialize check request")
-			}
-
-			msg := &transport.Message{
-				Type:    types.CheckRequestType,
-				Payload: configBytes,
 			}
-			s.sendq <- msg
 		case <-s.stopping:
 			return
 		}
 	}
 }
 
+func (s *Session) sendCheckRequest(request *types.CheckRequest) {
+	configBytes, err := json.Marshal(request)
+	if err != nil {
+		logger.WithError(err).Error("session failed to serialize check request")
+	}
+
+	msg := &transport.Message{
+		Type:    types.CheckRequestType,
+		Payload: configBytes,
+	}
+	s.sendq <- msg
+}
+
 func (s *Session) sendPump() {
 	defer func() {
 		s.wg.Done()
@@ -204,32 +225,138 @@ func (s *Session) sendPump() {
 // 3. Start subscription pump
 // 5. Ensure bus unsubscribe when the session shuts down.
 func (s *Session) Start() (err error) {
+	sessionsGauge.Inc()
 	s.wg = &sync.WaitGroup{}
 	s.wg.Add(3)
 	go s.sendPump()
 	go s.recvPump()
 	go s.subPump()
 
-	org, env := s.cfg.Organization, s.cfg.Environment
-	agentID := fmt.Sprintf("%s:%s:%s", org, env, s.cfg.AgentID)
-
 	defer func() {
 		if err != nil {
 			s.Stop()
 		}
 	}()
 
+	s.subscriptionsMu.Lock()
 	for _, sub := range s.cfg.Subscriptions {
-		topic := messaging.SubscriptionTopic(org, env, sub)
-		logger.WithField("topic", topic).Debug("subscribing to topic")
-		subscription, err := s.bus.Subscribe(topic, agentID, s)
-		if err != nil {
-			logger.WithError(err).Error("error starting subscription")
-			return err
+		if err = s.subscribe(sub); err != nil {
+			break
 		}
-		s.subscriptions <- subscription
 	}
-	close(s.subscriptions)
+	s.subscriptionsMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return s.restoreSubscriptions()
+}
+
+// restoreSubscriptions replaces the subscriptions of the agent with the ones
+// of its entity, if they were set through the API while it was disconnected or
+// before its cache was lost.
+func (s *Session) restoreSubscriptions() error {
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, s.cfg.Organization)
+	ctx = context.WithValue(ctx, types.EnvironmentKey, s.cfg.Environment)
+	entity, err := s.store.GetEntityByID(ctx, s.cfg.AgentID)
+	if err != nil {
+		return err
+	}
+	if !entity.GetManagedSubscriptions() {
+		return nil
+	}
+
+	return s.updateSubscriptions(entity.Subscriptions)
+}
+
+// subscribe subscribes the session to the topic of the subscription, unless
+// already subscribed. The subscriptions lock must be held.
+func (s *Session) subscribe(sub string) error {
+	if _, ok := s.subscriptions[sub]; ok {
+		return nil
+	}
+
+	org, env := s.cfg.Organization, s.cfg.Environment
+	agentID := fmt.Sprintf("%s:%s:%s", org, env, s.cfg.AgentID)
+	topic := messaging.SubscriptionTopic(org, env, sub)
+	logger.WithField("topic", topic).Debug("subscribing to topic")
+
+	subscription, err := s.bus.Subscribe(topic, agentID, s)
+	if err != nil {
+		logger.WithError(err).Error("error starting subscription")
+		return err
+	}
+	s.subscriptions[sub] = subscription
+
+	return nil
+}
+
+// unsubscribe cancels the subscription of the session to the topic of the
+// subscription. The subscriptions lock must be held.
+func (s *Session) unsubscribe(sub string) {
+	subscription, ok := s.subscriptions[sub]
+	if !ok {
+		return
+	}
+
+	if err := subscription.Cancel(); err != nil {
+		logger.WithError(err).Error("unable to unsubscribe from message bus")
+	}
+	delete(s.subscriptions, sub)
+}
+
+// updateSubscriptions replaces the subscriptions of the session, subscribing
+// to the topics of the new subscriptions and unsubscribing from the ones that
+// were removed, and pushes them to the agent. The entity subscription of the
+// agent is always kept.
+func (s *Session) updateSubscriptions(subscriptions []string) error {
+	entitySubscription := types.GetEntitySubscription(s.cfg.AgentID)
+
+	agentSubscriptions := []string{}
+	wanted := map[string]struct{}{entitySubscription: {}}
+	for _, sub := range subscriptions {
+		if _, ok := wanted[sub]; ok {
+			continue
+		}
+		wanted[sub] = struct{}{}
+		agentSubscriptions = append(agentSubscriptions, sub)
+	}
+
+	s.subscriptionsMu.Lock()
+	for sub := range s.subscriptions {
+		if _, ok := wanted[sub]; !ok {
+			s.unsubscribe(sub)
+		}
+	}
+	var err error
+	for sub := range wanted {
+		if err = s.subscribe(sub); err != nil {
+			break
+		}
+	}
+	s.subscriptionsMu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	logger.WithFields(logrus.Fields{
+		"id":            s.cfg.AgentID,
+		"subscriptions": agentSubscriptions,
+	}).Info("agent subscriptions updated")
+
+	payload, err := json.Marshal(&types.SubscriptionsUpdate{Subscriptions: agentSubscriptions})
+	if err != nil {
+		return err
+	}
+
+	msg := &transport.Message{
+		Type:    types.SubscriptionsUpdateType,
+		Payload: payload,
+	}
+	select {
+	case s.sendq <- msg:
+	case <-s.stopping:
+	}
 
 	return nil
 }
@@ -239,12 +366,13 @@ func (s *Session) Start() (err error) {
 func (s *Session) Stop() {
 	close(s.stopping)
 	s.wg.Wait()
+	sessionsGauge.Dec()
 
+	s.subscriptionsMu.Lock()
 	for sub := range s.subscriptions {
-		if err := sub.Cancel(); err != nil {
-			logger.WithError(err).Error("unable to unsubscribe from message bus")
-		}
+		s.unsubscribe(sub)
 	}
+	s.subscriptionsMu.Unlock()
 	close(s.checkChannel)
 }
 
@@ -269,6 +397,35 @@ func (s *Session) handleKeepalive(payload []byte) error {
 	return s.bus.Publish(messaging.TopicKeepalive, keepalive)
 }
 
+// handleDeregistration relays the deregistration of an agent shutting down to
+// keepalived, which deregisters the entity right away.
+func (s *Session) handleDeregistration(payload []byte) error {
+	deregistration := &types.Event{}
+	if err := json.Unmarshal(payload, deregistration); err != nil {
+		return err
+	}
+
+	if deregistration.Entity == nil {
+		return errors.New("deregistration does not contain an entity")
+	}
+
+	// An agent can only deregister its own entity
+	entity := deregistration.Entity
+	if entity.ID != s.cfg.AgentID || entity.Organization != s.cfg.Organization || entity.Environment != s.cfg.Environment {
+		return fmt.Errorf("deregistration of entity %s/%s/%s by agent %s/%s/%s",
+			entity.Organization, entity.Environment, entity.ID,
+			s.cfg.Organization, s.cfg.Environment, s.cfg.AgentID)
+	}
+
+	if deregistration.Timestamp == 0 {
+		return errors.New("deregistration contains invalid timestamp")
+	}
+
+	deregistration.Entity.Subscriptions = addEntitySubscription(deregistration.Entity.ID, deregistration.Entity.Subscriptions)
+
+	return s.bus.Publish(messaging.TopicDeregistration, deregistration)
+}
+
 func (s *Session) handleEvent(payload []byte) error {
 	// Decode the payload to an event
 	event := &types.Event{}
@@ -1,6 +1,7 @@
 package agentd
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -50,6 +51,14 @@ func (t *testTransport) Receive() (*transport.Message, error) {
 	return <-t.sendCh, nil
 }
 
+type testSubscriber struct {
+	ch chan interface{}
+}
+
+func (ts testSubscriber) Receiver() chan<- interface{} {
+	return ts.ch
+}
+
 func TestGoodSessionConfig(t *testing.T) {
 	conn := &testTransport{
 		sendCh: make(chan *transport.Message, 10),
@@ -111,3 +120,141 @@ func TestBadSessionConfig(t *testing.T) {
 	assert.Nil(t, session)
 	assert.Error(t, err)
 }
+
+func TestSessionUpdateSubscriptions(t *testing.T) {
+	conn := &testTransport{
+		sendCh: make(chan *transport.Message, 10),
+	}
+
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+		RingGetter: &mockring.Getter{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+
+	st := &mockstore.MockStore{}
+	st.On("GetEnvironment", mock.Anything, "org", "env").Return(&types.Environment{}, nil)
+
+	cfg := SessionConfig{
+		AgentID:       "testing",
+		Organization:  "org",
+		Environment:   "env",
+		Subscriptions: []string{"linux", "entity:testing"},
+	}
+	session, err := NewSession(cfg, conn, bus, st)
+	require.NoError(t, err)
+
+	session.subscriptionsMu.Lock()
+	for _, sub := range cfg.Subscriptions {
+		require.NoError(t, session.subscribe(sub))
+	}
+	session.subscriptionsMu.Unlock()
+
+	require.NoError(t, session.updateSubscriptions([]string{"web", "web", "entity:testing"}))
+
+	// The entity subscription is kept
+	assert.Len(t, session.subscriptions, 2)
+	assert.Contains(t, session.subscriptions, "web")
+	assert.Contains(t, session.subscriptions, "entity:testing")
+
+	// The update is pushed to the agent, without the entity subscription
+	msg := <-session.sendq
+	assert.Equal(t, types.SubscriptionsUpdateType, msg.Type)
+	update := &types.SubscriptionsUpdate{}
+	require.NoError(t, json.Unmarshal(msg.Payload, update))
+	assert.Equal(t, []string{"web"}, update.Subscriptions)
+
+	// Only the topics of the current subscriptions reach the session
+	request := &types.CheckRequest{Config: types.FixtureCheckConfig("check")}
+	require.NoError(t, bus.Publish(messaging.SubscriptionTopic("org", "env", "linux"), request))
+	assert.Len(t, session.checkChannel, 0)
+	require.NoError(t, bus.Publish(messaging.SubscriptionTopic("org", "env", "web"), request))
+	assert.Len(t, session.checkChannel, 1)
+}
+
+func TestSessionRestoreSubscriptions(t *testing.T) {
+	conn := &testTransport{
+		sendCh: make(chan *transport.Message, 10),
+	}
+
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+		RingGetter: &mockring.Getter{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+
+	entity := types.FixtureEntity("testing")
+	entity.Subscriptions = []string{"web"}
+
+	st := &mockstore.MockStore{}
+	st.On("GetEnvironment", mock.Anything, "org", "env").Return(&types.Environment{}, nil)
+	st.On("GetEntityByID", mock.Anything, "testing").Return(entity, nil)
+
+	cfg := SessionConfig{
+		AgentID:       "testing",
+		Organization:  "org",
+		Environment:   "env",
+		Subscriptions: []string{"linux", "entity:testing"},
+	}
+	session, err := NewSession(cfg, conn, bus, st)
+	require.NoError(t, err)
+
+	// The subscriptions of the agent are kept until set through the API
+	require.NoError(t, session.restoreSubscriptions())
+	assert.Len(t, session.sendq, 0)
+
+	entity.ManagedSubscriptions = true
+	require.NoError(t, session.restoreSubscriptions())
+	msg := <-session.sendq
+	assert.Equal(t, types.SubscriptionsUpdateType, msg.Type)
+	update := &types.SubscriptionsUpdate{}
+	require.NoError(t, json.Unmarshal(msg.Payload, update))
+	assert.Equal(t, []string{"web"}, update.Subscriptions)
+	assert.Contains(t, session.subscriptions, "web")
+}
+
+func TestSessionHandleDeregistration(t *testing.T) {
+	conn := &testTransport{
+		sendCh: make(chan *transport.Message, 10),
+	}
+
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+		RingGetter: &mockring.Getter{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+
+	deregistrations := testSubscriber{ch: make(chan interface{}, 1)}
+	_, err = bus.Subscribe(messaging.TopicDeregistration, "testing", deregistrations)
+	require.NoError(t, err)
+
+	st := &mockstore.MockStore{}
+	st.On("GetEnvironment", mock.Anything, "default", "default").Return(&types.Environment{}, nil)
+
+	cfg := SessionConfig{
+		AgentID:      "testing",
+		Organization: "default",
+		Environment:  "default",
+	}
+	session, err := NewSession(cfg, conn, bus, st)
+	require.NoError(t, err)
+
+	// An agent can't deregister another entity
+	event := types.FixtureEvent("other", "check")
+	payload, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.Error(t, session.handleDeregistration(payload))
+
+	event.Entity.Environment = "prod"
+	event.Entity.ID = "testing"
+	payload, err = json.Marshal(event)
+	require.NoError(t, err)
+	assert.Error(t, session.handleDeregistration(payload))
+	assert.Len(t, deregistrations.ch, 0)
+
+	event.Entity.Environment = "default"
+	payload, err = json.Marshal(event)
+	require.NoError(t, err)
+	require.NoError(t, session.handleDeregistration(payload))
+	assert.Len(t, deregistrations.ch, 1)
+}
@@ -10,7 +10,10 @@ import (
 
 // assetUpdateFields whitelists fields allowed to be updated for Assets
 var assetUpdateFields = []string{
+	"Headers",
+	"Mirrors",
 	"Sha512",
+	"Signature",
 	"URL",
 }
 
@@ -28,7 +31,8 @@ func NewAssetController(store store.AssetStore) AssetController {
 	}
 }
 
-// Query returns resources available to the viewer filter by given params.
+// Query returns resources available to the viewer filter by given params. The
+// header values of the assets are redacted.
 func (a AssetController) Query(ctx context.Context) ([]*types.Asset, error) {
 	abilities := a.Policy.WithContext(ctx)
 
@@ -42,7 +46,7 @@ func (a AssetController) Query(ctx context.Context) ([]*types.Asset, error) {
 	resources := []*types.Asset{}
 	for _, result := range results {
 		if yes := abilities.CanRead(result); yes {
-			resources = append(resources, result)
+			resources = append(resources, result.RedactHeaders())
 		}
 	}
 
@@ -50,7 +54,7 @@ func (a AssetController) Query(ctx context.Context) ([]*types.Asset, error) {
 }
 
 // Find returns resource associated with given parameters if available to the
-// viewer.
+// viewer. The header values of the asset are redacted.
 func (a AssetController) Find(ctx context.Context, name string) (*types.Asset, error) {
 	// Validate params
 	if id := name; id == "" {
@@ -66,7 +70,7 @@ func (a AssetController) Find(ctx context.Context, name string) (*types.Asset, e
 	// Verify user has permission to view
 	abilities := a.Policy.WithContext(ctx)
 	if result != nil && abilities.CanRead(result) {
-		return result, nil
+		return result.RedactHeaders(), nil
 	}
 
 	return nil, NewErrorf(NotFound)
@@ -122,7 +126,8 @@ func (a AssetController) Update(ctx context.Context, given types.Asset) error {
 		return NewErrorf(PermissionDenied)
 	}
 
-	// Copy
+	// Copy, keeping the header values which were redacted
+	given.RestoreHeaders(asset)
 	copyFields(asset, &given, assetUpdateFields...)
 
 	// Validate
@@ -149,6 +154,14 @@ func (a AssetController) CreateOrReplace(ctx context.Context, asset types.Asset)
 		return NewErrorf(PermissionDenied)
 	}
 
+	// Keep the header values which were redacted
+	existing, err := a.Store.GetAssetByName(ctx, asset.Name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	} else if existing != nil {
+		asset.RestoreHeaders(existing)
+	}
+
 	// Validate
 	if err := asset.Validate(); err != nil {
 		return NewError(InvalidArgument, err)
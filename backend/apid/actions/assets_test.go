@@ -496,3 +496,41 @@ func TestAssetUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestAssetHeadersRedacted(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(
+				types.RuleTypeAsset,
+				types.RulePermRead,
+				types.RulePermCreate,
+				types.RulePermUpdate,
+			),
+		),
+	)
+
+	stored := types.FixtureAsset("asset1")
+	stored.Headers = map[string]string{"Authorization": "Bearer secret"}
+
+	store := &mockstore.MockStore{}
+	store.On("GetAssets", ctx).Return([]*types.Asset{stored}, nil)
+	store.On("GetAssetByName", mock.Anything, "asset1").Return(stored, nil)
+	actions := NewAssetController(store)
+
+	// The header values are redacted
+	results, err := actions.Query(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "REDACTED", results[0].Headers["Authorization"])
+
+	result, err := actions.Find(ctx, "asset1")
+	assert.NoError(t, err)
+	assert.Equal(t, "REDACTED", result.Headers["Authorization"])
+
+	// Replacing the asset with its redacted form keeps the header values
+	store.On("UpdateAsset", mock.Anything, mock.Anything).Return(nil)
+	assert.NoError(t, actions.CreateOrReplace(ctx, *result))
+	updated := store.Calls[len(store.Calls)-1].Arguments.Get(1).(*types.Asset)
+	assert.Equal(t, "Bearer secret", updated.Headers["Authorization"])
+}
@@ -0,0 +1,64 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/backup"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// BackupController exposes the backup and the restore of the store.
+type BackupController struct {
+	Store  store.Store
+	Policy authorization.BackupPolicy
+}
+
+// NewBackupController returns new BackupController
+func NewBackupController(store store.Store) BackupController {
+	return BackupController{
+		Store:  store,
+		Policy: authorization.Backups,
+	}
+}
+
+// Backup returns an archive of the resources selected by opts, if the viewer
+// has full access to all of them.
+func (a BackupController) Backup(ctx context.Context, opts backup.Options) (*backup.Archive, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, NewError(InvalidArgument, err)
+	}
+
+	// Verify viewer has full access to every resource
+	abilities := a.Policy.WithContext(ctx)
+	if !abilities.CanBackup(opts.Organization, opts.Environment) {
+		return nil, NewErrorf(PermissionDenied)
+	}
+
+	archive, err := backup.Export(ctx, a.Store, opts)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	return archive, nil
+}
+
+// Restore writes the resources of the archive selected by opts to the store,
+// if the viewer can create and update all of them.
+func (a BackupController) Restore(ctx context.Context, archive *backup.Archive, opts backup.Options) error {
+	if err := opts.Validate(); err != nil {
+		return NewError(InvalidArgument, err)
+	}
+
+	// Verify viewer can make change
+	abilities := a.Policy.WithContext(ctx)
+	if !abilities.CanRestore(opts.Organization, opts.Environment) {
+		return NewErrorf(PermissionDenied)
+	}
+
+	if err := backup.Restore(ctx, a.Store, archive, opts); err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package actions
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/backup"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupControllerBackup(t *testing.T) {
+	store := &mockstore.MockStore{}
+	store.On("GetOrganizations", mock.Anything).Return([]*types.Organization{}, nil)
+	actions := NewBackupController(store)
+
+	// Read access to a single type of resource isn't enough
+	ctx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeCheck, types.RulePermRead),
+	))
+	_, err := actions.Backup(ctx, backup.Options{})
+	assert.Equal(t, PermissionDenied, errCode(t, err))
+
+	// Nor is read access to every resource, since the backup holds secrets
+	ctx = testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeAll, types.RulePermRead),
+	))
+	_, err = actions.Backup(ctx, backup.Options{})
+	assert.Equal(t, PermissionDenied, errCode(t, err))
+
+	// Nor is the access to a single organization for a full backup
+	rule := types.FixtureRuleWithPerms(types.RuleTypeAll, types.RuleAllPerms...)
+	rule.Organization = "acme"
+	ctx = testutil.NewContext(testutil.ContextWithRules(rule))
+	_, err = actions.Backup(ctx, backup.Options{})
+	assert.Equal(t, PermissionDenied, errCode(t, err))
+
+	archive, err := actions.Backup(ctx, backup.Options{Organization: "acme"})
+	require.NoError(t, err)
+	assert.Equal(t, backup.Version, archive.Version)
+
+	_, err = actions.Backup(ctx, backup.Options{Environment: "dev"})
+	assert.Equal(t, InvalidArgument, errCode(t, err))
+}
+
+func TestBackupControllerRestore(t *testing.T) {
+	store := &mockstore.MockStore{}
+	actions := NewBackupController(store)
+	archive := &backup.Archive{Version: backup.Version}
+
+	ctx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeAll, types.RulePermRead),
+	))
+	err := actions.Restore(ctx, archive, backup.Options{})
+	assert.Equal(t, PermissionDenied, errCode(t, err))
+
+	ctx = testutil.NewContext(testutil.ContextWithFullAccess)
+	assert.NoError(t, actions.Restore(ctx, archive, backup.Options{}))
+
+	archive.Version = backup.Version + 1
+	err = actions.Restore(ctx, archive, backup.Options{})
+	assert.Equal(t, InternalErr, errCode(t, err))
+}
+
+func errCode(t *testing.T, err error) ErrCode {
+	inferErr, ok := err.(Error)
+	require.True(t, ok, "given was not of type 'Error'")
+	return inferErr.Code
+}
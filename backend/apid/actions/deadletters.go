@@ -0,0 +1,122 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/types"
+)
+
+// DeadLetterController exposes actions which a viewer can perform.
+type DeadLetterController struct {
+	DeadLetters types.Queue
+	Replays     types.Queue
+	Policy      authorization.DeadLetterPolicy
+}
+
+// NewDeadLetterController returns new DeadLetterController
+func NewDeadLetterController(getter types.QueueGetter) DeadLetterController {
+	return DeadLetterController{
+		DeadLetters: getter.GetQueue(types.DeadLetterQueueName),
+		Replays:     getter.GetQueue(types.DeadLetterReplayQueueName),
+		Policy:      authorization.DeadLetters,
+	}
+}
+
+// Query returns the dead letters of the current environment available to the
+// viewer, oldest first.
+func (a DeadLetterController) Query(ctx context.Context) ([]*types.DeadLetter, error) {
+	values, err := a.DeadLetters.List(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	results := []*types.DeadLetter{}
+	abilities := a.Policy.WithContext(ctx)
+	for _, value := range values {
+		letter, err := decodeDeadLetter(value)
+		if err != nil {
+			// Skip the values which are not dead letters
+			continue
+		}
+
+		// Filter out those resources the viewer does not have access to view.
+		if !inContextNamespace(ctx, letter) || !abilities.CanRead(letter) {
+			continue
+		}
+		results = append(results, letter)
+	}
+
+	return results, nil
+}
+
+// Replay moves the dead letters of the current environment available to the
+// viewer to the replay queue, so their handlers get executed again. If a name
+// is given, only the dead letter with that name is replayed. Returns the
+// replayed dead letters.
+func (a DeadLetterController) Replay(ctx context.Context, name string) ([]*types.DeadLetter, error) {
+	values, err := a.DeadLetters.List(ctx)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	results := []*types.DeadLetter{}
+	abilities := a.Policy.WithContext(ctx)
+	for _, value := range values {
+		letter, err := decodeDeadLetter(value)
+		if err != nil {
+			// Skip the values which are not dead letters
+			continue
+		}
+
+		// Leave the dead letters that should not be replayed in the queue
+		if (name != "" && letter.Name != name) ||
+			!inContextNamespace(ctx, letter) ||
+			!abilities.CanUpdate(letter) {
+			continue
+		}
+
+		removed, err := a.DeadLetters.Remove(ctx, value)
+		if err != nil {
+			return results, NewError(InternalErr, err)
+		}
+		if !removed {
+			// Another client replayed the dead letter in the meantime
+			continue
+		}
+
+		if err := a.Replays.Enqueue(ctx, value); err != nil {
+			// Return the dead letter to the queue rather than losing it
+			_ = a.DeadLetters.Enqueue(ctx, value)
+			return results, NewError(InternalErr, err)
+		}
+		results = append(results, letter)
+
+		if name != "" {
+			break
+		}
+	}
+
+	if name != "" && len(results) == 0 {
+		return nil, NewErrorf(NotFound)
+	}
+
+	return results, nil
+}
+
+func decodeDeadLetter(value string) (*types.DeadLetter, error) {
+	letter := &types.DeadLetter{}
+	err := json.Unmarshal([]byte(value), letter)
+	return letter, err
+}
+
+// inContextNamespace returns true if the dead letter belongs to the
+// organization and environment of the context, which support "*" as a
+// wildcard.
+func inContextNamespace(ctx context.Context, letter *types.DeadLetter) bool {
+	org, _ := ctx.Value(types.OrganizationKey).(string)
+	env, _ := ctx.Value(types.EnvironmentKey).(string)
+	return (org == "*" || org == letter.GetOrganization()) &&
+		(env == "*" || env == letter.GetEnvironment())
+}
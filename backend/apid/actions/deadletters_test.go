@@ -0,0 +1,183 @@
+package actions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/queue"
+	"github.com/sensu/sensu-go/testing/mockqueue"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newDeadLetterQueues(t *testing.T, letters ...*types.DeadLetter) *queue.MemoryGetter {
+	getter := queue.NewMemoryGetter()
+	q := getter.GetQueue(types.DeadLetterQueueName)
+	for _, letter := range letters {
+		value, err := json.Marshal(letter)
+		require.NoError(t, err)
+		require.NoError(t, q.Enqueue(context.Background(), string(value)))
+	}
+	return getter
+}
+
+func newDeadLetterContext(perms ...string) context.Context {
+	return testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithPerms(types.RuleTypeDeadLetter, perms...),
+	)
+}
+
+func TestNewDeadLetterController(t *testing.T) {
+	assert := assert.New(t)
+
+	deadLetters := &mockqueue.MockQueue{}
+	replays := &mockqueue.MockQueue{}
+	getter := &mockqueue.Getter{}
+	getter.On("GetQueue", types.DeadLetterQueueName).Return(deadLetters)
+	getter.On("GetQueue", types.DeadLetterReplayQueueName).Return(replays)
+	controller := NewDeadLetterController(getter)
+
+	assert.NotNil(controller)
+	assert.Equal(deadLetters, controller.DeadLetters)
+	assert.Equal(replays, controller.Replays)
+	assert.NotNil(controller.Policy)
+}
+
+func TestDeadLetterQuery(t *testing.T) {
+	defaultCtx := newDeadLetterContext(types.RulePermRead)
+
+	otherEnv := types.FixtureDeadLetter("letter3", "handler1")
+	otherEnv.Event.Entity.Environment = "dev"
+
+	testCases := []struct {
+		name          string
+		ctx           context.Context
+		expectedNames []string
+	}{
+		{
+			name:          "With Read Access",
+			ctx:           defaultCtx,
+			expectedNames: []string{"letter1", "letter2"},
+		},
+		{
+			name:          "With Only Create Access",
+			ctx:           newDeadLetterContext(types.RulePermCreate),
+			expectedNames: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := newDeadLetterQueues(t,
+				types.FixtureDeadLetter("letter1", "handler1"),
+				types.FixtureDeadLetter("letter2", "handler1"),
+				otherEnv,
+			)
+			controller := NewDeadLetterController(getter)
+
+			results, err := controller.Query(tc.ctx)
+			require.NoError(t, err)
+
+			names := []string{}
+			for _, letter := range results {
+				names = append(names, letter.Name)
+			}
+			assert.Equal(t, tc.expectedNames, names)
+		})
+	}
+}
+
+func TestDeadLetterQueryError(t *testing.T) {
+	deadLetters := &mockqueue.MockQueue{}
+	deadLetters.On("List", mock.Anything).Return([]string{}, errors.New("error"))
+	controller := DeadLetterController{DeadLetters: deadLetters}
+
+	_, err := controller.Query(testutil.NewContext())
+	require.Error(t, err)
+	assert.Equal(t, InternalErr, err.(Error).Code)
+}
+
+func TestDeadLetterReplay(t *testing.T) {
+	defaultCtx := newDeadLetterContext(types.RulePermUpdate)
+
+	testCases := []struct {
+		name             string
+		ctx              context.Context
+		letterName       string
+		expectedReplayed []string
+		expectedLeft     []string
+		expectedErr      bool
+	}{
+		{
+			name:             "All",
+			ctx:              defaultCtx,
+			expectedReplayed: []string{"letter1", "letter2", "letter3"},
+			expectedLeft:     []string{},
+		},
+		{
+			name:             "By Name",
+			ctx:              defaultCtx,
+			letterName:       "letter2",
+			expectedReplayed: []string{"letter2"},
+			expectedLeft:     []string{"letter1", "letter3"},
+		},
+		{
+			name:         "Unknown Name",
+			ctx:          defaultCtx,
+			letterName:   "letter4",
+			expectedLeft: []string{"letter1", "letter2", "letter3"},
+			expectedErr:  true,
+		},
+		{
+			name:             "With Only Read Access",
+			ctx:              newDeadLetterContext(types.RulePermRead),
+			expectedReplayed: []string{},
+			expectedLeft:     []string{"letter1", "letter2", "letter3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			getter := newDeadLetterQueues(t,
+				types.FixtureDeadLetter("letter1", "handler1"),
+				types.FixtureDeadLetter("letter2", "handler1"),
+				types.FixtureDeadLetter("letter3", "handler1"),
+			)
+			controller := NewDeadLetterController(getter)
+
+			results, err := controller.Replay(tc.ctx, tc.letterName)
+			if tc.expectedErr {
+				require.Error(t, err)
+				assert.Equal(t, NotFound, err.(Error).Code)
+			} else {
+				require.NoError(t, err)
+				names := []string{}
+				for _, letter := range results {
+					names = append(names, letter.Name)
+				}
+				assert.Equal(t, tc.expectedReplayed, names)
+			}
+
+			// The dead letters left in the queue keep their order
+			values, err := controller.DeadLetters.List(context.Background())
+			require.NoError(t, err)
+			left := []string{}
+			for _, value := range values {
+				letter, err := decodeDeadLetter(value)
+				require.NoError(t, err)
+				left = append(left, letter.Name)
+			}
+			assert.Equal(t, tc.expectedLeft, left)
+
+			replays, err := controller.Replays.List(context.Background())
+			require.NoError(t, err)
+			assert.Len(t, replays, len(tc.expectedReplayed))
+		})
+	}
+}
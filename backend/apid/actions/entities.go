@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/types"
 )
@@ -17,13 +18,15 @@ var entityUpdateFields = []string{
 type EntityController struct {
 	Store  store.EntityStore
 	Policy authorization.EntityPolicy
+	Bus    messaging.MessageBus
 }
 
 // NewEntityController returns new EntityController
-func NewEntityController(store store.EntityStore) EntityController {
+func NewEntityController(store store.EntityStore, bus messaging.MessageBus) EntityController {
 	return EntityController{
 		Store:  store,
 		Policy: authorization.Entities,
+		Bus:    bus,
 	}
 }
 
@@ -110,8 +113,16 @@ func (c EntityController) Update(ctx context.Context, given types.Entity) error
 	}
 
 	// Copy
+	subscriptions := entity.Subscriptions
 	copyFields(entity, &given, entityUpdateFields...)
 
+	// The backend owns the subscriptions of the entity from now on, instead of
+	// taking them from the keepalives of its agent
+	changed := !equalSubscriptions(subscriptions, entity.Subscriptions)
+	if changed {
+		entity.ManagedSubscriptions = true
+	}
+
 	// Validate
 	if err := entity.Validate(); err != nil {
 		return NewError(InvalidArgument, err)
@@ -122,5 +133,41 @@ func (c EntityController) Update(ctx context.Context, given types.Entity) error
 		return NewError(InternalErr, serr)
 	}
 
+	// Push the new subscriptions to the agent, if connected
+	if entity.Class == types.EntityAgentClass && changed {
+		if err := c.pushSubscriptions(entity); err != nil {
+			return NewError(InternalErr, err)
+		}
+	}
+
 	return nil
 }
+
+// pushSubscriptions publishes the subscriptions of the agent entity on its
+// entity subscription topic, to which the session of the agent is subscribed.
+func (c EntityController) pushSubscriptions(entity *types.Entity) error {
+	if c.Bus == nil {
+		return nil
+	}
+
+	topic := messaging.SubscriptionTopic(
+		entity.Organization,
+		entity.Environment,
+		types.GetEntitySubscription(entity.ID),
+	)
+	update := &types.SubscriptionsUpdate{Subscriptions: entity.Subscriptions}
+
+	return c.Bus.Publish(topic, update)
+}
+
+func equalSubscriptions(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
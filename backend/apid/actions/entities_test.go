@@ -5,6 +5,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/testing/mockbus"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/testing/testutil"
 	"github.com/sensu/sensu-go/types"
@@ -16,10 +18,12 @@ func TestNewEntityController(t *testing.T) {
 	assert := assert.New(t)
 
 	store := &mockstore.MockStore{}
-	actions := NewEntityController(store)
+	bus := &mockbus.MockBus{}
+	actions := NewEntityController(store, bus)
 
 	assert.NotNil(actions)
 	assert.Equal(store, actions.Store)
+	assert.Equal(bus, actions.Bus)
 	assert.NotNil(actions.Policy)
 }
 
@@ -92,7 +96,7 @@ func TestEntityDestroy(t *testing.T) {
 
 	for _, tc := range testCases {
 		store := &mockstore.MockStore{}
-		actions := NewEntityController(store)
+		actions := NewEntityController(store, nil)
 
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
@@ -176,7 +180,7 @@ func TestEntityFind(t *testing.T) {
 
 	for _, tc := range testCases {
 		store := &mockstore.MockStore{}
-		actions := NewEntityController(store)
+		actions := NewEntityController(store, nil)
 
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
@@ -259,7 +263,7 @@ func TestEntityQuery(t *testing.T) {
 
 	for _, tc := range testCases {
 		store := &mockstore.MockStore{}
-		actions := NewEntityController(store)
+		actions := NewEntityController(store, nil)
 
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
@@ -357,7 +361,7 @@ func TestEntityUpdate(t *testing.T) {
 
 	for _, tc := range testCases {
 		store := &mockstore.MockStore{}
-		actions := NewEntityController(store)
+		actions := NewEntityController(store, nil)
 
 		t.Run(tc.name, func(t *testing.T) {
 			assert := assert.New(t)
@@ -387,3 +391,89 @@ func TestEntityUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestEntityUpdateSubscriptions(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(types.RuleTypeEntity, types.RulePermUpdate),
+		),
+	)
+	topic := messaging.SubscriptionTopic("default", "default", "entity:foo")
+
+	testCases := []struct {
+		name          string
+		class         string
+		subscriptions []string
+		busErr        error
+		expectPush    bool
+		expectedErr   bool
+	}{
+		{
+			name:          "Changed subscriptions are pushed",
+			class:         types.EntityAgentClass,
+			subscriptions: []string{"linux", "web"},
+			expectPush:    true,
+		},
+		{
+			name:          "Unchanged subscriptions are not pushed",
+			class:         types.EntityAgentClass,
+			subscriptions: []string{"linux"},
+		},
+		{
+			name:          "Proxy entities are not pushed",
+			class:         types.EntityProxyClass,
+			subscriptions: []string{"linux", "web"},
+		},
+		{
+			name:          "Message bus error",
+			class:         types.EntityAgentClass,
+			subscriptions: []string{"web"},
+			busErr:        errors.New("where's the wizard"),
+			expectPush:    true,
+			expectedErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			entity := types.FixtureEntity("foo")
+			entity.Class = tc.class
+			entity.Subscriptions = []string{"linux"}
+
+			given := types.FixtureEntity("foo")
+			given.Subscriptions = tc.subscriptions
+
+			store := &mockstore.MockStore{}
+			store.On("GetEntityByID", mock.Anything, "foo").Return(entity, nil)
+			var updated *types.Entity
+			store.On("UpdateEntity", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+				updated = args.Get(1).(*types.Entity)
+			})
+
+			bus := &mockbus.MockBus{}
+			update := &types.SubscriptionsUpdate{Subscriptions: tc.subscriptions}
+			bus.On("Publish", topic, update).Return(tc.busErr)
+
+			actions := NewEntityController(store, bus)
+			err := actions.Update(ctx, *given)
+			if tc.expectedErr {
+				assert.Error(err)
+			} else {
+				assert.NoError(err)
+			}
+
+			if tc.expectPush {
+				bus.AssertCalled(t, "Publish", topic, update)
+			} else {
+				bus.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+			}
+
+			// The backend owns the subscriptions once they were changed
+			changed := !equalSubscriptions(tc.subscriptions, []string{"linux"})
+			assert.Equal(changed, updated.ManagedSubscriptions)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package actions
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/backend/authorization"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// ErrorController expose actions in which a viewer can perform.
+type ErrorController struct {
+	Store  store.ErrorStore
+	Policy authorization.ErrorPolicy
+}
+
+// NewErrorController returns new ErrorController
+func NewErrorController(store store.ErrorStore) ErrorController {
+	return ErrorController{
+		Store:  store,
+		Policy: authorization.Errors,
+	}
+}
+
+// Query returns resources available to the viewer filter by given params.
+func (a ErrorController) Query(ctx context.Context, entity, check string) ([]*types.Error, error) {
+	var results []*types.Error
+
+	// Fetch from store
+	var serr error
+	if entity != "" && check != "" {
+		results, serr = a.Store.GetErrorsByEntityCheck(ctx, entity, check)
+	} else if entity != "" {
+		results, serr = a.Store.GetErrorsByEntity(ctx, entity)
+	} else {
+		results, serr = a.Store.GetErrors(ctx)
+	}
+
+	if serr != nil {
+		return nil, NewError(InternalErr, serr)
+	}
+
+	// Filter out those resources the viewer does not have access to view.
+	abilities := a.Policy.WithContext(ctx)
+	for i := 0; i < len(results); i++ {
+		if !abilities.CanRead(results[i]) {
+			results = append(results[:i], results[i+1:]...)
+			i--
+		}
+	}
+
+	return results, nil
+}
+
+// Find returns resource associated with given parameters if available to the
+// viewer.
+func (a ErrorController) Find(ctx context.Context, entity, check, name string) (*types.Error, error) {
+	if entity == "" || check == "" || name == "" {
+		return nil, NewErrorf(InvalidArgument, "Find() requires an entity, a check and a name")
+	}
+
+	result, err := a.Store.GetError(ctx, entity, check, name)
+	if err != nil {
+		return nil, NewError(InternalErr, err)
+	}
+
+	// Verify user has permission to view
+	abilities := a.Policy.WithContext(ctx)
+	if result != nil && abilities.CanRead(result) {
+		return result, nil
+	}
+
+	return nil, NewErrorf(NotFound)
+}
+
+// Destroy destroys the error indicated by the supplied entity, check and name.
+func (a ErrorController) Destroy(ctx context.Context, entity, check, name string) error {
+	if entity == "" || check == "" || name == "" {
+		return NewErrorf(InvalidArgument, "Destroy() requires an entity, a check and a name")
+	}
+
+	result, err := a.Store.GetError(ctx, entity, check, name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	}
+
+	// Verify user has permission to delete
+	abilities := a.Policy.WithContext(ctx)
+	if result != nil && abilities.CanDelete(result) {
+		if err := a.Store.DeleteError(ctx, entity, check, name); err != nil {
+			return NewError(InternalErr, err)
+		}
+		return nil
+	}
+
+	return NewErrorf(NotFound)
+}
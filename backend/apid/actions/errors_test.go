@@ -0,0 +1,278 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNewErrorController(t *testing.T) {
+	assert := assert.New(t)
+
+	store := &mockstore.MockStore{}
+	errorController := NewErrorController(store)
+
+	assert.NotNil(errorController)
+	assert.Equal(store, errorController.Store)
+	assert.NotNil(errorController.Policy)
+}
+
+func TestErrorQuery(t *testing.T) {
+	defaultCtx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeError, types.RulePermRead),
+	))
+
+	testCases := []struct {
+		name        string
+		ctx         context.Context
+		errors      []*types.Error
+		entity      string
+		check       string
+		expectedLen int
+		storeErr    error
+		expectedErr error
+	}{
+		{
+			name: "No Params No Errors",
+			ctx:  defaultCtx,
+			errors: []*types.Error{
+				types.FixtureError("error1", "handler failed"),
+				types.FixtureError("error2", "handler failed"),
+			},
+			expectedLen: 2,
+		},
+		{
+			name: "No Params With Only Create Access",
+			ctx: testutil.NewContext(testutil.ContextWithRules(
+				types.FixtureRuleWithPerms(types.RuleTypeError, types.RulePermCreate),
+			)),
+			errors: []*types.Error{
+				types.FixtureError("error1", "handler failed"),
+			},
+			expectedLen: 0,
+		},
+		{
+			name: "Entity Param",
+			ctx:  defaultCtx,
+			errors: []*types.Error{
+				types.FixtureError("error1", "handler failed"),
+			},
+			entity:      "agent",
+			expectedLen: 1,
+		},
+		{
+			name: "Entity And Check Params",
+			ctx:  defaultCtx,
+			errors: []*types.Error{
+				types.FixtureError("error1", "handler failed"),
+			},
+			entity:      "agent",
+			check:       "check",
+			expectedLen: 1,
+		},
+		{
+			name:        "Store Failure",
+			ctx:         defaultCtx,
+			errors:      nil,
+			expectedLen: 0,
+			storeErr:    errors.New(""),
+			expectedErr: NewError(InternalErr, errors.New("")),
+		},
+	}
+
+	for _, tc := range testCases {
+		store := &mockstore.MockStore{}
+		errorController := NewErrorController(store)
+
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			// Mock store methods
+			store.On("GetErrors", tc.ctx).Return(tc.errors, tc.storeErr)
+			store.On("GetErrorsByEntity", tc.ctx, mock.Anything).Return(tc.errors, tc.storeErr)
+			store.
+				On("GetErrorsByEntityCheck", tc.ctx, mock.Anything, mock.Anything).
+				Return(tc.errors, tc.storeErr)
+
+			// Exec Query
+			results, err := errorController.Query(tc.ctx, tc.entity, tc.check)
+
+			// Assert
+			assert.EqualValues(tc.expectedErr, err)
+			assert.Len(results, tc.expectedLen)
+		})
+	}
+}
+
+func TestErrorFind(t *testing.T) {
+	defaultCtx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeError, types.RulePermRead),
+	))
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		record          *types.Error
+		entity          string
+		check           string
+		errName         string
+		expected        bool
+		expectedErrCode ErrCode
+	}{
+		{
+			name:            "No Params",
+			ctx:             defaultCtx,
+			expected:        false,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Missing Name Param",
+			ctx:             defaultCtx,
+			entity:          "agent",
+			check:           "check",
+			expected:        false,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Found",
+			ctx:             defaultCtx,
+			record:          types.FixtureError("error1", "handler failed"),
+			entity:          "agent",
+			check:           "check",
+			errName:         "error1",
+			expected:        true,
+			expectedErrCode: 0,
+		},
+		{
+			name:            "Not Found",
+			ctx:             defaultCtx,
+			record:          nil,
+			entity:          "agent",
+			check:           "check",
+			errName:         "error1",
+			expected:        false,
+			expectedErrCode: NotFound,
+		},
+		{
+			name: "No Read Permission",
+			ctx: testutil.NewContext(testutil.ContextWithRules(
+				types.FixtureRuleWithPerms(types.RuleTypeError, types.RulePermCreate),
+			)),
+			record:          types.FixtureError("error1", "handler failed"),
+			entity:          "agent",
+			check:           "check",
+			errName:         "error1",
+			expected:        false,
+			expectedErrCode: NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		store := &mockstore.MockStore{}
+		errorController := NewErrorController(store)
+
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			// Mock store methods
+			store.
+				On("GetError", tc.ctx, mock.Anything, mock.Anything, mock.Anything).
+				Return(tc.record, nil)
+
+			// Exec Query
+			result, err := errorController.Find(tc.ctx, tc.entity, tc.check, tc.errName)
+
+			inferErr, ok := err.(Error)
+			if ok {
+				assert.Equal(tc.expectedErrCode, inferErr.Code)
+			} else {
+				assert.NoError(err)
+			}
+			assert.Equal(tc.expected, result != nil, "expects Find() to return an error")
+		})
+	}
+}
+
+func TestErrorDestroy(t *testing.T) {
+	defaultCtx := testutil.NewContext(testutil.ContextWithRules(
+		types.FixtureRuleWithPerms(types.RuleTypeError, types.RulePermDelete),
+	))
+
+	testCases := []struct {
+		name            string
+		ctx             context.Context
+		record          *types.Error
+		entity          string
+		check           string
+		errName         string
+		expectedErrCode ErrCode
+	}{
+		{
+			name:            "No Params",
+			ctx:             defaultCtx,
+			expectedErrCode: InvalidArgument,
+		},
+		{
+			name:            "Delete",
+			ctx:             defaultCtx,
+			record:          types.FixtureError("error1", "handler failed"),
+			entity:          "agent",
+			check:           "check",
+			errName:         "error1",
+			expectedErrCode: 0,
+		},
+		{
+			name:            "Not Found",
+			ctx:             defaultCtx,
+			record:          nil,
+			entity:          "agent",
+			check:           "check",
+			errName:         "error1",
+			expectedErrCode: NotFound,
+		},
+		{
+			name: "No Delete Permission",
+			ctx: testutil.NewContext(testutil.ContextWithRules(
+				types.FixtureRuleWithPerms(types.RuleTypeError, types.RulePermCreate),
+			)),
+			record:          types.FixtureError("error1", "handler failed"),
+			entity:          "agent",
+			check:           "check",
+			errName:         "error1",
+			expectedErrCode: NotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		store := &mockstore.MockStore{}
+		errorController := NewErrorController(store)
+
+		t.Run(tc.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			// Mock store methods
+			store.
+				On("GetError", tc.ctx, mock.Anything, mock.Anything, mock.Anything).
+				Return(tc.record, nil)
+			store.
+				On("DeleteError", tc.ctx, mock.Anything, mock.Anything, mock.Anything).
+				Return(nil)
+
+			// Exec Query
+			err := errorController.Destroy(tc.ctx, tc.entity, tc.check, tc.errName)
+
+			inferErr, ok := err.(Error)
+			if ok {
+				assert.Equal(tc.expectedErrCode, inferErr.Code)
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
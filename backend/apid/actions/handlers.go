@@ -16,6 +16,8 @@ var updateFields = []string{
 	"Command",
 	"Handlers",
 	"Socket",
+	"HTTP",
+	"RuntimeAssets",
 }
 
 // HandlerController exposes actions available for handlers
@@ -80,6 +82,14 @@ func (c HandlerController) CreateOrReplace(ctx context.Context, handler types.Ha
 		return NewErrorf(PermissionDenied, "create/update")
 	}
 
+	// Keep the header values which were redacted
+	existing, err := c.Store.GetHandlerByName(ctx, handler.Name)
+	if err != nil {
+		return NewError(InternalErr, err)
+	} else if existing != nil {
+		handler.RestoreHeaders(existing)
+	}
+
 	// Validate
 	if err := handler.Validate(); err != nil {
 		return NewError(InvalidArgument, err)
@@ -119,7 +129,7 @@ func (c HandlerController) Destroy(ctx context.Context, name string) error {
 }
 
 // Find returns resource associated with given parameters if available to the
-// viewer.
+// viewer. The HTTP header values of the handler are redacted.
 func (c HandlerController) Find(ctx context.Context, name string) (*types.Handler, error) {
 	// Fetch from store
 	result, err := c.Store.GetHandlerByName(ctx, name)
@@ -130,13 +140,14 @@ func (c HandlerController) Find(ctx context.Context, name string) (*types.Handle
 	// Verify user has permission to view
 	abilities := c.Policy.WithContext(ctx)
 	if result != nil && abilities.CanRead(result) {
-		return result, nil
+		return result.RedactHeaders(), nil
 	}
 
 	return nil, NewErrorf(NotFound)
 }
 
-// Query returns resources available to the viewer
+// Query returns resources available to the viewer. The HTTP header values of
+// the handlers are redacted.
 func (c HandlerController) Query(ctx context.Context) ([]*types.Handler, error) {
 	// Fetch from store
 	results, serr := c.Store.GetHandlers(ctx)
@@ -150,7 +161,9 @@ func (c HandlerController) Query(ctx context.Context) ([]*types.Handler, error)
 		if !abilities.CanRead(results[i]) {
 			results = append(results[:i], results[i+1:]...)
 			i--
+			continue
 		}
+		results[i] = results[i].RedactHeaders()
 	}
 
 	return results, nil
@@ -175,7 +188,8 @@ func (c HandlerController) Update(ctx context.Context, newHandler types.Handler)
 		return NewErrorf(PermissionDenied)
 	}
 
-	// Copy
+	// Copy, keeping the header values which were redacted
+	newHandler.RestoreHeaders(handler)
 	copyFields(handler, &newHandler, updateFields...)
 
 	// Validate
@@ -563,3 +563,49 @@ func TestHandlerUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlerHeadersRedacted(t *testing.T) {
+	ctx := testutil.NewContext(
+		testutil.ContextWithOrgEnv("default", "default"),
+		testutil.ContextWithRules(
+			types.FixtureRuleWithPerms(
+				types.RuleTypeHandler,
+				types.RulePermRead,
+				types.RulePermCreate,
+				types.RulePermUpdate,
+			),
+		),
+	)
+
+	stored := types.FixtureHTTPHandler("handler1", "https://example.com")
+	stored.HTTP.Headers = map[string]string{"Authorization": "Bearer secret"}
+
+	store := &mockstore.MockStore{}
+	store.On("GetHandlers", ctx).Return([]*types.Handler{stored}, nil)
+	store.On("GetHandlerByName", mock.Anything, "handler1").Return(stored, nil)
+	actions := NewHandlerController(store)
+
+	// The header values are redacted
+	results, err := actions.Query(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "REDACTED", results[0].HTTP.Headers["Authorization"])
+	assert.Equal(t, "Bearer secret", stored.HTTP.Headers["Authorization"])
+
+	result, err := actions.Find(ctx, "handler1")
+	assert.NoError(t, err)
+	assert.Equal(t, "REDACTED", result.HTTP.Headers["Authorization"])
+
+	// Replacing or updating the handler with its redacted form keeps the
+	// header values
+	store.On("UpdateHandler", mock.Anything).Return(nil)
+	assert.NoError(t, actions.CreateOrReplace(ctx, *result))
+	updated := store.Calls[len(store.Calls)-1].Arguments.Get(0).(*types.Handler)
+	assert.Equal(t, "Bearer secret", updated.HTTP.Headers["Authorization"])
+
+	result, err = actions.Find(ctx, "handler1")
+	assert.NoError(t, err)
+	assert.NoError(t, actions.Update(ctx, *result))
+	updated = store.Calls[len(store.Calls)-1].Arguments.Get(0).(*types.Handler)
+	assert.Equal(t, "Bearer secret", updated.HTTP.Headers["Authorization"])
+}
@@ -12,6 +12,7 @@ var mutatorUpdateFields = []string{
 	"Command",
 	"Timeout",
 	"EnvVars",
+	"RuntimeAssets",
 }
 
 // MutatorController allows querying mutators in bulk or by name.
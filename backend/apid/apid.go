@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sensu/sensu-go/backend/apid/actions"
 	"github.com/sensu/sensu-go/backend/apid/middlewares"
 	"github.com/sensu/sensu-go/backend/apid/routers"
@@ -33,6 +34,7 @@ type APId struct {
 	httpServer    *http.Server
 	bus           messaging.MessageBus
 	backendStatus func() types.StatusMap
+	gatherer      prometheus.Gatherer
 	store         store.Store
 	queueGetter   types.QueueGetter
 	tls           *types.TLSOptions
@@ -50,6 +52,10 @@ type Config struct {
 	QueueGetter   types.QueueGetter
 	TLS           *types.TLSOptions
 	BackendStatus func() types.StatusMap
+
+	// Gatherer provides the metrics exposed on /metrics, the default
+	// Prometheus registry if nil
+	Gatherer prometheus.Gatherer
 }
 
 // New creates a new APId.
@@ -61,6 +67,7 @@ func New(c Config, opts ...Option) (*APId, error) {
 		queueGetter:   c.QueueGetter,
 		tls:           c.TLS,
 		backendStatus: c.BackendStatus,
+		gatherer:      c.Gatherer,
 		bus:           c.Bus,
 		stopping:      make(chan struct{}, 1),
 		running:       &atomic.Value{},
@@ -68,11 +75,17 @@ func New(c Config, opts ...Option) (*APId, error) {
 		errChan:       make(chan error, 1),
 	}
 
+	if a.gatherer == nil {
+		a.gatherer = prometheus.DefaultGatherer
+	}
+
 	router := mux.NewRouter().UseEncodedPath()
 	router.NotFoundHandler = http.HandlerFunc(notFoundHandler)
 	registerUnauthenticatedResources(router, a.backendStatus)
 	registerAuthenticationResources(router, a.store)
+	registerMetricsResources(router, a.store, a.gatherer)
 	registerRestrictedResources(router, a.store, a.queueGetter, a.bus)
+	registerBackupResources(router, a.store)
 
 	a.httpServer = &http.Server{
 		Addr:         fmt.Sprintf("%s:%d", a.Host, a.Port),
@@ -161,6 +174,22 @@ func registerUnauthenticatedResources(
 	)
 }
 
+// registerMetricsResources mounts the metrics route, which requires an
+// authenticated user since the labels of the metrics name the organizations,
+// environments and checks of every tenant.
+func registerMetricsResources(router *mux.Router, store store.Store, gatherer prometheus.Gatherer) {
+	mountRouters(
+		NewSubrouter(
+			router.NewRoute(),
+			middlewares.SimpleLogger{},
+			middlewares.Authentication{},
+			middlewares.AllowList{Store: store},
+			middlewares.LimitRequest{},
+		),
+		routers.NewMetricsRouter(gatherer),
+	)
+}
+
 func registerAuthenticationResources(router *mux.Router, store store.Store) {
 	mountRouters(
 		NewSubrouter(
@@ -186,8 +215,10 @@ func registerRestrictedResources(router *mux.Router, store store.Store, getter t
 		),
 		routers.NewAssetRouter(store),
 		routers.NewChecksRouter(store, getter),
-		routers.NewEntitiesRouter(store),
+		routers.NewDeadLettersRouter(getter),
+		routers.NewEntitiesRouter(store, bus),
 		routers.NewEnvironmentsRouter(store),
+		routers.NewErrorsRouter(store),
 		routers.NewEventFiltersRouter(store),
 		routers.NewEventsRouter(store, bus),
 		routers.NewGraphQLRouter(store, bus, getter),
@@ -201,6 +232,23 @@ func registerRestrictedResources(router *mux.Router, store store.Store, getter t
 	)
 }
 
+// registerBackupResources mounts the backup and restore routes, which are
+// restricted like the others but not limited in size, since archives can be
+// much larger than any single resource.
+func registerBackupResources(router *mux.Router, store store.Store) {
+	mountRouters(
+		NewSubrouter(
+			router.NewRoute(),
+			middlewares.SimpleLogger{},
+			middlewares.Environment{Store: store},
+			middlewares.Authentication{},
+			middlewares.AllowList{Store: store},
+			middlewares.Authorization{Store: store},
+		),
+		routers.NewBackupRouter(store),
+	)
+}
+
 func mountRouters(parent *mux.Router, subRouters ...routers.Router) {
 	for _, subRouter := range subRouters {
 		subRouter.Mount(parent)
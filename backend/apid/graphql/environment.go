@@ -25,14 +25,16 @@ type envImpl struct {
 	checksCtrl actions.CheckController
 	entityCtrl actions.EntityController
 	eventsCtrl actions.EventController
+	errorsCtrl actions.ErrorController
 }
 
 func newEnvImpl(store store.Store, getter types.QueueGetter) *envImpl {
 	return &envImpl{
 		orgCtrl:    actions.NewOrganizationsController(store),
 		checksCtrl: actions.NewCheckController(store, getter),
-		entityCtrl: actions.NewEntityController(store),
+		entityCtrl: actions.NewEntityController(store, nil),
 		eventsCtrl: actions.NewEventController(store, nil),
+		errorsCtrl: actions.NewErrorController(store),
 	}
 }
 
@@ -178,3 +180,24 @@ func (r *envImpl) Events(p schema.EnvironmentEventsFieldResolverParams) (interfa
 	}
 	return relay.NewArrayConnection(edges, info), nil
 }
+
+// Errors implements response to request for 'errors' field.
+func (r *envImpl) Errors(p schema.EnvironmentErrorsFieldResolverParams) (interface{}, error) {
+	env := p.Source.(*types.Environment)
+	ctx := types.SetContextFromResource(p.Context, env)
+	records, err := r.errorsCtrl.Query(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	// pagination
+	info := relay.NewArrayConnectionInfo(
+		0, len(records),
+		p.Args.First, p.Args.Last, p.Args.Before, p.Args.After,
+	)
+	edges := make([]*relay.Edge, info.End-info.Begin)
+	for i, r := range records[info.Begin:info.End] {
+		edges[i] = relay.NewArrayConnectionEdge(r, i)
+	}
+	return relay.NewArrayConnection(edges, info), nil
+}
@@ -0,0 +1,42 @@
+package graphql
+
+import (
+	"time"
+
+	"github.com/sensu/sensu-go/backend/apid/graphql/schema"
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/types"
+)
+
+var _ schema.ErrorFieldResolvers = (*errorImpl)(nil)
+
+//
+// Implement ErrorFieldResolvers
+//
+
+type errorImpl struct {
+	schema.ErrorAliases
+}
+
+// Namespace implements response to request for 'namespace' field.
+func (r *errorImpl) Namespace(p graphql.ResolveParams) (interface{}, error) {
+	return p.Source, nil
+}
+
+// Timestamp implements response to request for 'timestamp' field.
+func (r *errorImpl) Timestamp(p graphql.ResolveParams) (time.Time, error) {
+	perr := p.Source.(*types.Error)
+	return time.Unix(perr.Timestamp, 0), nil
+}
+
+// Event implements response to request for 'event' field.
+func (r *errorImpl) Event(p graphql.ResolveParams) (interface{}, error) {
+	perr := p.Source.(*types.Error)
+	return &perr.Event, nil
+}
+
+// IsTypeOf is used to determine if a given value is associated with the type
+func (r *errorImpl) IsTypeOf(s interface{}, p graphql.IsTypeOfParams) bool {
+	_, ok := s.(*types.Error)
+	return ok
+}
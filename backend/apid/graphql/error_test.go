@@ -0,0 +1,25 @@
+package graphql
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/graphql"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorTypeFields(t *testing.T) {
+	impl := &errorImpl{}
+	perr := types.FixtureError("error1", "handler failed")
+	params := graphql.ResolveParams{Source: perr}
+
+	timestamp, err := impl.Timestamp(params)
+	assert.NoError(t, err)
+	assert.Equal(t, perr.Timestamp, timestamp.Unix())
+
+	event, err := impl.Event(params)
+	assert.NoError(t, err)
+	assert.Equal(t, &perr.Event, event)
+
+	assert.True(t, impl.IsTypeOf(perr, graphql.IsTypeOfParams{}))
+}
@@ -124,7 +124,7 @@ type entityNodeResolver struct {
 }
 
 func registerEntityNodeResolver(register relay.NodeRegister, store store.EntityStore) {
-	controller := actions.NewEntityController(store)
+	controller := actions.NewEntityController(store, nil)
 	resolver := &entityNodeResolver{controller}
 	register.RegisterResolver(relay.NodeResolver{
 		ObjectType: schema.EntityType,
@@ -101,6 +101,26 @@ type EnvironmentEventsFieldResolver interface {
 	Events(p EnvironmentEventsFieldResolverParams) (interface{}, error)
 }
 
+// EnvironmentErrorsFieldResolverArgs contains arguments provided to errors when selected
+type EnvironmentErrorsFieldResolverArgs struct {
+	First  int    // First - self descriptive
+	Last   int    // Last - self descriptive
+	Before string // Before - self descriptive
+	After  string // After - self descriptive
+}
+
+// EnvironmentErrorsFieldResolverParams contains contextual info to resolve errors field
+type EnvironmentErrorsFieldResolverParams struct {
+	graphql.ResolveParams
+	Args EnvironmentErrorsFieldResolverArgs
+}
+
+// EnvironmentErrorsFieldResolver implement to resolve requests for the Environment's errors field.
+type EnvironmentErrorsFieldResolver interface {
+	// Errors implements response to request for errors field.
+	Errors(p EnvironmentErrorsFieldResolverParams) (interface{}, error)
+}
+
 //
 // EnvironmentFieldResolvers represents a collection of methods whose products represent the
 // response values of the 'Environment' type.
@@ -171,6 +191,7 @@ type EnvironmentFieldResolvers interface {
 	EnvironmentEntitiesFieldResolver
 	EnvironmentChecksFieldResolver
 	EnvironmentEventsFieldResolver
+	EnvironmentErrorsFieldResolver
 }
 
 // EnvironmentAliases implements all methods on EnvironmentFieldResolvers interface by using reflection to
@@ -271,6 +292,12 @@ func (_ EnvironmentAliases) Events(p EnvironmentEventsFieldResolverParams) (inte
 	return val, err
 }
 
+// Errors implements response to request for 'errors' field.
+func (_ EnvironmentAliases) Errors(p EnvironmentErrorsFieldResolverParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
 // EnvironmentType Environment represents a Sensu environment in RBAC
 var EnvironmentType = graphql.NewType("Environment", graphql.ObjectKind)
 
@@ -354,6 +381,19 @@ func _ObjTypeEnvironmentEventsHandler(impl interface{}) graphql1.FieldResolveFn
 	}
 }
 
+func _ObjTypeEnvironmentErrorsHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(EnvironmentErrorsFieldResolver)
+	return func(p graphql1.ResolveParams) (interface{}, error) {
+		frp := EnvironmentErrorsFieldResolverParams{ResolveParams: p}
+		err := mapstructure.Decode(p.Args, &frp.Args)
+		if err != nil {
+			return nil, err
+		}
+
+		return resolver.Errors(frp)
+	}
+}
+
 func _ObjectTypeEnvironmentConfigFn() graphql1.ObjectConfig {
 	return graphql1.ObjectConfig{
 		Description: "Environment represents a Sensu environment in RBAC",
@@ -424,6 +464,32 @@ func _ObjectTypeEnvironmentConfigFn() graphql1.ObjectConfig {
 				Name:              "entities",
 				Type:              graphql.OutputType("EntityConnection"),
 			},
+			"errors": &graphql1.Field{
+				Args: graphql1.FieldConfigArgument{
+					"after": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql1.String,
+					},
+					"before": &graphql1.ArgumentConfig{
+						Description: "self descriptive",
+						Type:        graphql1.String,
+					},
+					"first": &graphql1.ArgumentConfig{
+						DefaultValue: 10,
+						Description:  "self descriptive",
+						Type:         graphql1.Int,
+					},
+					"last": &graphql1.ArgumentConfig{
+						DefaultValue: 10,
+						Description:  "self descriptive",
+						Type:         graphql1.Int,
+					},
+				},
+				DeprecationReason: "",
+				Description:       "All pipeline errors associated with the environment.",
+				Name:              "errors",
+				Type:              graphql.OutputType("ErrorConnection"),
+			},
 			"events": &graphql1.Field{
 				Args: graphql1.FieldConfigArgument{
 					"after": &graphql1.ArgumentConfig{
@@ -503,6 +569,7 @@ var _ObjectTypeEnvironmentDesc = graphql.ObjectDesc{
 		"colourId":     _ObjTypeEnvironmentColourIDHandler,
 		"description":  _ObjTypeEnvironmentDescriptionHandler,
 		"entities":     _ObjTypeEnvironmentEntitiesHandler,
+		"errors":       _ObjTypeEnvironmentErrorsHandler,
 		"events":       _ObjTypeEnvironmentEventsHandler,
 		"id":           _ObjTypeEnvironmentIDHandler,
 		"name":         _ObjTypeEnvironmentNameHandler,
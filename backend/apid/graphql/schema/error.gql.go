@@ -0,0 +1,756 @@
+// Code generated by scripts/gengraphql.go. DO NOT EDIT.
+
+package schema
+
+import (
+	fmt "fmt"
+	graphql1 "github.com/graphql-go/graphql"
+	graphql "github.com/sensu/sensu-go/graphql"
+	time "time"
+)
+
+// ErrorNamespaceFieldResolver implement to resolve requests for the Error's namespace field.
+type ErrorNamespaceFieldResolver interface {
+	// Namespace implements response to request for namespace field.
+	Namespace(p graphql.ResolveParams) (interface{}, error)
+}
+
+// ErrorNameFieldResolver implement to resolve requests for the Error's name field.
+type ErrorNameFieldResolver interface {
+	// Name implements response to request for name field.
+	Name(p graphql.ResolveParams) (string, error)
+}
+
+// ErrorComponentFieldResolver implement to resolve requests for the Error's component field.
+type ErrorComponentFieldResolver interface {
+	// Component implements response to request for component field.
+	Component(p graphql.ResolveParams) (string, error)
+}
+
+// ErrorMessageFieldResolver implement to resolve requests for the Error's message field.
+type ErrorMessageFieldResolver interface {
+	// Message implements response to request for message field.
+	Message(p graphql.ResolveParams) (string, error)
+}
+
+// ErrorTimestampFieldResolver implement to resolve requests for the Error's timestamp field.
+type ErrorTimestampFieldResolver interface {
+	// Timestamp implements response to request for timestamp field.
+	Timestamp(p graphql.ResolveParams) (time.Time, error)
+}
+
+// ErrorEventFieldResolver implement to resolve requests for the Error's event field.
+type ErrorEventFieldResolver interface {
+	// Event implements response to request for event field.
+	Event(p graphql.ResolveParams) (interface{}, error)
+}
+
+//
+// ErrorFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'Error' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type ErrorFieldResolvers interface {
+	ErrorNamespaceFieldResolver
+	ErrorNameFieldResolver
+	ErrorComponentFieldResolver
+	ErrorMessageFieldResolver
+	ErrorTimestampFieldResolver
+	ErrorEventFieldResolver
+}
+
+// ErrorAliases implements all methods on ErrorFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type ErrorAliases struct{}
+
+// Namespace implements response to request for 'namespace' field.
+func (_ ErrorAliases) Namespace(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Name implements response to request for 'name' field.
+func (_ ErrorAliases) Name(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// Component implements response to request for 'component' field.
+func (_ ErrorAliases) Component(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// Message implements response to request for 'message' field.
+func (_ ErrorAliases) Message(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// Timestamp implements response to request for 'timestamp' field.
+func (_ ErrorAliases) Timestamp(p graphql.ResolveParams) (time.Time, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := val.(time.Time)
+	return ret, err
+}
+
+// Event implements response to request for 'event' field.
+func (_ ErrorAliases) Event(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+/*
+ErrorType An Error describes a failure that occurred while an event was going through
+the pipeline, eg. a handler exiting with a non-zero status.
+*/
+var ErrorType = graphql.NewType("Error", graphql.ObjectKind)
+
+// RegisterError registers Error object type with given service.
+func RegisterError(svc *graphql.Service, impl ErrorFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeErrorDesc, impl)
+}
+func _ObjTypeErrorNamespaceHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ErrorNamespaceFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Namespace(frp)
+	}
+}
+
+func _ObjTypeErrorNameHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ErrorNameFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Name(frp)
+	}
+}
+
+func _ObjTypeErrorComponentHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ErrorComponentFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Component(frp)
+	}
+}
+
+func _ObjTypeErrorMessageHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ErrorMessageFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Message(frp)
+	}
+}
+
+func _ObjTypeErrorTimestampHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ErrorTimestampFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Timestamp(frp)
+	}
+}
+
+func _ObjTypeErrorEventHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ErrorEventFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Event(frp)
+	}
+}
+
+func _ObjectTypeErrorConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "An Error describes a failure that occurred while an event was going through\nthe pipeline, eg. a handler exiting with a non-zero status.",
+		Fields: graphql1.Fields{
+			"component": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Component is the pipeline component that failed; filter, mutator or handler.",
+				Name:              "component",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"event": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Event is the event that was being processed when the failure occurred.",
+				Name:              "event",
+				Type:              graphql1.NewNonNull(graphql.OutputType("Event")),
+			},
+			"message": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Message describes the failure.",
+				Name:              "message",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"name": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Name is the unique identifier of the error.",
+				Name:              "name",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"namespace": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "namespace in which this record resides",
+				Name:              "namespace",
+				Type:              graphql1.NewNonNull(graphql.OutputType("Namespace")),
+			},
+			"timestamp": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "Timestamp is the time at which the failure occurred.",
+				Name:              "timestamp",
+				Type:              graphql1.NewNonNull(graphql1.DateTime),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see ErrorFieldResolvers.")
+		},
+		Name: "Error",
+	}
+}
+
+// describe Error's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeErrorDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeErrorConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"component": _ObjTypeErrorComponentHandler,
+		"event":     _ObjTypeErrorEventHandler,
+		"message":   _ObjTypeErrorMessageHandler,
+		"name":      _ObjTypeErrorNameHandler,
+		"namespace": _ObjTypeErrorNamespaceHandler,
+		"timestamp": _ObjTypeErrorTimestampHandler,
+	},
+}
+
+// ErrorConnectionEdgesFieldResolver implement to resolve requests for the ErrorConnection's edges field.
+type ErrorConnectionEdgesFieldResolver interface {
+	// Edges implements response to request for edges field.
+	Edges(p graphql.ResolveParams) (interface{}, error)
+}
+
+// ErrorConnectionPageInfoFieldResolver implement to resolve requests for the ErrorConnection's pageInfo field.
+type ErrorConnectionPageInfoFieldResolver interface {
+	// PageInfo implements response to request for pageInfo field.
+	PageInfo(p graphql.ResolveParams) (interface{}, error)
+}
+
+// ErrorConnectionTotalCountFieldResolver implement to resolve requests for the ErrorConnection's totalCount field.
+type ErrorConnectionTotalCountFieldResolver interface {
+	// TotalCount implements response to request for totalCount field.
+	TotalCount(p graphql.ResolveParams) (int, error)
+}
+
+//
+// ErrorConnectionFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'ErrorConnection' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type ErrorConnectionFieldResolvers interface {
+	ErrorConnectionEdgesFieldResolver
+	ErrorConnectionPageInfoFieldResolver
+	ErrorConnectionTotalCountFieldResolver
+}
+
+// ErrorConnectionAliases implements all methods on ErrorConnectionFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type ErrorConnectionAliases struct{}
+
+// Edges implements response to request for 'edges' field.
+func (_ ErrorConnectionAliases) Edges(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// PageInfo implements response to request for 'pageInfo' field.
+func (_ ErrorConnectionAliases) PageInfo(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// TotalCount implements response to request for 'totalCount' field.
+func (_ ErrorConnectionAliases) TotalCount(p graphql.ResolveParams) (int, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := graphql1.Int.ParseValue(val).(int)
+	return ret, err
+}
+
+// ErrorConnectionType A connection to a sequence of records.
+var ErrorConnectionType = graphql.NewType("ErrorConnection", graphql.ObjectKind)
+
+// RegisterErrorConnection registers ErrorConnection object type with given service.
+func RegisterErrorConnection(svc *graphql.Service, impl ErrorConnectionFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeErrorConnectionDesc, impl)
+}
+func _ObjTypeErrorConnectionEdgesHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ErrorConnectionEdgesFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Edges(frp)
+	}
+}
+
+func _ObjTypeErrorConnectionPageInfoHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ErrorConnectionPageInfoFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.PageInfo(frp)
+	}
+}
+
+func _ObjTypeErrorConnectionTotalCountHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ErrorConnectionTotalCountFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.TotalCount(frp)
+	}
+}
+
+func _ObjectTypeErrorConnectionConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "A connection to a sequence of records.",
+		Fields: graphql1.Fields{
+			"edges": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "edges",
+				Type:              graphql1.NewList(graphql.OutputType("ErrorEdge")),
+			},
+			"pageInfo": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "pageInfo",
+				Type:              graphql1.NewNonNull(graphql.OutputType("PageInfo")),
+			},
+			"totalCount": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "totalCount",
+				Type:              graphql1.NewNonNull(graphql1.Int),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see ErrorConnectionFieldResolvers.")
+		},
+		Name: "ErrorConnection",
+	}
+}
+
+// describe ErrorConnection's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeErrorConnectionDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeErrorConnectionConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"edges":      _ObjTypeErrorConnectionEdgesHandler,
+		"pageInfo":   _ObjTypeErrorConnectionPageInfoHandler,
+		"totalCount": _ObjTypeErrorConnectionTotalCountHandler,
+	},
+}
+
+// ErrorEdgeNodeFieldResolver implement to resolve requests for the ErrorEdge's node field.
+type ErrorEdgeNodeFieldResolver interface {
+	// Node implements response to request for node field.
+	Node(p graphql.ResolveParams) (interface{}, error)
+}
+
+// ErrorEdgeCursorFieldResolver implement to resolve requests for the ErrorEdge's cursor field.
+type ErrorEdgeCursorFieldResolver interface {
+	// Cursor implements response to request for cursor field.
+	Cursor(p graphql.ResolveParams) (string, error)
+}
+
+//
+// ErrorEdgeFieldResolvers represents a collection of methods whose products represent the
+// response values of the 'ErrorEdge' type.
+//
+// == Example SDL
+//
+//   """
+//   Dog's are not hooman.
+//   """
+//   type Dog implements Pet {
+//     "name of this fine beast."
+//     name:  String!
+//
+//     "breed of this silly animal; probably shibe."
+//     breed: [Breed]
+//   }
+//
+// == Example generated interface
+//
+//   // DogResolver ...
+//   type DogFieldResolvers interface {
+//     DogNameFieldResolver
+//     DogBreedFieldResolver
+//
+//     // IsTypeOf is used to determine if a given value is associated with the Dog type
+//     IsTypeOf(interface{}, graphql.IsTypeOfParams) bool
+//   }
+//
+// == Example implementation ...
+//
+//   // DogResolver implements DogFieldResolvers interface
+//   type DogResolver struct {
+//     logger logrus.LogEntry
+//     store interface{
+//       store.BreedStore
+//       store.DogStore
+//     }
+//   }
+//
+//   // Name implements response to request for name field.
+//   func (r *DogResolver) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     return dog.GetName()
+//   }
+//
+//   // Breed implements response to request for breed field.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // ... implementation details ...
+//     dog := p.Source.(DogGetter)
+//     breed := r.store.GetBreed(dog.GetBreedName())
+//     return breed
+//   }
+//
+//   // IsTypeOf is used to determine if a given value is associated with the Dog type
+//   func (r *DogResolver) IsTypeOf(p graphql.IsTypeOfParams) bool {
+//     // ... implementation details ...
+//     _, ok := p.Value.(DogGetter)
+//     return ok
+//   }
+//
+type ErrorEdgeFieldResolvers interface {
+	ErrorEdgeNodeFieldResolver
+	ErrorEdgeCursorFieldResolver
+}
+
+// ErrorEdgeAliases implements all methods on ErrorEdgeFieldResolvers interface by using reflection to
+// match name of field to a field on the given value. Intent is reduce friction
+// of writing new resolvers by removing all the instances where you would simply
+// have the resolvers method return a field.
+//
+// == Example SDL
+//
+//    type Dog {
+//      name:   String!
+//      weight: Float!
+//      dob:    DateTime
+//      breed:  [Breed]
+//    }
+//
+// == Example generated aliases
+//
+//   type DogAliases struct {}
+//   func (_ DogAliases) Name(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Weight(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Dob(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//   func (_ DogAliases) Breed(p graphql.ResolveParams) (interface{}, error) {
+//     // reflect...
+//   }
+//
+// == Example Implementation
+//
+//   type DogResolver struct { // Implements DogResolver
+//     DogAliases
+//     store store.BreedStore
+//   }
+//
+//   // NOTE:
+//   // All other fields are satisified by DogAliases but since this one
+//   // requires hitting the store we implement it in our resolver.
+//   func (r *DogResolver) Breed(p graphql.ResolveParams) interface{} {
+//     dog := v.(*Dog)
+//     return r.BreedsById(dog.BreedIDs)
+//   }
+//
+type ErrorEdgeAliases struct{}
+
+// Node implements response to request for 'node' field.
+func (_ ErrorEdgeAliases) Node(p graphql.ResolveParams) (interface{}, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	return val, err
+}
+
+// Cursor implements response to request for 'cursor' field.
+func (_ ErrorEdgeAliases) Cursor(p graphql.ResolveParams) (string, error) {
+	val, err := graphql.DefaultResolver(p.Source, p.Info.FieldName)
+	ret := fmt.Sprint(val)
+	return ret, err
+}
+
+// ErrorEdgeType An edge in a connection.
+var ErrorEdgeType = graphql.NewType("ErrorEdge", graphql.ObjectKind)
+
+// RegisterErrorEdge registers ErrorEdge object type with given service.
+func RegisterErrorEdge(svc *graphql.Service, impl ErrorEdgeFieldResolvers) {
+	svc.RegisterObject(_ObjectTypeErrorEdgeDesc, impl)
+}
+func _ObjTypeErrorEdgeNodeHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ErrorEdgeNodeFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Node(frp)
+	}
+}
+
+func _ObjTypeErrorEdgeCursorHandler(impl interface{}) graphql1.FieldResolveFn {
+	resolver := impl.(ErrorEdgeCursorFieldResolver)
+	return func(frp graphql1.ResolveParams) (interface{}, error) {
+		return resolver.Cursor(frp)
+	}
+}
+
+func _ObjectTypeErrorEdgeConfigFn() graphql1.ObjectConfig {
+	return graphql1.ObjectConfig{
+		Description: "An edge in a connection.",
+		Fields: graphql1.Fields{
+			"cursor": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "cursor",
+				Type:              graphql1.NewNonNull(graphql1.String),
+			},
+			"node": &graphql1.Field{
+				Args:              graphql1.FieldConfigArgument{},
+				DeprecationReason: "",
+				Description:       "self descriptive",
+				Name:              "node",
+				Type:              graphql.OutputType("Error"),
+			},
+		},
+		Interfaces: []*graphql1.Interface{},
+		IsTypeOf: func(_ graphql1.IsTypeOfParams) bool {
+			// NOTE:
+			// Panic by default. Intent is that when Service is invoked, values of
+			// these fields are updated with instantiated resolvers. If these
+			// defaults are called it is most certainly programmer err.
+			// If you're see this comment then: 'Whoops! Sorry, my bad.'
+			panic("Unimplemented; see ErrorEdgeFieldResolvers.")
+		},
+		Name: "ErrorEdge",
+	}
+}
+
+// describe ErrorEdge's configuration; kept private to avoid unintentional tampering of configuration at runtime.
+var _ObjectTypeErrorEdgeDesc = graphql.ObjectDesc{
+	Config: _ObjectTypeErrorEdgeConfigFn,
+	FieldHandlers: map[string]graphql.FieldHandler{
+		"cursor": _ObjTypeErrorEdgeCursorHandler,
+		"node":   _ObjTypeErrorEdgeNodeHandler,
+	},
+}
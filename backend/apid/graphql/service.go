@@ -71,6 +71,11 @@ func NewService(cfg ServiceConfig) (*graphql.Service, error) {
 	schema.RegisterEventConnection(svc, &schema.EventConnectionAliases{})
 	schema.RegisterEventEdge(svc, &schema.EventEdgeAliases{})
 
+	// Register error types
+	schema.RegisterError(svc, &errorImpl{})
+	schema.RegisterErrorConnection(svc, &schema.ErrorConnectionAliases{})
+	schema.RegisterErrorEdge(svc, &schema.ErrorEdgeAliases{})
+
 	// Register hook types
 	schema.RegisterHook(svc, &hookImpl{})
 	schema.RegisterHookConfig(svc, &hookCfgImpl{})
@@ -0,0 +1,82 @@
+package routers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/backup"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// BackupRouter handles requests for /backup and /restore
+type BackupRouter struct {
+	controller actions.BackupController
+}
+
+// NewBackupRouter instantiates new router for the backup and the restore of
+// the store
+func NewBackupRouter(store store.Store) *BackupRouter {
+	return &BackupRouter{
+		controller: actions.NewBackupController(store),
+	}
+}
+
+// Mount the BackupRouter to a parent Router
+func (r *BackupRouter) Mount(parent *mux.Router) {
+	parent.HandleFunc("/backup", r.backup).Methods(http.MethodGet)
+	parent.HandleFunc("/restore", actionHandler(r.restore)).Methods(http.MethodPost)
+}
+
+// backupOptions reads the options of a backup or a restore from the query
+// parameters. Unlike the other routes, no organization or environment means
+// all of them.
+func backupOptions(req *http.Request) (backup.Options, error) {
+	query := req.URL.Query()
+	opts := backup.Options{
+		Organization: query.Get("org"),
+		Environment:  query.Get("env"),
+	}
+	if events := query.Get("events"); events != "" {
+		include, err := strconv.ParseBool(events)
+		if err != nil {
+			return opts, actions.NewErrorf(actions.InvalidArgument, "invalid events parameter")
+		}
+		opts.Events = include
+	}
+	return opts, nil
+}
+
+func (r *BackupRouter) backup(w http.ResponseWriter, req *http.Request) {
+	opts, err := backupOptions(req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	archive, err := r.controller.Backup(req.Context(), opts)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/gzip")
+	if err := archive.Write(w); err != nil {
+		logger.WithError(err).Error("failed to write backup")
+	}
+}
+
+func (r *BackupRouter) restore(req *http.Request) (interface{}, error) {
+	opts, err := backupOptions(req)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := backup.Read(req.Body)
+	if err != nil {
+		return nil, actions.NewError(actions.InvalidArgument, err)
+	}
+
+	return nil, r.controller.Restore(req.Context(), archive, opts)
+}
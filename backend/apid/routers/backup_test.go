@@ -0,0 +1,62 @@
+package routers
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/backup"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupRouter(t *testing.T) {
+	store := &mockstore.MockStore{}
+	store.On("GetOrganizations", mock.Anything).Return([]*types.Organization{}, nil)
+	store.On("UpdateOrganization", mock.Anything, mock.Anything).Return(nil)
+
+	parent := mux.NewRouter()
+	NewBackupRouter(store).Mount(parent)
+	ctx := testutil.NewContext(testutil.ContextWithFullAccess)
+
+	// Backup a single organization
+	req := httptest.NewRequest(http.MethodGet, "/backup?org=acme&events=true", nil)
+	res := httptest.NewRecorder()
+	parent.ServeHTTP(res, req.WithContext(ctx))
+	require.Equal(t, http.StatusOK, res.Code)
+	assert.Equal(t, "application/gzip", res.Header().Get("Content-Type"))
+
+	archive, err := backup.Read(res.Body)
+	require.NoError(t, err)
+	assert.Empty(t, archive.Resources)
+
+	req = httptest.NewRequest(http.MethodGet, "/backup?events=maybe", nil)
+	res = httptest.NewRecorder()
+	parent.ServeHTTP(res, req.WithContext(ctx))
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+
+	// Restore it
+	archive.Resources = append(archive.Resources, types.Wrapper{
+		Type:  "Organization",
+		Value: types.FixtureOrganization("acme"),
+	})
+	var buf bytes.Buffer
+	require.NoError(t, archive.Write(&buf))
+
+	req = httptest.NewRequest(http.MethodPost, "/restore", &buf)
+	res = httptest.NewRecorder()
+	parent.ServeHTTP(res, req.WithContext(ctx))
+	assert.Equal(t, http.StatusNoContent, res.Code)
+	store.AssertCalled(t, "UpdateOrganization", mock.Anything, mock.Anything)
+
+	req = httptest.NewRequest(http.MethodPost, "/restore", bytes.NewBufferString("{}"))
+	res = httptest.NewRecorder()
+	parent.ServeHTTP(res, req.WithContext(ctx))
+	assert.Equal(t, http.StatusBadRequest, res.Code)
+}
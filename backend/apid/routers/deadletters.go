@@ -0,0 +1,44 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/types"
+)
+
+// DeadLettersRouter handles requests for /deadletters
+type DeadLettersRouter struct {
+	controller actions.DeadLetterController
+}
+
+// NewDeadLettersRouter instantiates new dead letters controller
+func NewDeadLettersRouter(getter types.QueueGetter) *DeadLettersRouter {
+	return &DeadLettersRouter{
+		controller: actions.NewDeadLetterController(getter),
+	}
+}
+
+// Mount the DeadLettersRouter to a parent Router
+func (r *DeadLettersRouter) Mount(parent *mux.Router) {
+	routes := resourceRoute{router: parent, pathPrefix: "/deadletters"}
+	routes.getAll(r.list)
+
+	// Custom
+	routes.path("replay", r.replay).Methods(http.MethodPost)
+	routes.path("{name}/replay", r.replay).Methods(http.MethodPost)
+}
+
+func (r *DeadLettersRouter) list(req *http.Request) (interface{}, error) {
+	records, err := r.controller.Query(req.Context())
+	return records, err
+}
+
+func (r *DeadLettersRouter) replay(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	name := url.PathEscape(params["name"])
+	records, err := r.controller.Replay(req.Context(), name)
+	return records, err
+}
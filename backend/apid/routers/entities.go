@@ -6,7 +6,9 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
 )
 
 // EntitiesRouter handles requests for /entities
@@ -15,9 +17,9 @@ type EntitiesRouter struct {
 }
 
 // NewEntitiesRouter instantiates new router for controlling entities resources
-func NewEntitiesRouter(store store.EntityStore) *EntitiesRouter {
+func NewEntitiesRouter(store store.EntityStore, bus messaging.MessageBus) *EntitiesRouter {
 	return &EntitiesRouter{
-		controller: actions.NewEntityController(store),
+		controller: actions.NewEntityController(store, bus),
 	}
 }
 
@@ -26,6 +28,7 @@ func (r *EntitiesRouter) Mount(parent *mux.Router) {
 	routes := resourceRoute{router: parent, pathPrefix: "/entities"}
 	routes.getAll(r.list)
 	routes.get(r.find)
+	routes.put(r.update)
 	routes.del(r.destroy)
 }
 
@@ -53,3 +56,20 @@ func (r *EntitiesRouter) list(req *http.Request) (interface{}, error) {
 	records, err := r.controller.Query(req.Context())
 	return records, err
 }
+
+func (r *EntitiesRouter) update(req *http.Request) (interface{}, error) {
+	params := mux.Vars(req)
+	id, err := url.PathUnescape(params["id"])
+	if err != nil {
+		return nil, err
+	}
+
+	entity := types.Entity{}
+	if err := unmarshalBody(req, &entity); err != nil {
+		return nil, err
+	}
+	entity.ID = id
+
+	err = r.controller.Update(req.Context(), entity)
+	return entity, err
+}
@@ -0,0 +1,69 @@
+package routers
+
+import (
+	"net/http"
+	"net/url"
+
+	"github.com/gorilla/mux"
+	"github.com/sensu/sensu-go/backend/apid/actions"
+	"github.com/sensu/sensu-go/backend/store"
+)
+
+// ErrorsRouter handles requests for /errors
+type ErrorsRouter struct {
+	controller actions.ErrorController
+}
+
+// NewErrorsRouter instantiates new errors controller
+func NewErrorsRouter(store store.ErrorStore) *ErrorsRouter {
+	return &ErrorsRouter{
+		controller: actions.NewErrorController(store),
+	}
+}
+
+// Mount the ErrorsRouter to a parent Router
+func (r *ErrorsRouter) Mount(parent *mux.Router) {
+	routes := resourceRoute{router: parent, pathPrefix: "/errors"}
+	routes.getAll(r.list)
+	routes.path("{entity}", r.listByEntity).Methods(http.MethodGet)
+	routes.path("{entity}/{check}", r.listByEntityCheck).Methods(http.MethodGet)
+	routes.path("{entity}/{check}/{name}", r.find).Methods(http.MethodGet)
+	routes.path("{entity}/{check}/{name}", r.destroy).Methods(http.MethodDelete)
+}
+
+func (r *ErrorsRouter) list(req *http.Request) (interface{}, error) {
+	records, err := r.controller.Query(req.Context(), "", "")
+	return records, err
+}
+
+func (r *ErrorsRouter) listByEntity(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	entity := url.PathEscape(params["entity"])
+	records, err := r.controller.Query(req.Context(), entity, "")
+	return records, err
+}
+
+func (r *ErrorsRouter) listByEntityCheck(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	entity := url.PathEscape(params["entity"])
+	check := url.PathEscape(params["check"])
+	records, err := r.controller.Query(req.Context(), entity, check)
+	return records, err
+}
+
+func (r *ErrorsRouter) find(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	entity := url.PathEscape(params["entity"])
+	check := url.PathEscape(params["check"])
+	name := url.PathEscape(params["name"])
+	record, err := r.controller.Find(req.Context(), entity, check, name)
+	return record, err
+}
+
+func (r *ErrorsRouter) destroy(req *http.Request) (interface{}, error) {
+	params := actions.QueryParams(mux.Vars(req))
+	entity := url.PathEscape(params["entity"])
+	check := url.PathEscape(params["check"])
+	name := url.PathEscape(params["name"])
+	return nil, r.controller.Destroy(req.Context(), entity, check, name)
+}
@@ -0,0 +1,26 @@
+package routers
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// MetricsRouter handles requests for /metrics
+type MetricsRouter struct {
+	gatherer prometheus.Gatherer
+}
+
+// NewMetricsRouter instantiates a new router exposing the metrics of the
+// gatherer in the Prometheus text format
+func NewMetricsRouter(gatherer prometheus.Gatherer) *MetricsRouter {
+	return &MetricsRouter{gatherer: gatherer}
+}
+
+// Mount the MetricsRouter to a parent Router
+func (r *MetricsRouter) Mount(parent *mux.Router) {
+	handler := promhttp.HandlerFor(r.gatherer, promhttp.HandlerOpts{})
+	parent.Handle("/metrics", handler).Methods(http.MethodGet)
+}
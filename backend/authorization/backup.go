@@ -0,0 +1,66 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// Backups is global instance of BackupPolicy
+var Backups = BackupPolicy{}
+
+// BackupPolicy ...
+type BackupPolicy struct {
+	context Context
+}
+
+// Resource this policy is associated with. A backup contains every type of
+// resource, so only the rules which apply to all of them are considered.
+func (p *BackupPolicy) Resource() string {
+	return types.RuleTypeAll
+}
+
+// Context info this instance of the policy is associated with
+func (p *BackupPolicy) Context() Context {
+	return p.context
+}
+
+// WithContext returns new policy populated with rules & organization.
+func (p BackupPolicy) WithContext(ctx context.Context) BackupPolicy { // nolint
+	p.context = ExtractValueFromContext(ctx)
+	return p
+}
+
+// CanBackup returns true if actor has full access to every resource of the
+// given organization and environment. An empty organization or environment
+// stands for all of them. Read access isn't enough, since a backup holds the
+// secrets which the API redacts, such as the password hashes of the users and
+// the headers of the assets.
+func (p *BackupPolicy) CanBackup(org, env string) bool {
+	org, env = backupNamespace(org, env)
+	for _, perm := range types.RuleAllPerms {
+		if !canPerformOn(p, org, env, perm) {
+			return false
+		}
+	}
+	return true
+}
+
+// CanRestore returns true if actor has access to create and update every
+// resource of the given organization and environment. An empty organization or
+// environment stands for all of them.
+func (p *BackupPolicy) CanRestore(org, env string) bool {
+	org, env = backupNamespace(org, env)
+	return canPerformOn(p, org, env, types.RulePermCreate) &&
+		canPerformOn(p, org, env, types.RulePermUpdate)
+}
+
+func backupNamespace(org, env string) (string, string) {
+	if org == "" {
+		org = "*"
+	}
+	if env == "" {
+		env = "*"
+	}
+	return org, env
+}
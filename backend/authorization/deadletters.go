@@ -0,0 +1,56 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// DeadLetters is global instance of DeadLetterPolicy
+var DeadLetters = DeadLetterPolicy{}
+
+// DeadLetterPolicy ...
+type DeadLetterPolicy struct {
+	context Context
+}
+
+// Resource this policy is associated with
+func (p *DeadLetterPolicy) Resource() string {
+	return types.RuleTypeDeadLetter
+}
+
+// Context info this instance of the policy is associated with
+func (p *DeadLetterPolicy) Context() Context {
+	return p.context
+}
+
+// WithContext returns new policy populated with rules & organization.
+func (p DeadLetterPolicy) WithContext(ctx context.Context) DeadLetterPolicy { // nolint
+	p.context = ExtractValueFromContext(ctx)
+	return p
+}
+
+// CanList returns true if actor has read access to resource.
+func (p *DeadLetterPolicy) CanList() bool {
+	return canPerform(p, types.RulePermRead)
+}
+
+// CanRead returns true if actor has read access to resource.
+func (p *DeadLetterPolicy) CanRead(letter *types.DeadLetter) bool {
+	return canPerformOn(p, letter.GetOrganization(), letter.GetEnvironment(), types.RulePermRead)
+}
+
+// CanCreate returns true if actor has access to create.
+func (p *DeadLetterPolicy) CanCreate(letter *types.DeadLetter) bool {
+	return canPerformOn(p, letter.GetOrganization(), letter.GetEnvironment(), types.RulePermCreate)
+}
+
+// CanUpdate returns true if actor has access to update.
+func (p *DeadLetterPolicy) CanUpdate(letter *types.DeadLetter) bool {
+	return canPerformOn(p, letter.GetOrganization(), letter.GetEnvironment(), types.RulePermUpdate)
+}
+
+// CanDelete returns true if actor has access to delete.
+func (p *DeadLetterPolicy) CanDelete(letter *types.DeadLetter) bool {
+	return canPerformOn(p, letter.GetOrganization(), letter.GetEnvironment(), types.RulePermDelete)
+}
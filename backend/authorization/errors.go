@@ -0,0 +1,56 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// Errors is global instance of ErrorPolicy
+var Errors = ErrorPolicy{}
+
+// ErrorPolicy ...
+type ErrorPolicy struct {
+	context Context
+}
+
+// Resource this policy is associated with
+func (p *ErrorPolicy) Resource() string {
+	return types.RuleTypeError
+}
+
+// Context info this instance of the policy is associated with
+func (p *ErrorPolicy) Context() Context {
+	return p.context
+}
+
+// WithContext returns new policy populated with rules & organization.
+func (p ErrorPolicy) WithContext(ctx context.Context) ErrorPolicy { // nolint
+	p.context = ExtractValueFromContext(ctx)
+	return p
+}
+
+// CanList returns true if actor has read access to resource.
+func (p *ErrorPolicy) CanList() bool {
+	return canPerform(p, types.RulePermRead)
+}
+
+// CanRead returns true if actor has read access to resource.
+func (p *ErrorPolicy) CanRead(perr *types.Error) bool {
+	return canPerformOn(p, perr.GetOrganization(), perr.GetEnvironment(), types.RulePermRead)
+}
+
+// CanCreate returns true if actor has access to create.
+func (p *ErrorPolicy) CanCreate(perr *types.Error) bool {
+	return canPerformOn(p, perr.GetOrganization(), perr.GetEnvironment(), types.RulePermCreate)
+}
+
+// CanUpdate returns true if actor has access to update.
+func (p *ErrorPolicy) CanUpdate(perr *types.Error) bool {
+	return canPerformOn(p, perr.GetOrganization(), perr.GetEnvironment(), types.RulePermUpdate)
+}
+
+// CanDelete returns true if actor has access to delete.
+func (p *ErrorPolicy) CanDelete(perr *types.Error) bool {
+	return canPerformOn(p, perr.GetOrganization(), perr.GetEnvironment(), types.RulePermDelete)
+}
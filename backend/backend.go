@@ -1,10 +1,15 @@
 package backend
 
 import (
+	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"runtime/debug"
+	"strings"
 
+	"github.com/coreos/etcd/clientv3"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sensu/sensu-go/backend/agentd"
 	"github.com/sensu/sensu-go/backend/apid"
 	"github.com/sensu/sensu-go/backend/daemon"
@@ -12,6 +17,7 @@ import (
 	"github.com/sensu/sensu-go/backend/etcd"
 	"github.com/sensu/sensu-go/backend/eventd"
 	"github.com/sensu/sensu-go/backend/keepalived"
+	"github.com/sensu/sensu-go/backend/leader"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/migration"
 	"github.com/sensu/sensu-go/backend/pipelined"
@@ -38,6 +44,7 @@ const (
 type Config struct {
 	// Backend Configuration
 	StateDir string
+	CacheDir string
 
 	// Agentd Configuration
 	AgentHost string
@@ -53,6 +60,9 @@ type Config struct {
 
 	// Pipelined Configuration
 	DeregistrationHandler string
+	PipelineWorkers       int
+	AssetTrustedKeys      []string
+	AssetRequireSignature bool
 
 	// Etcd configuration
 	EtcdInitialAdvertisePeerURL string
@@ -63,6 +73,14 @@ type Config struct {
 	EtcdListenPeerURL           string
 	EtcdName                    string
 
+	// External etcd configuration, used instead of the embedded etcd when
+	// endpoints are given
+	EtcdEndpoints     []string
+	EtcdCertFile      string
+	EtcdKeyFile       string
+	EtcdTrustedCAFile string
+	EtcdKeyPrefix     string
+
 	TLS *types.TLSOptions
 }
 
@@ -74,10 +92,12 @@ type Backend struct {
 	shutdownChan chan struct{}
 	done         chan struct{}
 	messageBus   messaging.MessageBus
+	metrics      *prometheus.Registry
 	apid         daemon.Daemon
 	agentd       daemon.Daemon
 	schedulerd   daemon.Daemon
 	etcd         *etcd.Etcd
+	client       *clientv3.Client
 
 	dashboardd daemon.Daemon
 	eventd     daemon.Daemon
@@ -122,6 +142,11 @@ func NewBackend(config *Config) (*Backend, error) {
 		config.AgentPort = 8081
 	}
 
+	// The key prefix only applies to the keys of an external etcd cluster
+	if config.EtcdKeyPrefix != "" && len(config.EtcdEndpoints) == 0 {
+		return nil, errors.New("etcd key prefix requires external etcd endpoints")
+	}
+
 	// Check for TLS config and load certs if present
 	var (
 		tlsConfig *tls.Config
@@ -142,7 +167,42 @@ func NewBackend(config *Config) (*Backend, error) {
 	}
 
 	// we go ahead and setup and start etcd here, because we'll have to pass
-	// a store along to the API.
+	// a store along to the API. Given the endpoints of an external etcd
+	// cluster, the backend is only a client of that cluster.
+	var client *clientv3.Client
+	if len(config.EtcdEndpoints) > 0 {
+		client, err = newExternalClient(config)
+	} else {
+		client, err = b.startEtcd(tlsConfig)
+	}
+	if err != nil {
+		return nil, err
+	}
+	b.client = client
+
+	// The check requests are relayed to the other backends of the cluster, so
+	// they reach the agents connected to any of them
+	bus, err := messaging.NewEtcdBus(messaging.EtcdBusConfig{
+		Client:     client,
+		RingGetter: ring.EtcdGetter{Client: client},
+		Topics:     []string{messaging.TopicSubscriptions},
+	})
+	if err != nil {
+		return nil, err
+	}
+	b.messageBus = bus
+
+	// The metrics of the components owned by this backend, which are exposed
+	// along with the ones of the default registry
+	b.metrics = prometheus.NewRegistry()
+	b.metrics.MustRegister(bus)
+
+	return b, nil
+}
+
+// startEtcd starts the embedded etcd, and returns a client of it.
+func (b *Backend) startEtcd(tlsConfig *tls.Config) (*clientv3.Client, error) {
+	config := b.Config
 	cfg := etcd.NewConfig()
 	cfg.DataDir = config.StateDir
 	cfg.ListenClientURL = config.EtcdListenClientURL
@@ -169,20 +229,28 @@ func NewBackend(config *Config) (*Backend, error) {
 	}
 	b.etcd = e
 
-	client, err := e.NewClient()
-	if err != nil {
-		return nil, err
+	return e.NewClient()
+}
+
+// newExternalClient returns a client of the external etcd cluster.
+func newExternalClient(config *Config) (*clientv3.Client, error) {
+	cfg := &etcd.ClientConfig{
+		Endpoints: config.EtcdEndpoints,
+		KeyPrefix: config.EtcdKeyPrefix,
+	}
+	if config.EtcdCertFile != "" || config.EtcdKeyFile != "" || config.EtcdTrustedCAFile != "" {
+		cfg.TLSInfo = &etcd.TLSInfo{
+			CertFile:      config.EtcdCertFile,
+			KeyFile:       config.EtcdKeyFile,
+			TrustedCAFile: config.EtcdTrustedCAFile,
+		}
 	}
 
-	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
-		RingGetter: ring.EtcdGetter{Client: client},
-	})
+	client, err := etcd.NewExternalClient(cfg)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("error connecting to etcd: %s", err)
 	}
-	b.messageBus = bus
-
-	return b, nil
+	return client, nil
 }
 
 type stopper interface {
@@ -236,15 +304,14 @@ func (b *Backend) Run() (derr error) {
 		return err
 	}
 
-	// Right now, instantiating a new Etcd will start etcd. If we change that
-	// s.t. Etcd has its own Start() method, conforming to Daemon, then we will
-	// want to make sure that we aren't calling NewClient before starting it,
-	// I think. That might return a connection error.
-	client, err := b.etcd.NewClient()
-	if err != nil {
-		return err
+	client := b.client
+	etcdName := b.Config.EtcdName
+
+	// Migrate the store before anything is written to it, which also refuses
+	// to start if its schema is newer than the one of this backend
+	if err := migration.Run(context.Background(), client); err != nil {
+		return fmt.Errorf("error migrating the store: %s", err)
 	}
-	etcdName := b.etcd.Name()
 
 	// Seed initial data
 	store := etcdstore.NewStore(client, etcdName)
@@ -252,10 +319,17 @@ func (b *Backend) Run() (derr error) {
 		return err
 	}
 
+	// The leader of the cluster handles the expired keepalive and check TTL
+	// deadlines
+	if err := leader.Initialize(client); err != nil {
+		return fmt.Errorf("error initializing leader election: %s", err)
+	}
+
 	bus := b.messageBus
 	tlsOpts := b.Config.TLS
 	queueGetter := queue.EtcdGetter{Client: client}
 
+	var err error
 	b.schedulerd, err = schedulerd.New(schedulerd.Config{
 		Store:       store,
 		Bus:         bus,
@@ -269,8 +343,14 @@ func (b *Backend) Run() (derr error) {
 	}
 
 	b.pipelined, err = pipelined.New(pipelined.Config{
-		Store: store,
-		Bus:   bus,
+		Store:       store,
+		Bus:         bus,
+		QueueGetter: queueGetter,
+		Workers:     b.Config.PipelineWorkers,
+		CacheDir:    b.Config.CacheDir,
+
+		AssetTrustedKeys:      b.Config.AssetTrustedKeys,
+		AssetRequireSignature: b.Config.AssetRequireSignature,
 	})
 	if err != nil {
 		return fmt.Errorf("error creating pipelined: %s", err)
@@ -289,6 +369,7 @@ func (b *Backend) Run() (derr error) {
 		QueueGetter:   queueGetter,
 		TLS:           tlsOpts,
 		BackendStatus: b.Status,
+		Gatherer:      prometheus.Gatherers{prometheus.DefaultGatherer, b.metrics},
 	})
 	if err != nil {
 		return fmt.Errorf("error creating apid: %s", err)
@@ -328,8 +409,9 @@ func (b *Backend) Run() (derr error) {
 	}
 
 	b.eventd, err = eventd.New(eventd.Config{
-		Store: store,
-		Bus:   bus,
+		Store:  store,
+		Bus:    bus,
+		Client: client,
 	})
 	if err != nil {
 		return fmt.Errorf("error creating eventd: %s", err)
@@ -341,8 +423,9 @@ func (b *Backend) Run() (derr error) {
 
 	b.keepalived, err = keepalived.New(keepalived.Config{
 		DeregistrationHandler: b.Config.DeregistrationHandler,
-		Bus:   bus,
-		Store: store,
+		Bus:                   bus,
+		Store:                 store,
+		Client:                client,
 	})
 	if err != nil {
 		return fmt.Errorf("error creating keepalived: %s", err)
@@ -358,7 +441,6 @@ func (b *Backend) Run() (derr error) {
 			b.apid,
 			b.agentd,
 			b.schedulerd,
-			b.etcd,
 			b.messageBus,
 			b.pipelined,
 			b.dashboardd,
@@ -366,6 +448,9 @@ func (b *Backend) Run() (derr error) {
 			b.keepalived,
 		},
 	}
+	if b.etcd != nil {
+		eg.errors = append(eg.errors, b.etcd)
+	}
 	eg.Go()
 
 	select {
@@ -381,6 +466,11 @@ func (b *Backend) Run() (derr error) {
 			trace := string(debug.Stack())
 			logger.WithField("panic", trace).WithError(err.(error)).Error("recovering from panic due to error, shutting down etcd")
 		}
+		// the external etcd cluster keeps running
+		if b.etcd == nil {
+			_ = b.client.Close()
+			return
+		}
 		err := b.etcd.Shutdown()
 		if derr == nil {
 			derr = err
@@ -396,6 +486,8 @@ func (b *Backend) Run() (derr error) {
 		{Name: "agentd", stopper: b.agentd},
 		// stop scheduling checks.
 		{Name: "schedulerd", stopper: b.schedulerd},
+		// stop monitoring keepalives.
+		{Name: "keepalived", stopper: b.keepalived},
 		// Shutting down eventd will cause it to drain events to the bus
 		{Name: "eventd", stopper: b.eventd},
 		// Once events have been drained from eventd, pipelined can finish
@@ -412,6 +504,11 @@ func (b *Backend) Run() (derr error) {
 		}
 	}
 
+	// let another backend handle the expired deadlines
+	if err := leader.Resign(); err != nil {
+		logger.WithError(err).Error("error resigning from leadership")
+	}
+
 	// we allow inErrChan to leak to avoid panics from other
 	// goroutines writing errors to either after shutdown has been initiated.
 	close(b.done)
@@ -419,17 +516,26 @@ func (b *Backend) Run() (derr error) {
 	return derr
 }
 
-// Migration performs the migration of data inside the store
-func (b *Backend) Migration() error {
-	logger.Infof("starting migration on the store with URL '%s'", b.etcd.LoopbackURL())
-	migration.Run(b.etcd.LoopbackURL())
-	return nil
+// Migration applies the pending migrations to the store, or only logs the
+// changes they would make if dryRun is true
+func (b *Backend) Migration(dryRun bool) error {
+	logger.Infof("starting migration on the store with URLs '%s'", strings.Join(b.client.Endpoints(), ","))
+	if dryRun {
+		return migration.DryRun(context.Background(), b.client)
+	}
+
+	return migration.Run(context.Background(), b.client)
 }
 
 // Status returns a map of component name to boolean healthy indicator.
 func (b *Backend) Status() types.StatusMap {
+	storeHealthy := etcd.ClientHealthy(b.client)
+	if b.etcd != nil {
+		storeHealthy = b.etcd.Healthy()
+	}
+
 	sm := map[string]bool{
-		"store":       b.etcd.Healthy(),
+		"store":       storeHealthy,
 		"message_bus": b.messageBus.Status() == nil,
 		"schedulerd":  b.schedulerd.Status() == nil,
 		"pipelined":   b.pipelined.Status() == nil,
@@ -3,6 +3,7 @@
 package backend
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"net"
@@ -11,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/coreos/etcd/clientv3"
 	"github.com/sensu/sensu-go/backend/etcd"
 	"github.com/sensu/sensu-go/testing/testutil"
 	"github.com/sensu/sensu-go/transport"
@@ -125,3 +127,71 @@ func TestBackendHTTPListener(t *testing.T) {
 		})
 	}
 }
+
+func TestBackendExternalEtcd(t *testing.T) {
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+
+	path, remove := testutil.TempDir(t)
+	defer remove()
+
+	ports := make([]int, 3)
+	require.NoError(t, testutil.RandomPorts(ports))
+	agentPort, apiPort, dashboardPort := ports[0], ports[1], ports[2]
+
+	b, err := NewBackend(&Config{
+		AgentHost:     "127.0.0.1",
+		AgentPort:     agentPort,
+		APIHost:       "127.0.0.1",
+		APIPort:       apiPort,
+		DashboardHost: "127.0.0.1",
+		DashboardPort: dashboardPort,
+		StateDir:      path,
+		EtcdEndpoints: []string{e.LoopbackURL()},
+		EtcdKeyPrefix: "/external",
+	})
+	require.NoError(t, err)
+
+	// The embedded etcd is not started
+	assert.Nil(t, b.etcd)
+
+	var runError error
+	var runWg sync.WaitGroup
+	runWg.Add(1)
+	go func() {
+		defer runWg.Done()
+		runError = b.Run()
+	}()
+
+	retryConnect(t, fmt.Sprintf("127.0.0.1:%d", apiPort))
+	assert.True(t, etcd.ClientHealthy(b.client))
+
+	b.Stop()
+	runWg.Wait()
+	assert.NoError(t, runError)
+
+	// The initial data is seeded under the key prefix, and the external etcd
+	// keeps running
+	client, err := e.NewClient()
+	require.NoError(t, err)
+	defer client.Close()
+
+	resp, err := client.Get(context.Background(), "/external/sensu.io/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	require.NoError(t, err)
+	assert.NotZero(t, resp.Count)
+
+	resp, err = client.Get(context.Background(), "/sensu.io/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	require.NoError(t, err)
+	assert.Zero(t, resp.Count)
+}
+
+func TestBackendKeyPrefixRequiresExternalEtcd(t *testing.T) {
+	path, remove := testutil.TempDir(t)
+	defer remove()
+
+	_, err := NewBackend(&Config{
+		StateDir:      path,
+		EtcdKeyPrefix: "/external",
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package backup
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+// Version is the version of the archive format. It must be incremented
+// whenever the archive format changes in a way older versions can't read.
+const Version = 1
+
+// Archive is a portable snapshot of the resources of a Sensu store. It is
+// written as gzip compressed JSON, where every resource is wrapped with its
+// type, just like the resources accepted by sensuctl create.
+type Archive struct {
+	// Version is the version of the archive format
+	Version int `json:"version"`
+
+	// CreatedAt is the time the archive was created, as a unix timestamp
+	CreatedAt int64 `json:"created_at"`
+
+	// Resources are the resources of the archive, in the order they must be
+	// restored
+	Resources []types.Wrapper `json:"resources"`
+}
+
+type rawArchive struct {
+	Version   int             `json:"version"`
+	CreatedAt int64           `json:"created_at"`
+	Resources json.RawMessage `json:"resources"`
+}
+
+func (a *Archive) add(typ string, resource types.Resource) {
+	a.Resources = append(a.Resources, types.Wrapper{Type: typ, Value: resource})
+}
+
+// Write writes the archive to w.
+func (a *Archive) Write(w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	if err := json.NewEncoder(gz).Encode(a); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// Read reads an archive from r. An error is returned if the archive was
+// written with another version of the archive format.
+func Read(r io.Reader) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive: %s", err)
+	}
+	defer gz.Close()
+
+	var raw rawArchive
+	if err := json.NewDecoder(gz).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("invalid archive: %s", err)
+	}
+	if raw.Version != Version {
+		return nil, fmt.Errorf("unsupported archive version %d, expected %d", raw.Version, Version)
+	}
+
+	archive := &Archive{Version: raw.Version, CreatedAt: raw.CreatedAt}
+	if len(raw.Resources) > 0 {
+		if err := json.Unmarshal(raw.Resources, &archive.Resources); err != nil {
+			return nil, fmt.Errorf("invalid archive: %s", err)
+		}
+	}
+
+	return archive, nil
+}
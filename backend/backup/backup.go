@@ -0,0 +1,308 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// Options selects the resources of a backup or a restore.
+type Options struct {
+	// Organization limits the resources to the ones of this organization. The
+	// users and roles, which don't belong to any organization, are only
+	// included when no organization is given.
+	Organization string
+
+	// Environment limits the resources to the ones of this environment, within
+	// the organization.
+	Environment string
+
+	// Events includes the events in a backup. Restore ignores it and restores
+	// the events found in the archive.
+	Events bool
+}
+
+// Validate returns an error if the options are invalid.
+func (o Options) Validate() error {
+	if o.Environment != "" && o.Organization == "" {
+		return fmt.Errorf("an organization is required to select the environment %s", o.Environment)
+	}
+	return nil
+}
+
+func (o Options) includesGlobal() bool {
+	return o.Organization == ""
+}
+
+func (o Options) includesOrg(org string) bool {
+	return o.Organization == "" || o.Organization == org
+}
+
+func (o Options) includesEnv(org, env string) bool {
+	return o.includesOrg(org) && (o.Environment == "" || o.Environment == env)
+}
+
+// Export returns an archive of the resources of the store selected by opts.
+func Export(ctx context.Context, st store.Store, opts Options) (*Archive, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	archive := &Archive{Version: Version, CreatedAt: time.Now().Unix()}
+
+	if opts.includesGlobal() {
+		users, err := st.GetAllUsers()
+		if err != nil {
+			return nil, err
+		}
+		for _, user := range users {
+			archive.add("User", user)
+		}
+
+		roles, err := st.GetRoles(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, role := range roles {
+			archive.add("Role", role)
+		}
+	}
+
+	orgs, err := st.GetOrganizations(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, org := range orgs {
+		if !opts.includesOrg(org.Name) {
+			continue
+		}
+		archive.add("Organization", org)
+
+		// Assets belong to an organization rather than an environment
+		orgCtx := context.WithValue(ctx, types.OrganizationKey, org.Name)
+		orgCtx = context.WithValue(orgCtx, types.EnvironmentKey, store.WildcardValue)
+		assets, err := st.GetAssets(orgCtx)
+		if err != nil {
+			return nil, err
+		}
+		for _, asset := range assets {
+			archive.add("Asset", asset)
+		}
+
+		envs, err := st.GetEnvironments(ctx, org.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, env := range envs {
+			if !opts.includesEnv(org.Name, env.Name) {
+				continue
+			}
+			archive.add("Environment", env)
+
+			envCtx := context.WithValue(ctx, types.OrganizationKey, org.Name)
+			envCtx = context.WithValue(envCtx, types.EnvironmentKey, env.Name)
+			if err := exportEnvironment(envCtx, st, opts, archive); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return archive, nil
+}
+
+// exportEnvironment adds the resources of the environment found in ctx to the
+// archive.
+func exportEnvironment(ctx context.Context, st store.Store, opts Options, archive *Archive) error {
+	checks, err := st.GetCheckConfigs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, check := range checks {
+		archive.add("CheckConfig", check)
+	}
+
+	hooks, err := st.GetHookConfigs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, hook := range hooks {
+		archive.add("HookConfig", hook)
+	}
+
+	handlers, err := st.GetHandlers(ctx)
+	if err != nil {
+		return err
+	}
+	for _, handler := range handlers {
+		archive.add("Handler", handler)
+	}
+
+	filters, err := st.GetEventFilters(ctx)
+	if err != nil {
+		return err
+	}
+	for _, filter := range filters {
+		archive.add("EventFilter", filter)
+	}
+
+	mutators, err := st.GetMutators(ctx)
+	if err != nil {
+		return err
+	}
+	for _, mutator := range mutators {
+		archive.add("Mutator", mutator)
+	}
+
+	silenced, err := st.GetSilencedEntries(ctx)
+	if err != nil {
+		return err
+	}
+	for _, entry := range silenced {
+		archive.add("Silenced", entry)
+	}
+
+	entities, err := st.GetEntities(ctx)
+	if err != nil {
+		return err
+	}
+	for _, entity := range entities {
+		archive.add("Entity", entity)
+	}
+
+	if !opts.Events {
+		return nil
+	}
+
+	events, err := st.GetEvents(ctx)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		archive.add("Event", event)
+	}
+
+	return nil
+}
+
+// rank orders the resources of a restore, so the organizations and the
+// environments exist before the resources they contain are restored.
+func rank(resource types.Resource) int {
+	switch resource.(type) {
+	case *types.Organization:
+		return 0
+	case *types.Environment:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// namespace returns the organization and the environment of a resource. Both
+// are empty for the global resources, and the environment is empty for the
+// resources which belong to an organization only.
+func namespace(resource types.Resource) (string, string) {
+	switch r := resource.(type) {
+	case *types.Organization:
+		return r.Name, ""
+	case *types.Environment:
+		return r.Organization, r.Name
+	case *types.Asset:
+		return r.Organization, ""
+	case *types.Event:
+		if r.Entity == nil {
+			return "", ""
+		}
+		return r.Entity.Organization, r.Entity.Environment
+	case types.MultitenantResource:
+		return r.GetOrganization(), r.GetEnvironment()
+	default:
+		return "", ""
+	}
+}
+
+// Restore writes the resources of the archive selected by opts to the store.
+// Existing resources are replaced.
+func Restore(ctx context.Context, st store.Store, archive *Archive, opts Options) error {
+	if err := opts.Validate(); err != nil {
+		return err
+	}
+	if archive.Version != Version {
+		return fmt.Errorf("unsupported archive version %d, expected %d", archive.Version, Version)
+	}
+
+	resources := make([]types.Resource, 0, len(archive.Resources))
+	for _, w := range archive.Resources {
+		if w.Value == nil {
+			continue
+		}
+		resources = append(resources, w.Value)
+	}
+	sort.SliceStable(resources, func(i, j int) bool {
+		return rank(resources[i]) < rank(resources[j])
+	})
+
+	for _, resource := range resources {
+		org, env := namespace(resource)
+		switch {
+		case org == "":
+			if !opts.includesGlobal() {
+				continue
+			}
+		case env == "":
+			if !opts.includesOrg(org) {
+				continue
+			}
+		default:
+			if !opts.includesEnv(org, env) {
+				continue
+			}
+		}
+
+		if err := restore(ctx, st, resource); err != nil {
+			return fmt.Errorf("could not restore %s: %s", resource.URIPath(), err)
+		}
+	}
+
+	return nil
+}
+
+func restore(ctx context.Context, st store.Store, resource types.Resource) error {
+	if err := resource.Validate(); err != nil {
+		return err
+	}
+
+	switch r := resource.(type) {
+	case *types.User:
+		return st.RestoreUser(r)
+	case *types.Role:
+		return st.UpdateRole(ctx, r)
+	case *types.Organization:
+		return st.UpdateOrganization(ctx, r)
+	case *types.Environment:
+		return st.UpdateEnvironment(ctx, r)
+	case *types.Asset:
+		return st.UpdateAsset(ctx, r)
+	case *types.CheckConfig:
+		return st.UpdateCheckConfig(ctx, r)
+	case *types.HookConfig:
+		return st.UpdateHookConfig(ctx, r)
+	case *types.Handler:
+		return st.UpdateHandler(ctx, r)
+	case *types.EventFilter:
+		return st.UpdateEventFilter(ctx, r)
+	case *types.Mutator:
+		return st.UpdateMutator(ctx, r)
+	case *types.Silenced:
+		return st.UpdateSilencedEntry(ctx, r)
+	case *types.Entity:
+		return st.UpdateEntity(ctx, r)
+	case *types.Event:
+		return st.UpdateEvent(ctx, r)
+	default:
+		return fmt.Errorf("resources of type %T can't be restored", resource)
+	}
+}
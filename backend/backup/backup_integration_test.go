@@ -0,0 +1,64 @@
+// +build integration,!race
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/backend/seeds"
+	"github.com/sensu/sensu-go/backend/store/etcd/testutil"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupRestore(t *testing.T) {
+	ctx := context.WithValue(context.Background(), types.OrganizationKey, "default")
+	ctx = context.WithValue(ctx, types.EnvironmentKey, "default")
+
+	source, err := testutil.NewStoreInstance()
+	require.NoError(t, err)
+	defer source.Teardown()
+	require.NoError(t, seeds.SeedInitialData(source))
+
+	user := types.FixtureUser("foo")
+	require.NoError(t, source.CreateUser(user))
+	check := types.FixtureCheckConfig("check1")
+	require.NoError(t, source.UpdateCheckConfig(ctx, check))
+	event := types.FixtureEvent("entity1", "check1")
+	require.NoError(t, source.UpdateEvent(ctx, event))
+
+	archive, err := Export(ctx, source, Options{})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, archive.Write(&buf))
+
+	// Restore the archive to a fresh cluster
+	target, err := testutil.NewStoreInstance()
+	require.NoError(t, err)
+	defer target.Teardown()
+
+	archive, err = Read(&buf)
+	require.NoError(t, err)
+	require.NoError(t, Restore(ctx, target, archive, Options{}))
+
+	env, err := target.GetEnvironment(ctx, "default", "default")
+	require.NoError(t, err)
+	assert.NotNil(t, env)
+
+	result, err := target.GetCheckConfigByName(ctx, "check1")
+	require.NoError(t, err)
+	assert.Equal(t, check, result)
+
+	// The passwords are restored as is
+	_, err = target.AuthenticateUser(ctx, "foo", "P@ssw0rd!")
+	assert.NoError(t, err)
+
+	// The events were left out of the backup
+	events, err := target.GetEvents(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
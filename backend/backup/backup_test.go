@@ -0,0 +1,134 @@
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveWriteRead(t *testing.T) {
+	archive := &Archive{Version: Version, CreatedAt: 42}
+	archive.add("Organization", types.FixtureOrganization("acme"))
+	archive.add("CheckConfig", types.FixtureCheckConfig("check1"))
+	archive.add("CheckConfig", types.FixtureCheckConfig("check2"))
+
+	var buf bytes.Buffer
+	require.NoError(t, archive.Write(&buf))
+
+	result, err := Read(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, archive, result)
+
+	// Every resource is decoded into its own value
+	assert.Equal(t, "check1", result.Resources[1].Value.(*types.CheckConfig).Name)
+	assert.Equal(t, "check2", result.Resources[2].Value.(*types.CheckConfig).Name)
+}
+
+func TestReadInvalidArchive(t *testing.T) {
+	_, err := Read(bytes.NewBufferString(`{"version": 1}`))
+	assert.Error(t, err)
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err = gz.Write([]byte(`{"version": 1000, "resources": []}`))
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	_, err = Read(&buf)
+	assert.Error(t, err)
+}
+
+func TestOptionsValidate(t *testing.T) {
+	assert.NoError(t, Options{}.Validate())
+	assert.NoError(t, Options{Organization: "acme", Environment: "dev"}.Validate())
+	assert.Error(t, Options{Environment: "dev"}.Validate())
+}
+
+func TestExport(t *testing.T) {
+	st := &mockstore.MockStore{}
+	check := types.FixtureCheckConfig("check1")
+	event := types.FixtureEvent("entity1", "check1")
+
+	st.On("GetAllUsers").Return([]*types.User{types.FixtureUser("foo")}, nil)
+	st.On("GetRoles", mock.Anything).Return([]*types.Role{types.FixtureRole("admin", "*", "*")}, nil)
+	st.On("GetOrganizations", mock.Anything).Return([]*types.Organization{types.FixtureOrganization("default")}, nil)
+	st.On("GetAssets", mock.Anything).Return([]*types.Asset{}, nil)
+	st.On("GetEnvironments", mock.Anything, "default").Return([]*types.Environment{types.FixtureEnvironment("default")}, nil)
+	st.On("GetCheckConfigs", mock.Anything).Return([]*types.CheckConfig{check}, nil)
+	st.On("GetHookConfigs", mock.Anything).Return([]*types.HookConfig{}, nil)
+	st.On("GetHandlers", mock.Anything).Return([]*types.Handler{}, nil)
+	st.On("GetEventFilters", mock.Anything).Return([]*types.EventFilter{}, nil)
+	st.On("GetMutators", mock.Anything).Return([]*types.Mutator{}, nil)
+	st.On("GetSilencedEntries", mock.Anything).Return([]*types.Silenced{}, nil)
+	st.On("GetEntities", mock.Anything).Return([]*types.Entity{}, nil)
+	st.On("GetEvents", mock.Anything).Return([]*types.Event{event}, nil)
+
+	archive, err := Export(context.Background(), st, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, Version, archive.Version)
+
+	var typs []string
+	for _, w := range archive.Resources {
+		typs = append(typs, w.Type)
+	}
+	assert.Equal(t, []string{"User", "Role", "Organization", "Environment", "CheckConfig"}, typs)
+	st.AssertNotCalled(t, "GetEvents", mock.Anything)
+
+	archive, err = Export(context.Background(), st, Options{Organization: "default", Events: true})
+	require.NoError(t, err)
+
+	typs = nil
+	for _, w := range archive.Resources {
+		typs = append(typs, w.Type)
+	}
+	assert.Equal(t, []string{"Organization", "Environment", "CheckConfig", "Event"}, typs)
+}
+
+func TestRestore(t *testing.T) {
+	dev := types.FixtureEnvironment("dev")
+	dev.Organization = "acme"
+	prod := types.FixtureEnvironment("prod")
+	prod.Organization = "acme"
+	devCheck := types.FixtureCheckConfig("check1")
+	devCheck.Organization = "acme"
+	devCheck.Environment = "dev"
+	prodCheck := types.FixtureCheckConfig("check1")
+	prodCheck.Organization = "acme"
+	prodCheck.Environment = "prod"
+
+	// The check is out of order and must be restored after its environment
+	archive := &Archive{Version: Version}
+	archive.add("CheckConfig", devCheck)
+	archive.add("CheckConfig", prodCheck)
+	archive.add("User", types.FixtureUser("foo"))
+	archive.add("Organization", types.FixtureOrganization("acme"))
+	archive.add("Environment", dev)
+	archive.add("Environment", prod)
+
+	st := &mockstore.MockStore{}
+	var calls []string
+	st.On("UpdateOrganization", mock.Anything, mock.Anything).Return(nil).Run(func(mock.Arguments) {
+		calls = append(calls, "organization")
+	})
+	st.On("UpdateEnvironment", mock.Anything, dev).Return(nil).Run(func(mock.Arguments) {
+		calls = append(calls, "environment")
+	})
+	st.On("UpdateCheckConfig", mock.Anything, devCheck).Return(nil).Run(func(mock.Arguments) {
+		calls = append(calls, "check")
+	})
+
+	opts := Options{Organization: "acme", Environment: "dev"}
+	require.NoError(t, Restore(context.Background(), st, archive, opts))
+	assert.Equal(t, []string{"organization", "environment", "check"}, calls)
+	st.AssertNotCalled(t, "RestoreUser", mock.Anything)
+
+	archive.Version = Version + 1
+	assert.Error(t, Restore(context.Background(), st, archive, opts))
+}
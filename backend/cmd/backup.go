@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend"
+	"github.com/sensu/sensu-go/backend/backup"
+	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/sensu/sensu-go/backend/seeds"
+	etcdstore "github.com/sensu/sensu-go/backend/store/etcd"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// Backup flag constants
+	flagBackupFile         = "file"
+	flagBackupOrganization = "organization"
+	flagBackupEnvironment  = "environment"
+	flagBackupEvents       = "events"
+)
+
+func newBackupCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "write an archive of the resources of the sensu store",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := backupOptions(cmd)
+			if err != nil {
+				return err
+			}
+			opts.Events, _ = cmd.Flags().GetBool(flagBackupEvents)
+
+			client, err := backupClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			archive, err := backup.Export(context.Background(), etcdstore.NewStore(client, ""), opts)
+			if err != nil {
+				return err
+			}
+
+			var out io.Writer = os.Stdout
+			if file, _ := cmd.Flags().GetString(flagBackupFile); file != "" {
+				f, err := os.Create(file)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+
+			if err := archive.Write(out); err != nil {
+				return err
+			}
+			logger.WithField("resources", len(archive.Resources)).Info("backup completed")
+			return nil
+		},
+	}
+
+	addBackupFlags(cmd, "path to the archive to write (defaults to stdout)")
+	cmd.Flags().Bool(flagBackupEvents, false, "include the events in the archive")
+
+	return cmd
+}
+
+func newRestoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "restore the resources of an archive to the sensu store",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts, err := backupOptions(cmd)
+			if err != nil {
+				return err
+			}
+
+			var in io.Reader = os.Stdin
+			if file, _ := cmd.Flags().GetString(flagBackupFile); file != "" {
+				f, err := os.Open(file)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				in = f
+			}
+
+			archive, err := backup.Read(in)
+			if err != nil {
+				return err
+			}
+
+			client, err := backupClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			// Seed a fresh cluster first, so the backend doesn't attempt to
+			// seed it over the restored resources once started
+			store := etcdstore.NewStore(client, "")
+			if err := seeds.SeedInitialData(store); err != nil {
+				return err
+			}
+
+			if err := backup.Restore(context.Background(), store, archive, opts); err != nil {
+				return err
+			}
+			logger.WithField("resources", len(archive.Resources)).Info("restore completed")
+			return nil
+		},
+	}
+
+	addBackupFlags(cmd, "path to the archive to read (defaults to stdin)")
+
+	return cmd
+}
+
+func addBackupFlags(cmd *cobra.Command, fileUsage string) {
+	cmd.Flags().StringP(flagBackupFile, "f", "", fileUsage)
+	cmd.Flags().String(flagBackupOrganization, "", "limit the resources to this organization")
+	cmd.Flags().String(flagBackupEnvironment, "", "limit the resources to this environment of the organization")
+
+	cmd.Flags().StringSlice(flagStoreEndpoints, []string{backend.DefaultEtcdClientURL}, "client URLs of the etcd cluster of the backend")
+	cmd.Flags().String(flagStoreCertFile, "", "etcd client certificate")
+	cmd.Flags().String(flagStoreKeyFile, "", "etcd client certificate key")
+	cmd.Flags().String(flagStoreTrustedCAFile, "", "etcd certificate authority")
+	cmd.Flags().String(flagStoreKeyPrefix, "", "prefix of the keys of the backend in the etcd cluster")
+}
+
+func backupOptions(cmd *cobra.Command) (backup.Options, error) {
+	org, _ := cmd.Flags().GetString(flagBackupOrganization)
+	env, _ := cmd.Flags().GetString(flagBackupEnvironment)
+	opts := backup.Options{Organization: org, Environment: env}
+	return opts, opts.Validate()
+}
+
+func backupClient(cmd *cobra.Command) (*clientv3.Client, error) {
+	endpoints, _ := cmd.Flags().GetStringSlice(flagStoreEndpoints)
+	certFile, _ := cmd.Flags().GetString(flagStoreCertFile)
+	keyFile, _ := cmd.Flags().GetString(flagStoreKeyFile)
+	trustedCAFile, _ := cmd.Flags().GetString(flagStoreTrustedCAFile)
+	keyPrefix, _ := cmd.Flags().GetString(flagStoreKeyPrefix)
+
+	cfg := &etcd.ClientConfig{
+		Endpoints: endpoints,
+		KeyPrefix: keyPrefix,
+	}
+	if certFile != "" || keyFile != "" || trustedCAFile != "" {
+		cfg.TLSInfo = &etcd.TLSInfo{
+			CertFile:      certFile,
+			KeyFile:       keyFile,
+			TrustedCAFile: trustedCAFile,
+		}
+	}
+
+	client, err := etcd.NewExternalClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to etcd: %s", err)
+	}
+	return client, nil
+}
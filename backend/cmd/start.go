@@ -13,6 +13,7 @@ import (
 	_ "net/http/pprof"
 
 	"github.com/sensu/sensu-go/backend"
+	"github.com/sensu/sensu-go/backend/pipelined"
 	"github.com/sensu/sensu-go/types"
 	"github.com/sensu/sensu-go/util/path"
 	"github.com/sensu/sensu-go/version"
@@ -28,15 +29,20 @@ const (
 	flagAgentPort             = "agent-port"
 	flagAPIHost               = "api-host"
 	flagAPIPort               = "api-port"
+	flagAssetRequireSignature = "asset-require-signature"
+	flagAssetTrustedKeys      = "asset-trusted-keys"
 	flagDashboardHost         = "dashboard-host"
 	flagDashboardPort         = "dashboard-port"
 	flagDeregistrationHandler = "deregistration-handler"
+	flagPipelineWorkers       = "pipeline-workers"
 	flagStateDir              = "state-dir"
+	flagCacheDir              = "cache-dir"
 	flagCertFile              = "cert-file"
 	flagKeyFile               = "key-file"
 	flagTrustedCAFile         = "trusted-ca-file"
 	flagInsecureSkipTLSVerify = "insecure-skip-tls-verify"
 	flagDebug                 = "debug"
+	flagDryRun                = "dry-run"
 
 	// Etcd flag constants
 	flagStoreClientURL               = "listen-client-urls"
@@ -46,11 +52,20 @@ const (
 	flagStoreInitialClusterState     = "initial-cluster-state"
 	flagStoreInitialClusterToken     = "initial-cluster-token"
 	flagStoreNodeName                = "name"
+
+	// External etcd flag constants
+	flagStoreEndpoints     = "etcd-endpoints"
+	flagStoreCertFile      = "etcd-cert-file"
+	flagStoreKeyFile       = "etcd-key-file"
+	flagStoreTrustedCAFile = "etcd-trusted-ca-file"
+	flagStoreKeyPrefix     = "etcd-key-prefix"
 )
 
 func init() {
 	rootCmd.AddCommand(newVersionCommand())
 	rootCmd.AddCommand(newStartCommand())
+	rootCmd.AddCommand(newBackupCommand())
+	rootCmd.AddCommand(newRestoreCommand())
 }
 
 func newVersionCommand() *cobra.Command {
@@ -89,7 +104,11 @@ func newStartCommand() *cobra.Command {
 				DashboardHost:         viper.GetString(flagDashboardHost),
 				DashboardPort:         viper.GetInt(flagDashboardPort),
 				DeregistrationHandler: viper.GetString(flagDeregistrationHandler),
+				PipelineWorkers:       viper.GetInt(flagPipelineWorkers),
+				AssetTrustedKeys:      viper.GetStringSlice(flagAssetTrustedKeys),
+				AssetRequireSignature: viper.GetBool(flagAssetRequireSignature),
 				StateDir:              viper.GetString(flagStateDir),
+				CacheDir:              viper.GetString(flagCacheDir),
 
 				EtcdListenClientURL:         viper.GetString(flagStoreClientURL),
 				EtcdListenPeerURL:           viper.GetString(flagStorePeerURL),
@@ -98,6 +117,12 @@ func newStartCommand() *cobra.Command {
 				EtcdInitialAdvertisePeerURL: viper.GetString(flagStoreInitialAdvertisePeerURL),
 				EtcdInitialClusterToken:     viper.GetString(flagStoreInitialClusterToken),
 				EtcdName:                    viper.GetString(flagStoreNodeName),
+
+				EtcdEndpoints:     viper.GetStringSlice(flagStoreEndpoints),
+				EtcdCertFile:      viper.GetString(flagStoreCertFile),
+				EtcdKeyFile:       viper.GetString(flagStoreKeyFile),
+				EtcdTrustedCAFile: viper.GetString(flagStoreTrustedCAFile),
+				EtcdKeyPrefix:     viper.GetString(flagStoreKeyPrefix),
 			}
 
 			certFile := viper.GetString(flagCertFile)
@@ -142,7 +167,7 @@ func newStartCommand() *cobra.Command {
 			}()
 
 			if len(args) == 1 && args[0] == "migration" {
-				return sensuBackend.Migration()
+				return sensuBackend.Migration(viper.GetBool(flagDryRun))
 			}
 
 			if viper.GetBool(flagDebug) {
@@ -182,7 +207,11 @@ func newStartCommand() *cobra.Command {
 	viper.SetDefault(flagDashboardHost, "[::]")
 	viper.SetDefault(flagDashboardPort, 3000)
 	viper.SetDefault(flagDeregistrationHandler, "")
+	viper.SetDefault(flagPipelineWorkers, pipelined.PipelineCount)
+	viper.SetDefault(flagAssetTrustedKeys, []string{})
+	viper.SetDefault(flagAssetRequireSignature, false)
 	viper.SetDefault(flagStateDir, path.SystemDataDir())
+	viper.SetDefault(flagCacheDir, path.SystemCacheDir("sensu-backend"))
 	viper.SetDefault(flagCertFile, "")
 	viper.SetDefault(flagKeyFile, "")
 	viper.SetDefault(flagTrustedCAFile, "")
@@ -197,6 +226,13 @@ func newStartCommand() *cobra.Command {
 	viper.SetDefault(flagStoreInitialClusterToken, "")
 	viper.SetDefault(flagStoreNodeName, "")
 
+	// External etcd defaults
+	viper.SetDefault(flagStoreEndpoints, []string{})
+	viper.SetDefault(flagStoreCertFile, "")
+	viper.SetDefault(flagStoreKeyFile, "")
+	viper.SetDefault(flagStoreTrustedCAFile, "")
+	viper.SetDefault(flagStoreKeyPrefix, "")
+
 	// Merge in config flag set so that it appears in command usage
 	cmd.Flags().AddFlagSet(configFlagSet)
 
@@ -208,12 +244,17 @@ func newStartCommand() *cobra.Command {
 	cmd.Flags().String(flagDashboardHost, viper.GetString(flagDashboardHost), "dashboard listener host")
 	cmd.Flags().Int(flagDashboardPort, viper.GetInt(flagDashboardPort), "dashboard listener port")
 	cmd.Flags().String(flagDeregistrationHandler, viper.GetString(flagDeregistrationHandler), "default deregistration handler")
+	cmd.Flags().Int(flagPipelineWorkers, viper.GetInt(flagPipelineWorkers), "number of concurrent event pipelines")
+	cmd.Flags().StringSlice(flagAssetTrustedKeys, viper.GetStringSlice(flagAssetTrustedKeys), "path to an ASCII armored keyring of the keys trusted to sign runtime assets (to specify multiple keyrings use this flag multiple times)")
+	cmd.Flags().Bool(flagAssetRequireSignature, viper.GetBool(flagAssetRequireSignature), "reject the runtime assets that are not signed by a trusted key")
 	cmd.Flags().StringP(flagStateDir, "d", viper.GetString(flagStateDir), "path to sensu state storage")
+	cmd.Flags().String(flagCacheDir, viper.GetString(flagCacheDir), "path to store cached data")
 	cmd.Flags().String(flagCertFile, viper.GetString(flagCertFile), "tls certificate")
 	cmd.Flags().String(flagKeyFile, viper.GetString(flagKeyFile), "tls certificate key")
 	cmd.Flags().String(flagTrustedCAFile, viper.GetString(flagTrustedCAFile), "tls certificate authority")
 	cmd.Flags().Bool(flagInsecureSkipTLSVerify, viper.GetBool(flagInsecureSkipTLSVerify), "skip ssl verification")
 	cmd.Flags().Bool(flagDebug, false, "enable debugging and profiling features")
+	cmd.Flags().Bool(flagDryRun, false, "with the migration argument, only log the changes the pending store migrations would make")
 
 	// Etcd flags
 	cmd.Flags().String(flagStoreClientURL, viper.GetString(flagStoreClientURL), "store listen client URL")
@@ -224,6 +265,13 @@ func newStartCommand() *cobra.Command {
 	cmd.Flags().String(flagStoreInitialClusterToken, viper.GetString(flagStoreInitialClusterToken), "store initial cluster token")
 	cmd.Flags().String(flagStoreNodeName, viper.GetString(flagStoreNodeName), "store cluster member node name")
 
+	// External etcd flags
+	cmd.Flags().StringSlice(flagStoreEndpoints, viper.GetStringSlice(flagStoreEndpoints), "client URLs of an external etcd cluster to use instead of the embedded store (to specify multiple URLs use this flag multiple times)")
+	cmd.Flags().String(flagStoreCertFile, viper.GetString(flagStoreCertFile), "external etcd client certificate")
+	cmd.Flags().String(flagStoreKeyFile, viper.GetString(flagStoreKeyFile), "external etcd client certificate key")
+	cmd.Flags().String(flagStoreTrustedCAFile, viper.GetString(flagStoreTrustedCAFile), "external etcd certificate authority")
+	cmd.Flags().String(flagStoreKeyPrefix, viper.GetString(flagStoreKeyPrefix), "prefix of the keys of the backend in the external etcd cluster")
+
 	// Load the configuration file but only error out if flagConfigFile is used
 	if err := viper.ReadInConfig(); err != nil && configFile != "" {
 		setupErr = err
@@ -0,0 +1,82 @@
+package etcd
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/namespace"
+	"github.com/coreos/etcd/pkg/transport"
+	grpcprom "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"google.golang.org/grpc"
+)
+
+// ClientConfig is a configuration for a client of an external etcd cluster,
+// used instead of the embedded etcd.
+type ClientConfig struct {
+	// Endpoints are the client URLs of the members of the cluster
+	Endpoints []string
+
+	// TLSInfo holds the client certificate, key and trusted CA, if any
+	TLSInfo *TLSInfo
+
+	// KeyPrefix is prepended to every key read or written with the client, so
+	// the cluster can be shared with other applications
+	KeyPrefix string
+}
+
+// NewExternalClient returns a new etcd v3 client of an external cluster.
+// Clients must be closed after use.
+func NewExternalClient(config *ClientConfig) (*clientv3.Client, error) {
+	if len(config.Endpoints) == 0 {
+		return nil, errors.New("no etcd endpoints")
+	}
+
+	var (
+		tlsCfg *tls.Config
+		err    error
+	)
+	if config.TLSInfo != nil {
+		tlsCfg, err = (transport.TLSInfo)(*config.TLSInfo).ClientConfig()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:   config.Endpoints,
+		DialTimeout: 5 * time.Second,
+		TLS:         tlsCfg,
+		DialOptions: []grpc.DialOption{
+			grpc.WithUnaryInterceptor(grpcprom.UnaryClientInterceptor),
+			grpc.WithStreamInterceptor(grpcprom.StreamClientInterceptor),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if config.KeyPrefix != "" {
+		cli.KV = namespace.NewKV(cli.KV, config.KeyPrefix)
+		cli.Watcher = namespace.NewWatcher(cli.Watcher, config.KeyPrefix)
+		cli.Lease = namespace.NewLease(cli.Lease, config.KeyPrefix)
+	}
+
+	return cli, nil
+}
+
+// ClientHealthy returns true if one of the endpoints of the client is healthy,
+// false otherwise.
+func ClientHealthy(client *clientv3.Client) bool {
+	for _, endpoint := range client.Endpoints() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		_, err := client.Status(ctx, endpoint)
+		cancel()
+		if err == nil {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+// +build integration,!race
+
+package etcd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewExternalClient(t *testing.T) {
+	_, err := NewExternalClient(&ClientConfig{})
+	assert.Error(t, err)
+
+	e, cleanup := NewTestEtcd(t)
+	defer cleanup()
+
+	client, err := NewExternalClient(&ClientConfig{
+		Endpoints: []string{e.LoopbackURL()},
+		KeyPrefix: "/prefix",
+	})
+	require.NoError(t, err)
+	defer client.Close()
+	assert.True(t, ClientHealthy(client))
+
+	_, err = client.Put(context.Background(), "/key", "value")
+	require.NoError(t, err)
+
+	// The keys are prefixed in the cluster
+	raw, err := e.NewClient()
+	require.NoError(t, err)
+	defer raw.Close()
+
+	resp, err := raw.Get(context.Background(), "/prefix/key")
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	assert.Equal(t, "value", string(resp.Kvs[0].Value))
+
+	resp, err = client.Get(context.Background(), "/", clientv3.WithPrefix())
+	require.NoError(t, err)
+	require.Len(t, resp.Kvs, 1)
+	assert.Equal(t, "/key", string(resp.Kvs[0].Key))
+}
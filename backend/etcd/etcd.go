@@ -22,6 +22,8 @@ import (
 	"github.com/coreos/etcd/embed"
 	"github.com/coreos/etcd/pkg/transport"
 	"github.com/coreos/pkg/capnslog"
+	grpcprom "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/grpclog"
 )
 
@@ -47,6 +49,9 @@ const (
 
 func init() {
 	clientv3.SetLogger(grpclog.NewLoggerV2(ioutil.Discard, ioutil.Discard, ioutil.Discard))
+
+	// Report the latency of the etcd requests, as grpc_client_handling_seconds
+	grpcprom.EnableClientHandlingTimeHistogram()
 }
 
 // Config is a configuration for the embedded etcd
@@ -260,6 +265,10 @@ func (e *Etcd) NewClient() (*clientv3.Client, error) {
 		Endpoints:   []string{e.loopbackURL},
 		DialTimeout: 5 * time.Second,
 		TLS:         tlsCfg,
+		DialOptions: []grpc.DialOption{
+			grpc.WithUnaryInterceptor(grpcprom.UnaryClientInterceptor),
+			grpc.WithStreamInterceptor(grpcprom.StreamClientInterceptor),
+		},
 	})
 	if err != nil {
 		return nil, err
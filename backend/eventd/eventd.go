@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/coreos/etcd/clientv3"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/monitor"
 	"github.com/sensu/sensu-go/backend/store"
@@ -18,26 +20,40 @@ const (
 	// ComponentName identifies Eventd as the component/daemon implemented in this
 	// package.
 	ComponentName = "eventd"
+
+	// monitorName is the name under which the TTL deadlines of the checks are
+	// kept.
+	monitorName = "ttls"
 )
 
 var (
 	logger = logrus.WithFields(logrus.Fields{
 		"component": ComponentName,
 	})
+
+	eventsProcessed = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sensu_eventd_events_processed_total",
+			Help: "Number of events processed by eventd",
+		},
+		[]string{"result"},
+	)
 )
 
+func init() {
+	prometheus.MustRegister(eventsProcessed)
+}
+
 // Eventd handles incoming sensu events and stores them in etcd.
 type Eventd struct {
-	store          store.Store
-	bus            messaging.MessageBus
-	handlerCount   int
-	monitorFactory monitor.FactoryFunc
+	store        store.Store
+	bus          messaging.MessageBus
+	handlerCount int
+	monitors     monitor.Supervisor
 
 	eventChan    chan interface{}
 	subscription messaging.Subscription
 	errChan      chan error
-	monitors     map[string]monitor.Interface
-	mu           *sync.Mutex
 	shutdownChan chan struct{}
 	wg           *sync.WaitGroup
 }
@@ -47,8 +63,9 @@ type Option func(*Eventd) error
 
 // Config configures Eventd
 type Config struct {
-	Store store.Store
-	Bus   messaging.MessageBus
+	Store  store.Store
+	Bus    messaging.MessageBus
+	Client *clientv3.Client
 }
 
 // New creates a new Eventd.
@@ -57,16 +74,12 @@ func New(c Config, opts ...Option) (*Eventd, error) {
 		store:        c.Store,
 		bus:          c.Bus,
 		handlerCount: 10,
-		monitorFactory: func(entity *types.Entity, event *types.Event, t time.Duration, u monitor.UpdateHandler, f monitor.FailureHandler) monitor.Interface {
-			return monitor.New(entity, event, t, u, f)
-		},
 		errChan:      make(chan error, 1),
 		shutdownChan: make(chan struct{}, 1),
 		eventChan:    make(chan interface{}, 100),
 		wg:           &sync.WaitGroup{},
-		mu:           &sync.Mutex{},
-		monitors:     make(map[string]monitor.Interface),
 	}
+	e.monitors = monitor.NewEtcdSupervisor(c.Client, monitorName, e)
 	for _, o := range opts {
 		if err := o(e); err != nil {
 			return nil, err
@@ -82,6 +95,10 @@ func (e *Eventd) Receiver() chan<- interface{} {
 
 // Start eventd.
 func (e *Eventd) Start() error {
+	if err := e.monitors.Start(); err != nil {
+		return err
+	}
+
 	e.wg.Add(e.handlerCount)
 	sub, err := e.bus.Subscribe(messaging.TopicEventRaw, "eventd", e)
 	e.subscription = sub
@@ -137,11 +154,14 @@ func (e *Eventd) startHandlers() {
 	}
 }
 
-func (e *Eventd) handleMessage(msg interface{}) error {
-	var (
-		mon monitor.Interface
-		ok  bool
-	)
+func (e *Eventd) handleMessage(msg interface{}) (err error) {
+	defer func() {
+		result := "success"
+		if err != nil {
+			result = "error"
+		}
+		eventsProcessed.WithLabelValues(result).Inc()
+	}()
 
 	event, ok := msg.(*types.Event)
 	if !ok {
@@ -153,6 +173,12 @@ func (e *Eventd) handleMessage(msg interface{}) error {
 		return err
 	}
 
+	// Metric events, which carry no check result, have no history to maintain
+	// and are passed through to the pipeline as is
+	if !event.HasCheck() {
+		return e.bus.Publish(messaging.TopicEvent, event)
+	}
+
 	ctx := context.WithValue(context.Background(), types.OrganizationKey, event.Entity.Organization)
 	ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
 
@@ -197,22 +223,14 @@ func (e *Eventd) handleMessage(msg interface{}) error {
 		return err
 	}
 
-	entity := event.Entity
-
 	if event.Check.Ttl > 0 && !event.Check.RoundRobin {
-		// create a monitor for the event's entity if it doesn't exist in the
-		// monitor map
-		// only monitor if there is a check TTL and the check is not a
-		// round robin check.
-		e.mu.Lock()
-		mon, ok = e.monitors[entity.ID]
-		if !ok || mon.IsStopped() {
-			timeout := time.Duration(event.Check.Ttl) * time.Second
-			mon = e.monitorFactory(entity, event, timeout, e, e)
-			e.monitors[entity.ID] = mon
+		// push back the deadline of the next result of the check, only
+		// monitored if there is a check TTL and the check is not a round robin
+		// check.
+		timeout := time.Duration(event.Check.Ttl) * time.Second
+		if err := e.monitors.Monitor(ctx, event.Entity, event, timeout); err != nil {
+			return err
 		}
-		e.mu.Unlock()
-		return mon.HandleUpdate(event)
 	}
 
 	return e.bus.Publish(messaging.TopicEvent, event)
@@ -235,21 +253,9 @@ func updateOccurrences(event *types.Event) {
 	}
 }
 
-// HandleUpdate updates the event in the store and publishes it to TopicEvent.
-func (e *Eventd) HandleUpdate(event *types.Event) error {
-	ctx := context.WithValue(context.Background(), types.OrganizationKey, event.Entity.Organization)
-	ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
-
-	err := e.store.UpdateEvent(ctx, event)
-	if err != nil {
-		return err
-	}
-
-	return e.bus.Publish(messaging.TopicEvent, event)
-}
-
 // HandleFailure creates a check event with a warn status and publishes it to
-// TopicEvent.
+// TopicEvent. It is called, by the leader of the cluster, once the TTL deadline
+// of the check expires.
 func (e *Eventd) HandleFailure(entity *types.Entity, event *types.Event) error {
 	ctx := context.WithValue(context.Background(), types.OrganizationKey, entity.Organization)
 	ctx = context.WithValue(ctx, types.EnvironmentKey, entity.Environment)
@@ -258,6 +264,10 @@ func (e *Eventd) HandleFailure(entity *types.Entity, event *types.Event) error {
 	if err != nil {
 		return err
 	}
+	// the event was deleted since
+	if failedCheckEvent == nil {
+		return nil
+	}
 	err = e.store.UpdateEvent(ctx, failedCheckEvent)
 	if err != nil {
 		return err
@@ -270,7 +280,7 @@ func (e *Eventd) createFailedCheckEvent(ctx context.Context, event *types.Event)
 	lastCheckResult, err := e.store.GetEventByEntityCheck(
 		ctx, event.Entity.ID, event.Check.Name,
 	)
-	if err != nil {
+	if err != nil || lastCheckResult == nil {
 		return nil, err
 	}
 
@@ -292,7 +302,7 @@ func (e *Eventd) Stop() error {
 	close(e.eventChan)
 	close(e.shutdownChan)
 	e.wg.Wait()
-	return nil
+	return e.monitors.Stop()
 }
 
 // Status returns an error if eventd is unhealthy.
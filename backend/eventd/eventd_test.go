@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/sensu/sensu-go/backend/messaging"
-	"github.com/sensu/sensu-go/backend/monitor"
 	"github.com/sensu/sensu-go/testing/mockmonitor"
 	"github.com/sensu/sensu-go/testing/mockring"
 	"github.com/sensu/sensu-go/testing/mockstore"
@@ -27,6 +26,7 @@ func TestEventHandling(t *testing.T) {
 	e, err := New(Config{Store: mockStore, Bus: bus})
 	require.NoError(t, err)
 	e.handlerCount = 5
+	e.monitors = &mockmonitor.MockSupervisor{}
 
 	require.NoError(t, e.Start())
 
@@ -75,6 +75,28 @@ func TestEventHandling(t *testing.T) {
 	assert.Equal(t, event.Timestamp, event.Check.LastOK)
 }
 
+func TestMetricsEventHandling(t *testing.T) {
+	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+		RingGetter: &mockring.Getter{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+
+	mockStore := &mockstore.MockStore{}
+	e, err := New(Config{Store: mockStore, Bus: bus})
+	require.NoError(t, err)
+
+	event := &types.Event{
+		Entity:    types.FixtureEntity("entity"),
+		Metrics:   types.FixtureMetrics(),
+		Timestamp: time.Now().Unix(),
+	}
+
+	// Metrics events are not stored, they only go through the pipeline
+	require.NoError(t, e.handleMessage(event))
+	mockStore.AssertNotCalled(t, "UpdateEvent", mock.Anything)
+}
+
 func TestEventMonitor(t *testing.T) {
 	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
 		RingGetter: &mockring.Getter{},
@@ -87,11 +109,9 @@ func TestEventMonitor(t *testing.T) {
 	require.NoError(t, err)
 	e.handlerCount = 5
 
-	mon := &mockmonitor.MockMonitor{}
-	mon.On("HandleUpdate", mock.Anything).Return(errors.New("error handling update"))
-	e.monitorFactory = func(*types.Entity, *types.Event, time.Duration, monitor.UpdateHandler, monitor.FailureHandler) monitor.Interface {
-		return mon
-	}
+	supervisor := &mockmonitor.MockSupervisor{}
+	supervisor.On("Monitor", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("error monitoring check"))
+	e.monitors = supervisor
 
 	require.NoError(t, e.Start())
 
@@ -125,7 +145,7 @@ func TestEventMonitor(t *testing.T) {
 	err = e.Stop()
 	assert.NoError(t, err)
 
-	mon.AssertCalled(t, "HandleUpdate", event)
+	supervisor.AssertCalled(t, "Monitor", mock.Anything, event.Entity, event, 90*time.Second)
 	// Make sure the event has been marked with the proper state
 	assert.Equal(t, types.EventPassingState, event.Check.State)
 }
@@ -5,6 +5,7 @@ package eventd
 import (
 	"testing"
 
+	"github.com/sensu/sensu-go/backend/leader"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/seeds"
 	"github.com/sensu/sensu-go/backend/store/etcd/testutil"
@@ -23,6 +24,9 @@ func (r testReceiver) Receiver() chan<- interface{} {
 }
 
 func TestEventdMonitor(t *testing.T) {
+	// The deadlines are handled by this backend
+	leader.Override()
+
 	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
 		RingGetter: &mockring.Getter{},
 	})
@@ -51,7 +55,7 @@ func TestEventdMonitor(t *testing.T) {
 		assert.FailNow(t, err.Error())
 	}
 
-	e, err := New(Config{Store: store, Bus: bus})
+	e, err := New(Config{Store: store, Bus: bus, Client: store.GetClient()})
 	require.NoError(t, err)
 
 	if err := e.Start(); err != nil {
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/sensu/sensu-go/backend/leader"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/seeds"
 	"github.com/sensu/sensu-go/backend/store/etcd/testutil"
@@ -16,6 +17,9 @@ import (
 )
 
 func TestKeepaliveMonitor(t *testing.T) {
+	// The deadlines are handled by this backend
+	leader.Override()
+
 	bus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
 		RingGetter: &mockring.Getter{},
 	})
@@ -45,7 +49,7 @@ func TestKeepaliveMonitor(t *testing.T) {
 		assert.FailNow(t, err.Error())
 	}
 
-	k, err := New(Config{Store: store, Bus: bus})
+	k, err := New(Config{Store: store, Bus: bus, Client: store.GetClient()})
 	require.NoError(t, err)
 
 	if err := k.Start(); err != nil {
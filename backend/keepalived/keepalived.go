@@ -2,9 +2,13 @@ package keepalived
 
 import (
 	"context"
+	"fmt"
+	"path"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/coreos/etcd/clientv3"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/monitor"
 	"github.com/sensu/sensu-go/backend/store"
@@ -35,6 +39,19 @@ const (
 	// RegistrationHandlerName is the name of the handler that is executed when
 	// a registration event is passed to pipelined.
 	RegistrationHandlerName = "registration"
+
+	// InventoryCheckName is the name of the check that is created when an
+	// entity sends a keepalive with a system inventory different from the one
+	// in the store.
+	InventoryCheckName = "inventory"
+
+	// InventoryHandlerName is the name of the handler that is executed when an
+	// inventory event is passed to pipelined.
+	InventoryHandlerName = "inventory"
+
+	// monitorName is the name under which the keepalive deadlines of the
+	// entities are kept.
+	monitorName = "keepalives"
 )
 
 // Keepalived is responsible for monitoring keepalive events and recording
@@ -44,13 +61,37 @@ type Keepalived struct {
 	handlerCount          int
 	store                 store.Store
 	deregistrationHandler string
-	monitorFactory        monitor.FactoryFunc
-	mu                    *sync.Mutex
-	monitors              map[string]monitor.Interface
+	monitors              monitor.Supervisor
 	wg                    *sync.WaitGroup
 	keepaliveChan         chan interface{}
 	subscription          messaging.Subscription
+	deregistrations       deregistrationReceiver
+	deregSubscription     messaging.Subscription
 	errChan               chan error
+
+	// deregistered holds the deregistrations of the ephemeral entities, so the
+	// keepalives their agents sent before are dropped rather than monitored
+	deregistered   map[string]deregistration
+	deregisteredMu sync.RWMutex
+}
+
+// deregistration records the time an ephemeral entity was deregistered at,
+// according to its agent, and the time the backend received it at
+type deregistration struct {
+	timestamp int64
+	received  time.Time
+}
+
+// deregistrationTTL is how long the deregistration of an entity is remembered
+const deregistrationTTL = time.Hour
+
+// deregistrationReceiver receives the deregistrations of the agents shutting
+// down, apart from their keepalives.
+type deregistrationReceiver chan interface{}
+
+// Receiver returns the deregistration receiver channel.
+func (d deregistrationReceiver) Receiver() chan<- interface{} {
+	return d
 }
 
 // Option is a functional option.
@@ -60,24 +101,23 @@ type Option func(*Keepalived) error
 type Config struct {
 	Store                 store.Store
 	Bus                   messaging.MessageBus
+	Client                *clientv3.Client
 	DeregistrationHandler string
 }
 
 // New creates a new Keepalived.
 func New(c Config, opts ...Option) (*Keepalived, error) {
 	k := &Keepalived{
-		store: c.Store,
-		bus:   c.Bus,
+		store:                 c.Store,
+		bus:                   c.Bus,
 		deregistrationHandler: c.DeregistrationHandler,
-		monitorFactory: func(entity *types.Entity, event *types.Event, t time.Duration, u monitor.UpdateHandler, f monitor.FailureHandler) monitor.Interface {
-			return monitor.New(entity, event, t, u, f)
-		},
-		keepaliveChan: make(chan interface{}, 10),
-		handlerCount:  DefaultHandlerCount,
-		mu:            &sync.Mutex{},
-		monitors:      map[string]monitor.Interface{},
-		errChan:       make(chan error, 1),
+		keepaliveChan:         make(chan interface{}, 10),
+		deregistrations:       make(deregistrationReceiver, 10),
+		deregistered:          make(map[string]deregistration),
+		handlerCount:          DefaultHandlerCount,
+		errChan:               make(chan error, 1),
 	}
+	k.monitors = monitor.NewEtcdSupervisor(c.Client, monitorName, k)
 	for _, o := range opts {
 		if err := o(k); err != nil {
 			return nil, err
@@ -101,16 +141,25 @@ func (k *Keepalived) Start() error {
 	}
 	k.subscription = sub
 
-	if err := k.initFromStore(); err != nil {
+	deregSub, err := k.bus.Subscribe(messaging.TopicDeregistration, "keepalived", k.deregistrations)
+	if err != nil {
 		if err := k.subscription.Cancel(); err != nil {
 			logger.WithError(err).Error("unable to unsubscribe from message bus")
 		}
 		return err
 	}
+	k.deregSubscription = deregSub
 
-	k.startWorkers()
+	if err := k.monitors.Start(); err != nil {
+		for _, sub := range []messaging.Subscription{k.subscription, k.deregSubscription} {
+			if err := sub.Cancel(); err != nil {
+				logger.WithError(err).Error("unable to unsubscribe from message bus")
+			}
+		}
+		return err
+	}
 
-	k.startMonitorSweeper()
+	k.startWorkers()
 
 	return nil
 }
@@ -119,10 +168,14 @@ func (k *Keepalived) Start() error {
 // shutdown.
 func (k *Keepalived) Stop() error {
 	err := k.subscription.Cancel()
+	if derr := k.deregSubscription.Cancel(); err == nil {
+		err = derr
+	}
 	close(k.keepaliveChan)
+	close(k.deregistrations)
 	k.wg.Wait()
-	for _, monitor := range k.monitors {
-		go monitor.Stop()
+	if merr := k.monitors.Stop(); err == nil {
+		err = merr
 	}
 	close(k.errChan)
 	return err
@@ -139,59 +192,20 @@ func (k *Keepalived) Err() <-chan error {
 	return k.errChan
 }
 
-func (k *Keepalived) initFromStore() error {
-	// For which clients were we previously alerting?
-	keepalives, err := k.store.GetFailingKeepalives(context.TODO())
-	if err != nil {
-		return err
-	}
-
-	for _, keepalive := range keepalives {
-		entityCtx := context.WithValue(context.TODO(), types.OrganizationKey, keepalive.Organization)
-		entityCtx = context.WithValue(entityCtx, types.EnvironmentKey, keepalive.Environment)
-		event, err := k.store.GetEventByEntityCheck(entityCtx, keepalive.EntityID, "keepalive")
-		if err != nil {
-			return err
-		}
-
-		// if there's no event, the entity was deregistered/deleted.
-		if event == nil {
-			continue
-		}
-
-		// if another backend picked it up, it will be passing.
-		if event.Check.Status == 0 {
-			continue
-		}
-
-		// Recreate the monitor with a time offset calculated from the keepalive
-		// entry timestamp minus the current time.
-		d := time.Duration(int64(keepalive.Time) - time.Now().Unix())
-
-		if d < 0 {
-			d = 0
-		}
-		monitor := k.monitorFactory(event.Entity, nil, d, k, k)
-		k.monitors[keepalive.EntityID] = monitor
-	}
-
-	return nil
-}
-
 func (k *Keepalived) startWorkers() {
 	k.wg = &sync.WaitGroup{}
-	k.wg.Add(k.handlerCount)
+	k.wg.Add(k.handlerCount + 1)
 
 	for i := 0; i < k.handlerCount; i++ {
 		go k.processKeepalives()
 	}
+	go k.processDeregistrations()
 }
 
 func (k *Keepalived) processKeepalives() {
 	defer k.wg.Done()
 
 	var (
-		mon   monitor.Interface
 		event *types.Event
 		ok    bool
 	)
@@ -214,32 +228,104 @@ func (k *Keepalived) processKeepalives() {
 			continue
 		}
 
-		if err := k.handleEntityRegistration(entity); err != nil {
-			logger.WithError(err).Error("error handling entity registration")
+		k.handleKeepalive(event)
+	}
+}
+
+// handleKeepalive registers the entity of the keepalive and pushes back the
+// deadline of its next keepalive, unless the entity was deregistered after the
+// keepalive was sent.
+func (k *Keepalived) handleKeepalive(event *types.Event) {
+	// Deregistrations wait for the keepalives being handled, so the deadline
+	// of a deregistered entity isn't created again
+	k.deregisteredMu.RLock()
+	defer k.deregisteredMu.RUnlock()
+
+	entity := event.Entity
+	if dereg, ok := k.deregistered[entityKey(entity)]; ok && event.Timestamp <= dereg.timestamp {
+		logger.WithField("entity", entity.GetID()).Info("dropping keepalive of deregistered entity")
+		return
+	}
+
+	if err := k.handleEntityRegistration(entity); err != nil {
+		logger.WithError(err).Error("error handling entity registration")
+	}
+
+	// push back the deadline of the next keepalive of the entity
+	ctx := types.SetContextFromResource(context.Background(), entity)
+	timeout := time.Duration(entity.KeepaliveTimeout) * time.Second
+	if err := k.monitors.Monitor(ctx, entity, nil, timeout); err != nil {
+		logger.WithError(err).Error("error monitoring entity")
+	}
+
+	if err := k.HandleUpdate(event); err != nil {
+		logger.WithError(err).Error("error handling keepalive")
+	}
+}
+
+func (k *Keepalived) processDeregistrations() {
+	defer k.wg.Done()
+
+	for msg := range k.deregistrations {
+		event, ok := msg.(*types.Event)
+		if !ok {
+			logger.Error("keepalived received non-Event on deregistration channel")
+			continue
+		}
+
+		entity := event.Entity
+		if entity == nil {
+			logger.Error("received deregistration with nil entity")
+			continue
 		}
 
-		k.mu.Lock()
-		mon, ok = k.monitors[entity.ID]
-		timeout := time.Duration(entity.KeepaliveTimeout) * time.Second
-		// create an entity monitor if it doesn't exist in the monitor map
-		if !ok || mon.IsStopped() {
-			mon = k.monitorFactory(entity, nil, timeout, k, k)
-			k.monitors[entity.ID] = mon
+		if err := entity.Validate(); err != nil {
+			logger.WithError(err).Error("invalid deregistration event")
+			continue
 		}
-		// stop the running monitor and reset it in the monitor map with new timeout
-		if mon.GetTimeout() != timeout {
-			mon.Stop()
-			mon = k.monitorFactory(entity, nil, timeout, k, k)
-			k.monitors[entity.ID] = mon
+
+		if err := k.HandleDeregistration(event); err != nil {
+			logger.WithError(err).Error("error deregistering entity")
 		}
-		k.mu.Unlock()
+	}
+}
+
+// HandleDeregistration stops monitoring the keepalives of an ephemeral entity
+// whose agent shut down, and deregisters it right away. The keepalives of other
+// entities keep being monitored, so they fail once they time out.
+func (k *Keepalived) HandleDeregistration(event *types.Event) error {
+	entity := event.Entity
+	if !entity.Deregister {
+		logger.WithField("entity", entity.GetID()).Info("agent shut down, entity is not ephemeral")
+		return nil
+	}
 
-		if err := mon.HandleUpdate(event); err != nil {
-			logger.WithError(err).Error("error monitoring entity")
+	k.deregisteredMu.Lock()
+	defer k.deregisteredMu.Unlock()
+
+	now := time.Now()
+	for key, dereg := range k.deregistered {
+		if now.Sub(dereg.received) > deregistrationTTL {
+			delete(k.deregistered, key)
 		}
 	}
+	k.deregistered[entityKey(entity)] = deregistration{timestamp: event.Timestamp, received: now}
+
+	ctx := types.SetContextFromResource(context.Background(), entity)
+	if err := k.monitors.Remove(ctx, entity, nil); err != nil {
+		return err
+	}
+	deregisterer := &Deregistration{
+		Store:      k.store,
+		MessageBus: k.bus,
+	}
+	return deregisterer.Deregister(entity)
 }
 
+// handleEntityRegistration emits a registration event for new agent entities,
+// and an inventory event if the system of a known one changed. The backend owns
+// the subscriptions of the entity once they were set through the API, so the
+// stored ones replace the ones of the keepalive.
 func (k *Keepalived) handleEntityRegistration(entity *types.Entity) error {
 	if entity.Class != types.EntityAgentClass {
 		return nil
@@ -252,30 +338,26 @@ func (k *Keepalived) handleEntityRegistration(entity *types.Entity) error {
 		return err
 	}
 
+	entity.ManagedSubscriptions = fetchedEntity.GetManagedSubscriptions()
+	if entity.ManagedSubscriptions {
+		entity.Subscriptions = fetchedEntity.Subscriptions
+	}
+
 	if fetchedEntity == nil {
 		event := createRegistrationEvent(entity)
 		err = k.bus.Publish(messaging.TopicEvent, event)
+	} else if changes := inventoryChanges(&fetchedEntity.System, &entity.System); len(changes) > 0 {
+		event := createInventoryEvent(entity, changes)
+		err = k.bus.Publish(messaging.TopicEvent, event)
 	}
 
 	return err
 }
 
-// startMonitorSweeper spins off into oblivion if Keepalived is stopped until
-// the monitors map is empty, and then the goroutine stops.
-func (k *Keepalived) startMonitorSweeper() {
-	go func() {
-		timer := time.NewTimer(10 * time.Minute)
-		for {
-			<-timer.C
-			for key, monitor := range k.monitors {
-				if monitor.IsStopped() {
-					k.mu.Lock()
-					delete(k.monitors, key)
-					k.mu.Unlock()
-				}
-			}
-		}
-	}()
+// entityKey returns the key identifying an entity across organizations and
+// environments
+func entityKey(entity *types.Entity) string {
+	return path.Join(entity.Organization, entity.Environment, entity.ID)
 }
 
 func createKeepaliveEvent(entity *types.Entity) *types.Event {
@@ -314,16 +396,76 @@ func createRegistrationEvent(entity *types.Entity) *types.Event {
 	return registrationEvent
 }
 
+// createInventoryEvent returns an event describing the changes of the system
+// inventory of the entity. A change is not a failure, so the event is OK and
+// doesn't leave the entity in a warning state.
+func createInventoryEvent(entity *types.Entity, changes []string) *types.Event {
+	inventoryCheck := &types.Check{
+		Name:         InventoryCheckName,
+		Interval:     entity.KeepaliveTimeout,
+		Handlers:     []string{InventoryHandlerName},
+		Environment:  entity.Environment,
+		Organization: entity.Organization,
+		Output:       strings.Join(changes, "\n"),
+		Status:       0,
+	}
+	inventoryEvent := &types.Event{
+		Timestamp: time.Now().Unix(),
+		Entity:    entity,
+		Check:     inventoryCheck,
+	}
+
+	return inventoryEvent
+}
+
+// inventoryChanges describes the differences between the stored and received system
+// inventories of an entity. The uptime is ignored since it always changes.
+func inventoryChanges(stored, received *types.System) []string {
+	var changes []string
+	changed := func(field string, from, to interface{}) {
+		changes = append(changes, fmt.Sprintf("%s changed from %v to %v", field, from, to))
+	}
+
+	if stored.Hostname != received.Hostname {
+		changed("hostname", stored.Hostname, received.Hostname)
+	}
+	if stored.OS != received.OS {
+		changed("os", stored.OS, received.OS)
+	}
+	if stored.Platform != received.Platform {
+		changed("platform", stored.Platform, received.Platform)
+	}
+	if stored.PlatformFamily != received.PlatformFamily {
+		changed("platform_family", stored.PlatformFamily, received.PlatformFamily)
+	}
+	if stored.PlatformVersion != received.PlatformVersion {
+		changed("platform_version", stored.PlatformVersion, received.PlatformVersion)
+	}
+	if stored.Arch != received.Arch {
+		changed("arch", stored.Arch, received.Arch)
+	}
+	if stored.CPUs != received.CPUs {
+		changed("cpus", stored.CPUs, received.CPUs)
+	}
+	if stored.Memory != received.Memory {
+		changed("memory", stored.Memory, received.Memory)
+	}
+	if stored.KernelVersion != received.KernelVersion {
+		changed("kernel_version", stored.KernelVersion, received.KernelVersion)
+	}
+	if !stored.Network.Equal(&received.Network) {
+		changes = append(changes, "network interfaces changed")
+	}
+
+	return changes
+}
+
 // HandleUpdate sets the entity's last seen time and publishes an OK check event
 // to the message bus.
 func (k *Keepalived) HandleUpdate(e *types.Event) error {
 	entity := e.Entity
 
 	ctx := types.SetContextFromResource(context.Background(), entity)
-	if err := k.store.DeleteFailingKeepalive(ctx, e.Entity); err != nil {
-		return err
-	}
-
 	entity.LastSeen = e.Timestamp
 
 	if err := k.store.UpdateEntity(ctx, entity); err != nil {
@@ -336,12 +478,11 @@ func (k *Keepalived) HandleUpdate(e *types.Event) error {
 }
 
 // HandleFailure checks if the entity should be deregistered, and emits a
-// keepalive event if the entity is still valid.
+// keepalive event if the entity is still valid. It is called, by the leader of
+// the cluster, once the keepalive deadline of the entity expires.
 func (k *Keepalived) HandleFailure(entity *types.Entity, _ *types.Event) error {
 	// Note, we don't need to use the event parameter here as we're
 	// constructing new one instead.
-	ctx := types.SetContextFromResource(context.Background(), entity)
-
 	deregisterer := &Deregistration{
 		Store:      k.store,
 		MessageBus: k.bus,
@@ -359,6 +500,5 @@ func (k *Keepalived) HandleFailure(entity *types.Entity, _ *types.Event) error {
 	}
 
 	logger.WithField("entity", entity.GetID()).Info("keepalive timed out, creating keepalive event for entity")
-	timeout := time.Now().Unix() + int64(entity.KeepaliveTimeout)
-	return k.store.UpdateFailingKeepalive(ctx, entity, timeout)
+	return nil
 }
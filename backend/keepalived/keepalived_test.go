@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/sensu/sensu-go/backend/messaging"
-	"github.com/sensu/sensu-go/backend/monitor"
 	"github.com/sensu/sensu-go/testing/mockmonitor"
 	"github.com/sensu/sensu-go/testing/mockring"
 	"github.com/sensu/sensu-go/testing/mockstore"
@@ -23,6 +22,7 @@ type keepalivedTest struct {
 	Keepalived   *Keepalived
 	MessageBus   messaging.MessageBus
 	Store        *mockstore.MockStore
+	Supervisor   *mockmonitor.MockSupervisor
 	Deregisterer *mockDeregisterer
 	receiver     chan interface{}
 }
@@ -40,14 +40,14 @@ func newKeepalivedTest(t *testing.T) *keepalivedTest {
 	require.NoError(t, err)
 	k, err := New(Config{Store: store, Bus: bus})
 	require.NoError(t, err)
-	k.monitorFactory = func(*types.Entity, *types.Event, time.Duration, monitor.UpdateHandler, monitor.FailureHandler) monitor.Interface {
-		mon := &mockmonitor.MockMonitor{}
-		mon.On("HandleUpdate", mock.Anything).Return(nil)
-		return mon
-	}
+	supervisor := &mockmonitor.MockSupervisor{}
+	supervisor.On("Monitor", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	supervisor.On("Remove", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	k.monitors = supervisor
 	test := &keepalivedTest{
 		MessageBus:   bus,
 		Store:        store,
+		Supervisor:   supervisor,
 		Deregisterer: deregisterer,
 		Keepalived:   k,
 		receiver:     make(chan interface{}),
@@ -61,113 +61,38 @@ func (k *keepalivedTest) Dispose(t *testing.T) {
 }
 
 func TestStartStop(t *testing.T) {
-	failingEvent := func(e *types.Event) *types.Event {
-		e.Check.Status = 1
-		return e
-	}
-
-	tt := []struct {
-		name     string
-		records  []*types.KeepaliveRecord
-		events   []*types.Event
-		monitors int
-	}{
-		{
-			name:     "No Keepalives",
-			records:  nil,
-			events:   nil,
-			monitors: 0,
-		},
-		{
-			name: "Passing Keepalives",
-			records: []*types.KeepaliveRecord{
-				{
-					EntityID:     "entity1",
-					Organization: "org",
-					Time:         0,
-				},
-				{
-					EntityID:     "entity2",
-					Organization: "org",
-					Time:         0,
-				},
-			},
-			events: []*types.Event{
-				types.FixtureEvent("entity1", "keepalive"),
-				types.FixtureEvent("entity2", "keepalive"),
-			},
-			monitors: 0,
-		},
-		{
-			name: "Failing Keepalives",
-			records: []*types.KeepaliveRecord{
-				{
-					EntityID:     "entity1",
-					Organization: "org",
-					Time:         0,
-				},
-				{
-					EntityID:     "entity2",
-					Organization: "org",
-					Time:         0,
-				},
-			},
-			events: []*types.Event{
-				failingEvent(types.FixtureEvent("entity1", "keepalive")),
-				failingEvent(types.FixtureEvent("entity2", "keepalive")),
-			},
-			monitors: 2,
-		},
-	}
-
-	for _, tc := range tt {
-		t.Run(tc.name, func(t *testing.T) {
-			test := newKeepalivedTest(t)
-			defer test.Dispose(t)
-
-			k := test.Keepalived
-
-			test.Store.On("GetFailingKeepalives", mock.Anything).Return(tc.records, nil)
-			for _, event := range tc.events {
-				test.Store.On("GetEventByEntityCheck", mock.Anything, event.Entity.ID, "keepalive").Return(event, nil)
-				if event.Check.Status != 0 {
-					test.Store.On("UpdateFailingKeepalive", mock.Anything, event.Entity, mock.AnythingOfType("int64")).Return(nil)
-				}
-			}
+	test := newKeepalivedTest(t)
+	defer test.Dispose(t)
 
-			require.NoError(t, k.Start())
-			require.NoError(t, k.Status())
+	k := test.Keepalived
+	require.NoError(t, k.Start())
+	require.NoError(t, k.Status())
 
-			var err error
-			select {
-			case err = <-k.Err():
-			default:
-			}
-			assert.NoError(t, err)
-			assert.NoError(t, k.Stop())
-			assert.Equal(t, tc.monitors, len(k.monitors))
-		})
+	var err error
+	select {
+	case err = <-k.Err():
+	default:
 	}
+	assert.NoError(t, err)
+	assert.NoError(t, k.Stop())
 }
 
 func TestEventProcessing(t *testing.T) {
 	test := newKeepalivedTest(t)
-	test.Store.On("GetFailingKeepalives", mock.Anything).Return([]*types.KeepaliveRecord{}, nil)
-	mon := &mockmonitor.MockMonitor{}
-	mon.On("HandleUpdate", mock.Anything).Return(nil)
-	test.Keepalived.monitorFactory = func(entity *types.Entity, event *types.Event, t time.Duration, updateHandler monitor.UpdateHandler, failureHandler monitor.FailureHandler) monitor.Interface {
-		return mon
-	}
+	defer test.Dispose(t)
 	require.NoError(t, test.Keepalived.Start())
 	event := types.FixtureEvent("entity", "keepalive")
 	event.Check.Status = 1
 
 	test.Store.On("UpdateEntity", mock.Anything, event.Entity).Return(nil)
-	test.Store.On("DeleteFailingKeepalive", mock.Anything, event.Entity).Return(nil)
 
 	test.Keepalived.keepaliveChan <- event
 	assert.NoError(t, test.Keepalived.Stop())
-	mon.AssertCalled(t, "HandleUpdate", event)
+
+	// The deadline of the next keepalive is pushed back
+	timeout := time.Duration(event.Entity.KeepaliveTimeout) * time.Second
+	test.Supervisor.AssertCalled(t, "Monitor", mock.Anything, event.Entity, (*types.Event)(nil), timeout)
+	test.Store.AssertCalled(t, "UpdateEntity", mock.Anything, event.Entity)
 }
 
 type testSubscriber struct {
@@ -185,6 +110,12 @@ func TestProcessRegistration(t *testing.T) {
 		return entity
 	}
 
+	newEntityWithSystem := func(version string) *types.Entity {
+		entity := newEntityWithClass("agent")
+		entity.System.PlatformVersion = version
+		return entity
+	}
+
 	tt := []struct {
 		name        string
 		entity      *types.Entity
@@ -209,6 +140,12 @@ func TestProcessRegistration(t *testing.T) {
 			storeEntity: nil,
 			expectedLen: 1,
 		},
+		{
+			name:        "Registered Entity With Changed Inventory",
+			entity:      newEntityWithSystem("16.04"),
+			storeEntity: newEntityWithSystem("14.04"),
+			expectedLen: 1,
+		},
 	}
 
 	for _, tc := range tt {
@@ -239,3 +176,128 @@ func TestProcessRegistration(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessRegistrationManagedSubscriptions(t *testing.T) {
+	messageBus, err := messaging.NewWizardBus(messaging.WizardBusConfig{
+		RingGetter: &mockring.Getter{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, messageBus.Start())
+
+	store := &mockstore.MockStore{}
+	keepalived, err := New(Config{Store: store, Bus: messageBus})
+	require.NoError(t, err)
+
+	stored := types.FixtureEntity("agent1")
+	stored.Class = types.EntityAgentClass
+	stored.Subscriptions = []string{"web"}
+	store.On("GetEntityByID", mock.Anything, "agent1").Return(stored, nil)
+
+	// The subscriptions of the keepalive are used until set through the API
+	entity := types.FixtureEntity("agent1")
+	entity.Class = types.EntityAgentClass
+	entity.Subscriptions = []string{"linux"}
+	require.NoError(t, keepalived.handleEntityRegistration(entity))
+	assert.Equal(t, []string{"linux"}, entity.Subscriptions)
+	assert.False(t, entity.ManagedSubscriptions)
+
+	stored.ManagedSubscriptions = true
+	require.NoError(t, keepalived.handleEntityRegistration(entity))
+	assert.Equal(t, []string{"web"}, entity.Subscriptions)
+	assert.True(t, entity.ManagedSubscriptions)
+}
+
+func TestInventoryChanges(t *testing.T) {
+	stored := types.System{Hostname: "host", PlatformVersion: "14.04", CPUs: 2, Uptime: 10}
+	received := stored
+	received.Uptime = 20
+	assert.Empty(t, inventoryChanges(&stored, &received))
+
+	received.PlatformVersion = "16.04"
+	received.CPUs = 4
+	received.Network.Interfaces = []types.NetworkInterface{{Name: "eth0"}}
+	assert.Equal(t, []string{
+		"platform_version changed from 14.04 to 16.04",
+		"cpus changed from 2 to 4",
+		"network interfaces changed",
+	}, inventoryChanges(&stored, &received))
+
+	event := createInventoryEvent(types.FixtureEntity("agent1"), inventoryChanges(&stored, &received))
+	assert.Equal(t, InventoryCheckName, event.Check.Name)
+	assert.Contains(t, event.Check.Output, "cpus changed from 2 to 4")
+	assert.Equal(t, uint32(0), event.Check.Status)
+}
+
+func TestDeregistrationProcessing(t *testing.T) {
+	test := newKeepalivedTest(t)
+	defer test.Dispose(t)
+	require.NoError(t, test.Keepalived.Start())
+
+	tsub := testSubscriber{
+		ch: make(chan interface{}, 1),
+	}
+	subscription, err := test.MessageBus.Subscribe(messaging.TopicEvent, "testSubscriber", tsub)
+	require.NoError(t, err)
+	defer subscription.Cancel()
+
+	entity := types.FixtureEntity("entity")
+	entity.Deregister = true
+	entity.Deregistration.Handler = "deregistration"
+
+	test.Store.On("DeleteEntity", mock.Anything, entity).Return(nil)
+	test.Store.On("GetEventsByEntity", mock.Anything, entity.ID).Return([]*types.Event{}, nil)
+
+	require.NoError(t, test.MessageBus.Publish(messaging.TopicDeregistration, &types.Event{Entity: entity}))
+
+	// The deregistration handler is executed right away
+	select {
+	case msg := <-tsub.ch:
+		event, ok := msg.(*types.Event)
+		require.True(t, ok)
+		assert.Equal(t, "deregistration", event.Check.Name)
+		assert.Equal(t, []string{"deregistration"}, event.Check.Handlers)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no deregistration event")
+	}
+
+	assert.NoError(t, test.Keepalived.Stop())
+	test.Supervisor.AssertCalled(t, "Remove", mock.Anything, entity, (*types.Event)(nil))
+	test.Store.AssertCalled(t, "DeleteEntity", mock.Anything, entity)
+}
+
+func TestDeregistrationNotEphemeral(t *testing.T) {
+	test := newKeepalivedTest(t)
+	defer test.Dispose(t)
+
+	entity := types.FixtureEntity("entity")
+
+	// The keepalives of the entity keep being monitored
+	assert.NoError(t, test.Keepalived.HandleDeregistration(&types.Event{Entity: entity}))
+	test.Supervisor.AssertNotCalled(t, "Remove", mock.Anything, mock.Anything, mock.Anything)
+	test.Store.AssertNotCalled(t, "DeleteEntity", mock.Anything, mock.Anything)
+}
+
+func TestKeepaliveAfterDeregistration(t *testing.T) {
+	test := newKeepalivedTest(t)
+	defer test.Dispose(t)
+
+	entity := types.FixtureEntity("entity")
+	entity.Deregister = true
+
+	test.Store.On("DeleteEntity", mock.Anything, entity).Return(nil)
+	test.Store.On("GetEventsByEntity", mock.Anything, entity.ID).Return([]*types.Event{}, nil)
+	test.Store.On("UpdateEntity", mock.Anything, entity).Return(nil)
+
+	now := time.Now().Unix()
+	require.NoError(t, test.Keepalived.HandleDeregistration(&types.Event{Entity: entity, Timestamp: now}))
+
+	// A keepalive sent before the deregistration doesn't monitor the entity again
+	keepalive := &types.Event{Entity: entity, Timestamp: now - 1}
+	test.Keepalived.handleKeepalive(keepalive)
+	test.Supervisor.AssertNotCalled(t, "Monitor", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// The entity is monitored once its agent starts again
+	keepalive.Timestamp = now + 1
+	test.Keepalived.handleKeepalive(keepalive)
+	test.Supervisor.AssertCalled(t, "Monitor", mock.Anything, entity, (*types.Event)(nil), mock.Anything)
+}
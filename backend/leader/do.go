@@ -16,7 +16,7 @@ import (
 // concurrent with the next elected leader's.
 //
 // If this node is not the leader, Do will block until it is elected. This can
-// be terminated by calling Resign.
+// be terminated by calling Resign, in which case ErrResigned is returned.
 //
 // Do can lead to etcd leader elections, which may also fail. These failures
 // will be returned as errors.
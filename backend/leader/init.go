@@ -16,6 +16,10 @@ var (
 var (
 	// ErrNotInitialized is returned when Init has not been called.
 	ErrNotInitialized = errors.New("package not initialized")
+
+	// ErrResigned is returned when Resign is called before the work could be
+	// executed.
+	ErrResigned = errors.New("resigned from leadership")
 )
 
 var (
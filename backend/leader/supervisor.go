@@ -25,6 +25,7 @@ type supervisor struct {
 	isLeader      chan struct{}
 	isFollower    chan struct{}
 	work          chan *work
+	done          chan struct{}
 	cancel        context.CancelFunc
 	nodeName      string
 	logger        *logrus.Entry
@@ -55,6 +56,7 @@ func (s *supervisor) Start() {
 	s.leaderName.Store("")
 	ctx, cancel := context.WithCancel(context.Background())
 	s.cancel = cancel
+	s.done = make(chan struct{})
 
 	go s.campaign(ctx)
 	go s.observer(ctx)
@@ -166,13 +168,19 @@ func (s *supervisor) observer(ctx context.Context) {
 func (s *supervisor) Stop() error {
 	s.logger.Info("resigning from leadership or election")
 	s.cancel()
+	close(s.done)
 	s.workInFlight.Wait()
 	return s.election.Resign(context.Background())
 }
 
-// Exec sends the work its given to the work channel.
+// Exec sends the work its given to the work channel. The work fails with
+// ErrResigned if the supervisor is stopped before it gains leadership.
 func (s *supervisor) Exec(w *work) {
-	s.work <- w
+	select {
+	case s.work <- w:
+	case <-s.done:
+		w.result <- ErrResigned
+	}
 }
 
 // WaitLeader blocks until the supervisor has attained leadership.
@@ -0,0 +1,359 @@
+package messaging
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/google/uuid"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// relayMessageTTL is the time, in seconds, the relayed messages are kept in
+	// etcd. They are delivered as soon as they are written, through watches.
+	relayMessageTTL = 30
+
+	// relayLeaseInterval is the duration a lease is used for relayed messages
+	// before a new one is granted, so the messages expire in time.
+	relayLeaseInterval = 10 * time.Second
+
+	// relayRetryInterval is the delay before the relayed messages are watched
+	// again after a watch failed.
+	relayRetryInterval = time.Second
+
+	// relayTimeout is the time allowed to write a relayed message to etcd.
+	relayTimeout = 5 * time.Second
+)
+
+var relayKeyBuilder = store.NewKeyBuilder("bus")
+
+// relayTypes are the types of the messages that can be relayed to the other
+// backends, by name.
+var relayTypes = map[string]func() interface{}{
+	"CheckRequest":        func() interface{} { return &types.CheckRequest{} },
+	"Event":               func() interface{} { return &types.Event{} },
+	"SubscriptionsUpdate": func() interface{} { return &types.SubscriptionsUpdate{} },
+}
+
+// A LocalPublisher is a MessageBus spanning several backends, which can
+// publish a message to the subscribers of its own backend only.
+type LocalPublisher interface {
+	PublishLocal(topic string, message interface{}) error
+}
+
+// PublishLocal publishes a message to the subscribers of the topic on this
+// backend only, for the messages that every backend of a cluster publishes
+// itself, such as scheduled check requests.
+func PublishLocal(bus MessageBus, topic string, message interface{}) error {
+	if local, ok := bus.(LocalPublisher); ok {
+		return local.PublishLocal(topic, message)
+	}
+	return bus.Publish(topic, message)
+}
+
+// relayedMessage is a message relayed to the other backends through etcd.
+type relayedMessage struct {
+	Origin   string          `json:"origin"`
+	Topic    string          `json:"topic"`
+	Consumer string          `json:"consumer,omitempty"`
+	Type     string          `json:"type"`
+	Payload  json.RawMessage `json:"payload"`
+}
+
+// EtcdBus is a MessageBus spanning all the backends of a cluster.
+//
+// Messages are fanned out to the subscribers of the backend by a WizardBus.
+// Those published to the relayed topics are also written to etcd, with a short
+// lease, and delivered to the subscribers of the other backends, which watch
+// them. Messages sent with PublishDirect go to the next consumer of the ring
+// of the topic, which is shared by the backends. They are relayed to the
+// backend of the consumer if it is not subscribed to this one.
+type EtcdBus struct {
+	*WizardBus
+
+	client *clientv3.Client
+	id     string
+	topics []string
+	prefix string
+
+	leaseMu      sync.Mutex
+	lease        clientv3.LeaseID
+	leaseGranted time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// EtcdBusConfig configures an EtcdBus
+type EtcdBusConfig struct {
+	Client     *clientv3.Client
+	RingGetter types.RingGetter
+
+	// Topics are the prefixes of the topics whose messages are relayed to the
+	// other backends
+	Topics []string
+}
+
+// NewEtcdBus creates a new EtcdBus.
+func NewEtcdBus(cfg EtcdBusConfig, opts ...WizardOption) (*EtcdBus, error) {
+	wizard, err := NewWizardBus(WizardBusConfig{RingGetter: cfg.RingGetter}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EtcdBus{
+		WizardBus: wizard,
+		client:    cfg.Client,
+		id:        uuid.New().String(),
+		topics:    cfg.Topics,
+		prefix:    relayKeyBuilder.Build() + "/",
+	}, nil
+}
+
+// Start starts the bus, and the delivery of the messages relayed by the other
+// backends.
+func (b *EtcdBus) Start() error {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Only the messages relayed from now on are delivered
+	resp, err := b.client.Get(ctx, b.prefix, clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	if err := b.WizardBus.Start(); err != nil {
+		cancel()
+		return err
+	}
+
+	b.cancel = cancel
+	b.wg.Add(1)
+	go b.relayPump(ctx, resp.Header.Revision+1)
+
+	return nil
+}
+
+// Stop stops the delivery of the relayed messages, then the bus.
+func (b *EtcdBus) Stop() error {
+	if b.cancel != nil {
+		b.cancel()
+	}
+	b.wg.Wait()
+	return b.WizardBus.Stop()
+}
+
+// Publish publishes a message to the subscribers of the topic, on every
+// backend if the topic is relayed.
+func (b *EtcdBus) Publish(topic string, msg interface{}) error {
+	if err := b.WizardBus.Publish(topic, msg); err != nil {
+		return err
+	}
+
+	if !b.relayed(topic) {
+		return nil
+	}
+	return b.relay(topic, "", msg)
+}
+
+// PublishDirect publishes a message to a single consumer of the topic, on any
+// backend if the topic is relayed.
+func (b *EtcdBus) PublishDirect(topic string, msg interface{}) error {
+	if !b.relayed(topic) {
+		return b.WizardBus.PublishDirect(topic, msg)
+	}
+
+	// The topic may only have consumers on the other backends
+	wt, err := b.WizardBus.directTopic(topic, true)
+	if err != nil {
+		return err
+	}
+	consumer, err := wt.next()
+	if err != nil {
+		return err
+	}
+
+	if wt.sendTo(consumer, msg) {
+		return nil
+	}
+	return b.relay(topic, consumer, msg)
+}
+
+// PublishLocal publishes a message to the subscribers of the topic on this
+// backend only.
+func (b *EtcdBus) PublishLocal(topic string, msg interface{}) error {
+	return b.WizardBus.Publish(topic, msg)
+}
+
+// relayed returns true if the messages of the topic are relayed
+func (b *EtcdBus) relayed(topic string) bool {
+	for _, prefix := range b.topics {
+		if strings.HasPrefix(topic, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// relay writes the message to etcd, so the other backends deliver it, to the
+// given consumer only if there is one
+func (b *EtcdBus) relay(topic, consumer string, msg interface{}) error {
+	value, err := encodeRelayedMessage(b.id, topic, consumer, msg)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), relayTimeout)
+	defer cancel()
+
+	lease, err := b.getLease(ctx)
+	if err != nil {
+		return err
+	}
+
+	key := b.prefix + uuid.New().String()
+	_, err = b.client.Put(ctx, key, string(value), clientv3.WithLease(lease))
+	return err
+}
+
+// getLease returns the lease of the relayed messages, granting a new one once
+// the current one has been used for the lease interval.
+func (b *EtcdBus) getLease(ctx context.Context) (clientv3.LeaseID, error) {
+	b.leaseMu.Lock()
+	defer b.leaseMu.Unlock()
+
+	if b.lease != clientv3.NoLease && time.Since(b.leaseGranted) < relayLeaseInterval {
+		return b.lease, nil
+	}
+
+	resp, err := b.client.Grant(ctx, relayMessageTTL)
+	if err != nil {
+		return clientv3.NoLease, err
+	}
+	b.lease = resp.ID
+	b.leaseGranted = time.Now()
+
+	return b.lease, nil
+}
+
+// relayPump delivers the messages relayed by the other backends, starting at
+// the given revision, until the context is cancelled. The watch is resumed
+// where it stopped if it fails.
+func (b *EtcdBus) relayPump(ctx context.Context, revision int64) {
+	defer b.wg.Done()
+
+	for {
+		revision = b.watch(ctx, revision)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(relayRetryInterval):
+		}
+	}
+}
+
+// watch delivers the relayed messages until the watch fails, and returns the
+// revision it should be resumed from.
+func (b *EtcdBus) watch(ctx context.Context, revision int64) int64 {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	watcher := b.client.Watch(
+		ctx, b.prefix, clientv3.WithPrefix(), clientv3.WithRev(revision), clientv3.WithFilterDelete(),
+	)
+	for resp := range watcher {
+		if err := resp.Err(); err != nil {
+			if ctx.Err() == nil {
+				logger.WithError(err).Error("error watching the relayed messages")
+			}
+			// The messages of the compacted revisions are lost
+			if resp.CompactRevision > revision {
+				revision = resp.CompactRevision
+			}
+			return revision
+		}
+
+		for _, event := range resp.Events {
+			revision = event.Kv.ModRevision + 1
+			b.deliver(event.Kv.Value)
+		}
+	}
+
+	return revision
+}
+
+// deliver publishes a message relayed by another backend to the subscribers
+// of this backend
+func (b *EtcdBus) deliver(value []byte) {
+	relayed, msg, err := decodeRelayedMessage(value)
+	if err != nil {
+		logger.WithError(err).Error("discarding invalid relayed message")
+		return
+	}
+	topic := relayed.Topic
+
+	// The messages of this backend were delivered when they were published
+	if relayed.Origin == b.id {
+		return
+	}
+
+	// Direct messages are only delivered by the backend of their consumer
+	if relayed.Consumer != "" {
+		b.WizardBus.sendTo(topic, relayed.Consumer, msg)
+		return
+	}
+
+	if err := b.WizardBus.Publish(topic, msg); err != nil {
+		logger.WithError(err).WithField("topic", topic).Error("error delivering relayed message")
+	}
+}
+
+func encodeRelayedMessage(origin, topic, consumer string, msg interface{}) ([]byte, error) {
+	t := reflect.TypeOf(msg)
+	if t == nil || t.Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("message of type %T can't be relayed", msg)
+	}
+	name := t.Elem().Name()
+	if _, ok := relayTypes[name]; !ok {
+		return nil, fmt.Errorf("message of type %T can't be relayed", msg)
+	}
+
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(relayedMessage{
+		Origin:   origin,
+		Topic:    topic,
+		Consumer: consumer,
+		Type:     name,
+		Payload:  payload,
+	})
+}
+
+func decodeRelayedMessage(value []byte) (*relayedMessage, interface{}, error) {
+	var relayed relayedMessage
+	if err := json.Unmarshal(value, &relayed); err != nil {
+		return nil, nil, err
+	}
+
+	newMessage, ok := relayTypes[relayed.Type]
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown message type %q", relayed.Type)
+	}
+	msg := newMessage()
+	if err := json.Unmarshal(relayed.Payload, msg); err != nil {
+		return nil, nil, err
+	}
+
+	return &relayed, msg, nil
+}
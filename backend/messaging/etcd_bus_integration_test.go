@@ -0,0 +1,123 @@
+// +build integration,!race
+
+package messaging
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/sensu/sensu-go/testing/mockring"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEtcdBusRelay(t *testing.T) {
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+
+	client, err := e.NewClient()
+	require.NoError(t, err)
+	defer client.Close()
+
+	// Two backends of a cluster
+	newBus := func() *EtcdBus {
+		bus, err := NewEtcdBus(EtcdBusConfig{
+			Client:     client,
+			RingGetter: &mockring.Getter{},
+			Topics:     []string{TopicSubscriptions},
+		})
+		require.NoError(t, err)
+		require.NoError(t, bus.Start())
+		return bus
+	}
+	local, remote := newBus(), newBus()
+	defer local.Stop()
+	defer remote.Stop()
+
+	topic := SubscriptionTopic("default", "default", "linux")
+	localSub := channelSubscriber{make(chan interface{}, 10)}
+	remoteSub := channelSubscriber{make(chan interface{}, 10)}
+	_, err = local.Subscribe(topic, "local", localSub)
+	require.NoError(t, err)
+	_, err = remote.Subscribe(topic, "remote", remoteSub)
+	require.NoError(t, err)
+	_, err = remote.Subscribe(TopicEventRaw, "remote", remoteSub)
+	require.NoError(t, err)
+
+	receive := func(sub channelSubscriber) interface{} {
+		select {
+		case msg := <-sub.Channel:
+			return msg
+		case <-time.After(5 * time.Second):
+			t.Fatal("no message received")
+			return nil
+		}
+	}
+
+	// Messages of the relayed topics reach the subscribers of every backend,
+	// once
+	request := &types.CheckRequest{Config: types.FixtureCheckConfig("check")}
+	require.NoError(t, local.Publish(topic, request))
+	assert.Equal(t, request, receive(localSub))
+	assert.Equal(t, request, receive(remoteSub))
+
+	// Messages published locally and of other topics are not relayed
+	require.NoError(t, local.PublishLocal(topic, request))
+	require.NoError(t, local.Publish(TopicEventRaw, types.FixtureEvent("entity", "check")))
+	assert.Equal(t, request, receive(localSub))
+
+	update := &types.SubscriptionsUpdate{Subscriptions: []string{"web"}}
+	require.NoError(t, local.Publish(topic, update))
+	assert.Equal(t, update, receive(remoteSub))
+	assert.Equal(t, update, receive(localSub))
+	assert.Len(t, localSub.Channel, 0)
+	assert.Len(t, remoteSub.Channel, 0)
+}
+
+func TestEtcdBusRelayDirect(t *testing.T) {
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+
+	client, err := e.NewClient()
+	require.NoError(t, err)
+	defer client.Close()
+
+	topic := SubscriptionTopic("default", "default", "linux")
+
+	// The ring of the topic is shared by the backends
+	ring := &mockring.Ring{}
+	ring.On("Add", mock.Anything, mock.Anything).Return(nil)
+	ring.On("Next", mock.Anything).Return("remote", nil)
+	getter := &mockring.Getter{topic: ring}
+
+	newBus := func() *EtcdBus {
+		bus, err := NewEtcdBus(EtcdBusConfig{
+			Client:     client,
+			RingGetter: getter,
+			Topics:     []string{TopicSubscriptions},
+		})
+		require.NoError(t, err)
+		require.NoError(t, bus.Start())
+		return bus
+	}
+	local, remote := newBus(), newBus()
+	defer local.Stop()
+	defer remote.Stop()
+
+	remoteSub := channelSubscriber{make(chan interface{}, 10)}
+	_, err = remote.Subscribe(topic, "remote", remoteSub)
+	require.NoError(t, err)
+
+	// The message is relayed to the backend of the consumer
+	request := &types.CheckRequest{Config: types.FixtureCheckConfig("check")}
+	require.NoError(t, local.PublishDirect(topic, request))
+	select {
+	case msg := <-remoteSub.Channel:
+		assert.Equal(t, request, msg)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message received")
+	}
+}
@@ -0,0 +1,50 @@
+package messaging
+
+import (
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockring"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayedMessageEncoding(t *testing.T) {
+	request := &types.CheckRequest{Config: types.FixtureCheckConfig("check")}
+
+	value, err := encodeRelayedMessage("backend", "topic", "consumer", request)
+	require.NoError(t, err)
+
+	relayed, msg, err := decodeRelayedMessage(value)
+	require.NoError(t, err)
+	assert.Equal(t, "backend", relayed.Origin)
+	assert.Equal(t, "topic", relayed.Topic)
+	assert.Equal(t, "consumer", relayed.Consumer)
+	assert.Equal(t, request, msg)
+
+	// Only the known message types can be relayed
+	_, err = encodeRelayedMessage("backend", "topic", "", "message")
+	assert.Error(t, err)
+	_, err = encodeRelayedMessage("backend", "topic", "", &types.Asset{})
+	assert.Error(t, err)
+
+	_, _, err = decodeRelayedMessage([]byte(`{"type": "Asset", "payload": {}}`))
+	assert.Error(t, err)
+}
+
+func TestPublishLocal(t *testing.T) {
+	// Buses running on a single backend publish the messages as usual
+	bus, err := NewWizardBus(WizardBusConfig{
+		RingGetter: &mockring.Getter{},
+	})
+	require.NoError(t, err)
+	require.NoError(t, bus.Start())
+	defer bus.Stop()
+
+	sub := channelSubscriber{make(chan interface{}, 1)}
+	_, err = bus.Subscribe("topic", "consumer", sub)
+	require.NoError(t, err)
+
+	require.NoError(t, PublishLocal(bus, "topic", "message"))
+	assert.Equal(t, "message", <-sub.Channel)
+}
@@ -16,6 +16,10 @@ const (
 	// TopicKeepalive is the topic for keepalive events.
 	TopicKeepalive = "sensu:keepalive"
 
+	// TopicDeregistration is the topic for the deregistrations of the agents
+	// shutting down.
+	TopicDeregistration = "sensu:deregistration"
+
 	// TopicEventRaw is the Session -> Eventd channel -- for raw events directly
 	// from agents, subscribe to this.
 	TopicEventRaw = "sensu:event-raw"
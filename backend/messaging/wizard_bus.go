@@ -6,9 +6,17 @@ import (
 	"sync"
 	"sync/atomic"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sensu/sensu-go/types"
 )
 
+var topicDepthDesc = prometheus.NewDesc(
+	"sensu_bus_topic_depth",
+	"Number of messages waiting to be received by the subscribers of a topic",
+	[]string{"topic"},
+	nil,
+)
+
 // WizardBus is a message bus.
 //
 // For every topic, WizardBus creates a new goroutine responsible for fanning
@@ -79,6 +87,23 @@ func (b *WizardBus) Err() <-chan error {
 	return b.errchan
 }
 
+// Describe implements prometheus.Collector.
+func (b *WizardBus) Describe(ch chan<- *prometheus.Desc) {
+	ch <- topicDepthDesc
+}
+
+// Collect implements prometheus.Collector, reporting the depth of every topic.
+func (b *WizardBus) Collect(ch chan<- prometheus.Metric) {
+	b.topicsMu.RLock()
+	defer b.topicsMu.RUnlock()
+
+	for id, wTopic := range b.topics {
+		ch <- prometheus.MustNewConstMetric(
+			topicDepthDesc, prometheus.GaugeValue, float64(wTopic.depth()), id,
+		)
+	}
+}
+
 // Create a WizardBus topic (WizardTopic) with consumer channel
 // bindings. Every topic has its own mutex, sending data to consumers
 // should only be blocked when adding (Subscribe) or removing
@@ -143,25 +168,55 @@ func (b *WizardBus) Publish(topic string, msg interface{}) error {
 
 // PublishDirect publishes a message to a single consumer.
 func (b *WizardBus) PublishDirect(topic string, msg interface{}) error {
+	wt, err := b.directTopic(topic, false)
+	if wt == nil || err != nil {
+		return err
+	}
+
+	return wt.SendDirect(msg)
+}
+
+// directTopic returns the topic, with its ring, to publish a message directly
+// to one of its consumers. Unless create is true, nil is returned if the topic
+// does not exist.
+func (b *WizardBus) directTopic(topic string, create bool) (*wizardTopic, error) {
 	if !b.running.Load().(bool) {
-		return errors.New("bus no longer running")
+		return nil, errors.New("bus no longer running")
 	}
 
-	b.topicsMu.RLock()
+	b.topicsMu.Lock()
 	wt, ok := b.topics[topic]
-	b.topicsMu.RUnlock()
+	if !ok && create {
+		wt = b.createTopic(topic)
+		b.topics[topic] = wt
+	}
+	b.topicsMu.Unlock()
 
-	if !ok {
-		return nil
+	if wt == nil {
+		return nil, nil
 	}
 
 	if wt.ring == nil {
 		if err := b.makeRing(wt); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	return wt.SendDirect(msg)
+	return wt, nil
+}
+
+// sendTo sends a message to a consumer of a topic, if it is subscribed to
+// this bus. It returns false otherwise.
+func (b *WizardBus) sendTo(topic, consumer string, msg interface{}) bool {
+	b.topicsMu.RLock()
+	wt, ok := b.topics[topic]
+	b.topicsMu.RUnlock()
+
+	if !ok {
+		return false
+	}
+
+	return wt.sendTo(consumer, msg)
 }
 
 // makeRing constructs a ring for a topic. rings are lazily constructed;
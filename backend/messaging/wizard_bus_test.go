@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sensu/sensu-go/testing/mockring"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -164,3 +165,59 @@ func TestPublishDirect(t *testing.T) {
 	default:
 	}
 }
+
+func TestPublishDirectSharedRing(t *testing.T) {
+	// The ring is shared with another backend, whose consumers are skipped
+	ring := &mockring.Ring{}
+	ring.On("Add", mock.Anything, mock.Anything).Return(nil)
+	ring.On("Next", mock.Anything).Return("remote", nil).Once()
+	ring.On("Next", mock.Anything).Return("a", nil).Once()
+	getter := &mockring.Getter{"topic": ring}
+	bus, err := NewWizardBus(WizardBusConfig{
+		RingGetter: getter,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, bus.Start())
+	defer bus.Stop()
+
+	sub := channelSubscriber{make(chan interface{}, 1)}
+	_, err = bus.Subscribe("topic", "a", sub)
+	require.NoError(t, err)
+
+	require.NoError(t, bus.PublishDirect("topic", "hello, world"))
+	assert.Equal(t, "hello, world", <-sub.Channel)
+
+	// No consumer of the ring is subscribed to this backend
+	ring.On("Next", mock.Anything).Return("remote", nil)
+	assert.Error(t, bus.PublishDirect("topic", "hello, world"))
+	assert.Len(t, sub.Channel, 0)
+}
+
+func TestWizardBusTopicDepth(t *testing.T) {
+	bus, err := NewWizardBus(WizardBusConfig{})
+	require.NoError(t, err)
+
+	require.NoError(t, bus.Start())
+	defer bus.Stop()
+
+	for _, id := range []string{"a", "b"} {
+		_, err := bus.Subscribe("topic", id, channelSubscriber{make(chan interface{}, 10)})
+		require.NoError(t, err)
+	}
+	require.NoError(t, bus.Publish("topic", "hello"))
+	require.NoError(t, bus.Publish("topic", "world"))
+
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(bus))
+
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+	assert.Equal(t, "sensu_bus_topic_depth", families[0].GetName())
+
+	metrics := families[0].GetMetric()
+	require.Len(t, metrics, 1)
+	assert.Equal(t, "topic", metrics[0].GetLabel()[0].GetValue())
+	assert.Equal(t, float64(4), metrics[0].GetGauge().GetValue())
+}
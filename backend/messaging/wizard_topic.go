@@ -35,23 +35,61 @@ func (wTopic *wizardTopic) Send(msg interface{}) {
 	}
 }
 
-// SendDirect sends a message directly to a subscriber of this topic.
+// depth returns the number of messages waiting to be received by the
+// subscribers of this topic.
+func (wTopic *wizardTopic) depth() int {
+	wTopic.RLock()
+	defer wTopic.RUnlock()
+
+	var depth int
+	for _, subscriber := range wTopic.bindings {
+		depth += len(subscriber.Receiver())
+	}
+	return depth
+}
+
+// SendDirect sends a message directly to a subscriber of this topic. The ring
+// of the topic may be shared with other backends, so its members which are not
+// subscribed to this topic locally are skipped.
 func (wTopic *wizardTopic) SendDirect(msg interface{}) error {
+	seen := make(map[string]struct{})
+	for {
+		id, err := wTopic.next()
+		if err != nil {
+			return err
+		}
+		if _, ok := seen[id]; ok {
+			return errors.New("no local subscriber in the ring of topic: " + wTopic.id)
+		}
+		seen[id] = struct{}{}
+
+		if wTopic.sendTo(id, msg) {
+			return nil
+		}
+	}
+}
+
+// next returns the next subscriber of the ring of this topic.
+func (wTopic *wizardTopic) next() (string, error) {
 	if wTopic.ring == nil {
-		return errors.New("no ring for topic: " + wTopic.id)
+		return "", errors.New("no ring for topic: " + wTopic.id)
 	}
+	return wTopic.ring.Next(context.Background())
+}
 
+// sendTo sends a message to the given subscriber of this topic. It returns
+// false if the subscriber is not bound to this topic.
+func (wTopic *wizardTopic) sendTo(id string, msg interface{}) bool {
 	wTopic.RLock()
 	defer wTopic.RUnlock()
 
-	id, err := wTopic.ring.Next(context.Background())
-	if err != nil {
-		return err
+	subscriber, ok := wTopic.bindings[id]
+	if !ok {
+		return false
 	}
+	subscriber.Receiver() <- msg
 
-	wTopic.bindings[id].Receiver() <- msg
-
-	return nil
+	return true
 }
 
 // Subscribe a Subscriber to this topic and receive a Subscription.
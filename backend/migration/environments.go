@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
-	"time"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/sensu/sensu-go/types"
@@ -14,21 +13,10 @@ import (
 // breaking change introduced in https://github.com/sensu/sensu-go/pull/574,
 // which effectively prevent users to update their environments because the new
 // organization attribute is required.
-func environments(storeURL string) {
-	logger.Info("running environments migration")
-
-	client, err := clientv3.New(clientv3.Config{
-		Endpoints:   []string{storeURL},
-		DialTimeout: 5 * time.Second,
-	})
-
-	if err != nil {
-		logger.Fatal(err)
-	}
-
-	envsResponse, err := client.Get(context.Background(), "/sensu.io/environments", clientv3.WithPrefix())
+func environments(ctx context.Context, client *clientv3.Client, dryRun bool) error {
+	envsResponse, err := client.Get(ctx, "/sensu.io/environments", clientv3.WithPrefix())
 	if err != nil {
-		logger.Fatal(err)
+		return err
 	}
 
 	for _, kv := range envsResponse.Kvs {
@@ -47,13 +35,17 @@ func environments(storeURL string) {
 			}
 
 			env.Organization = pathParts[3]
+			if dryRun {
+				logger.WithField("key", string(kv.Key)).Infof("would set the organization of the environment to %s", env.Organization)
+				continue
+			}
+
 			envBytes, _ := json.Marshal(env)
-			_, err := client.Put(context.Background(), string(kv.Key), string(envBytes))
-			if err != nil {
-				logger.WithError(err).Info("error updating environment in store")
+			if _, err := client.Put(ctx, string(kv.Key), string(envBytes)); err != nil {
+				return err
 			}
 		}
 	}
 
-	logger.Info("migration of environment completed")
+	return nil
 }
@@ -1,12 +1,191 @@
 package migration
 
-import "github.com/Sirupsen/logrus"
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/concurrency"
+	"github.com/sensu/sensu-go/backend/store"
+)
 
 var logger = logrus.WithFields(logrus.Fields{
 	"component": "migration",
 })
 
-// Run lauches the migration process
-func Run(storeURL string) {
-	environments(storeURL)
+var (
+	// versionKey is the key of the schema version of the store
+	versionKey = path.Join(store.Root, "schema", "version")
+
+	// lockKey is the prefix of the lock held by the backend migrating the
+	// store
+	lockKey = path.Join(store.Root, "schema", "lock")
+)
+
+// Migration is a change to the schema of the store.
+type Migration struct {
+	// Name describes the change
+	Name string
+
+	// Migrate applies the change. When dryRun is true, it must only log the
+	// changes it would make. A migration is run again if it is interrupted, so
+	// it must be idempotent.
+	Migrate func(ctx context.Context, client *clientv3.Client, dryRun bool) error
+}
+
+// migrations are the migrations of the store, in the order they are applied.
+// The schema version of a store is the number of migrations applied to it, so
+// new migrations must only ever be appended to this list.
+var migrations = []Migration{
+	{Name: "add the organization to the environments", Migrate: environments},
+}
+
+// Version returns the schema version of the store supported by this binary.
+func Version() int {
+	return len(migrations)
+}
+
+// Run applies the pending migrations to the store. The backends of a cluster
+// take turns holding a lock to apply them, so each one is applied exactly once
+// and Run blocks until they are all applied, by this backend or another one. An
+// error is returned if the schema of the store is newer than the one supported
+// by this binary.
+func Run(ctx context.Context, client *clientv3.Client) error {
+	return run(ctx, client, migrations)
+}
+
+// DryRun logs the changes the pending migrations would make to the store,
+// without making them.
+func DryRun(ctx context.Context, client *clientv3.Client) error {
+	version, _, err := getVersion(ctx, client)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion(version, migrations); err != nil {
+		return err
+	}
+
+	for i := version; i < len(migrations); i++ {
+		m := migrations[i]
+		logger.WithFields(logrus.Fields{
+			"version":   i + 1,
+			"migration": m.Name,
+		}).Info("pending migration")
+		if err := m.Migrate(ctx, client, true); err != nil {
+			return fmt.Errorf("migration %d (%s) failed: %s", i+1, m.Name, err)
+		}
+	}
+	return nil
+}
+
+func run(ctx context.Context, client *clientv3.Client, ms []Migration) error {
+	version, _, err := getVersion(ctx, client)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion(version, ms); err != nil {
+		return err
+	}
+	if version == len(ms) {
+		return nil
+	}
+
+	// The backends holding the lock next find nothing left to do, or resume
+	// the migrations if the previous one stopped before completing them
+	session, err := concurrency.NewSession(client)
+	if err != nil {
+		return err
+	}
+	defer session.Close()
+
+	lock := concurrency.NewMutex(session, lockKey)
+	logger.Info("waiting for the lock to migrate the store")
+	if err := lock.Lock(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err := lock.Unlock(context.Background()); err != nil {
+			logger.WithError(err).Error("error releasing the lock to migrate the store")
+		}
+	}()
+
+	return migrate(ctx, client, ms)
+}
+
+// migrate applies the pending migrations. It must be run with the lock held.
+func migrate(ctx context.Context, client *clientv3.Client, ms []Migration) error {
+	version, modRevision, err := getVersion(ctx, client)
+	if err != nil {
+		return err
+	}
+	if err := checkVersion(version, ms); err != nil {
+		return err
+	}
+
+	for ; version < len(ms); version++ {
+		m := ms[version]
+		logger := logger.WithFields(logrus.Fields{
+			"version":   version + 1,
+			"migration": m.Name,
+		})
+		logger.Info("running migration")
+		if err := m.Migrate(ctx, client, false); err != nil {
+			return fmt.Errorf("migration %d (%s) failed: %s", version+1, m.Name, err)
+		}
+
+		// Record the migration, unless the version was changed concurrently
+		cmp := clientv3.Compare(clientv3.ModRevision(versionKey), "=", modRevision)
+		put := clientv3.OpPut(versionKey, strconv.Itoa(version+1))
+		resp, err := client.Txn(ctx).If(cmp).Then(put).Commit()
+		if err != nil {
+			return err
+		}
+		if !resp.Succeeded {
+			return fmt.Errorf("schema version changed while running migration %d (%s)", version+1, m.Name)
+		}
+		modRevision = resp.Header.Revision
+		logger.Info("migration completed")
+	}
+
+	return nil
+}
+
+// getVersion returns the schema version of the store, along with the revision
+// it was last modified at.
+func getVersion(ctx context.Context, client *clientv3.Client) (int, int64, error) {
+	resp, err := client.Get(ctx, versionKey)
+	if err != nil {
+		return 0, 0, err
+	}
+	version, err := parseVersion(resp)
+	if err != nil || version == 0 {
+		return version, 0, err
+	}
+	return version, resp.Kvs[0].ModRevision, nil
+}
+
+// parseVersion returns the schema version found in resp. Stores which never
+// recorded one are at version 0.
+func parseVersion(resp *clientv3.GetResponse) (int, error) {
+	if len(resp.Kvs) == 0 {
+		return 0, nil
+	}
+	version, err := strconv.Atoi(string(resp.Kvs[0].Value))
+	if err != nil {
+		return 0, fmt.Errorf("invalid schema version %q: %s", resp.Kvs[0].Value, err)
+	}
+	return version, nil
+}
+
+func checkVersion(version int, ms []Migration) error {
+	if version > len(ms) {
+		return fmt.Errorf(
+			"the schema version of the store is %d but this backend only supports up to version %d, it must be upgraded",
+			version, len(ms),
+		)
+	}
+	return nil
 }
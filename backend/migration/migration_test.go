@@ -0,0 +1,134 @@
+// +build integration,!race
+
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(t *testing.T) {
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+	client, err := e.NewClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	calls := map[string]int{}
+	failing := true
+	ms := []Migration{
+		{Name: "first", Migrate: func(context.Context, *clientv3.Client, bool) error {
+			calls["first"]++
+			return nil
+		}},
+		{Name: "second", Migrate: func(context.Context, *clientv3.Client, bool) error {
+			calls["second"]++
+			if failing {
+				return errors.New("interrupted")
+			}
+			return nil
+		}},
+	}
+
+	// The first migration is recorded even though the second one failed
+	assert.Error(t, run(ctx, client, ms))
+	version, _, err := getVersion(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, 1, version)
+
+	// Only the failed migration is run again
+	failing = false
+	require.NoError(t, run(ctx, client, ms))
+	assert.Equal(t, map[string]int{"first": 1, "second": 2}, calls)
+	version, _, err = getVersion(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, 2, version)
+
+	// Nothing is left to do
+	require.NoError(t, run(ctx, client, ms))
+	assert.Equal(t, map[string]int{"first": 1, "second": 2}, calls)
+
+	// A binary which supports fewer migrations refuses the store
+	assert.Error(t, run(ctx, client, ms[:1]))
+	assert.Equal(t, map[string]int{"first": 1, "second": 2}, calls)
+}
+
+func TestRunConcurrently(t *testing.T) {
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+	client, err := e.NewClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	var mu sync.Mutex
+	calls := 0
+	ms := []Migration{
+		{Name: "slow", Migrate: func(context.Context, *clientv3.Client, bool) error {
+			mu.Lock()
+			calls++
+			mu.Unlock()
+			time.Sleep(500 * time.Millisecond)
+			return nil
+		}},
+	}
+
+	// Every backend returns once the store is migrated, by only one of them
+	errs := make(chan error, 3)
+	for i := 0; i < cap(errs); i++ {
+		go func() {
+			errs <- run(ctx, client, ms)
+		}()
+	}
+	for i := 0; i < cap(errs); i++ {
+		assert.NoError(t, <-errs)
+	}
+	assert.Equal(t, 1, calls)
+
+	version, _, err := getVersion(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, 1, version)
+}
+
+func TestEnvironments(t *testing.T) {
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+	client, err := e.NewClient()
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	key := "/sensu.io/environments/acme/dev"
+	_, err = client.Put(ctx, key, `{"name": "dev"}`)
+	require.NoError(t, err)
+
+	getEnv := func() *types.Environment {
+		resp, err := client.Get(ctx, key)
+		require.NoError(t, err)
+		require.Len(t, resp.Kvs, 1)
+		env := &types.Environment{}
+		require.NoError(t, json.Unmarshal(resp.Kvs[0].Value, env))
+		return env
+	}
+
+	// A dry run leaves the store untouched
+	require.NoError(t, DryRun(ctx, client))
+	assert.Empty(t, getEnv().Organization)
+	version, _, err := getVersion(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, 0, version)
+
+	require.NoError(t, Run(ctx, client))
+	assert.Equal(t, "acme", getEnv().Organization)
+	version, _, err = getVersion(ctx, client)
+	require.NoError(t, err)
+	assert.Equal(t, Version(), version)
+}
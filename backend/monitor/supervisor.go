@@ -0,0 +1,278 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/leader"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+// sweepInterval is the interval at which the expired deadlines are looked up.
+const sweepInterval = time.Second
+
+// Supervisor monitors the deadlines by which entities are expected to send
+// their next keepalive or check result, and handles the failure of the ones
+// that expire.
+type Supervisor interface {
+	// Start starts handling the expired deadlines.
+	Start() error
+
+	// Stop stops handling the expired deadlines. The deadlines are kept.
+	Stop() error
+
+	// Monitor sets the deadline of the entity, or of its check if the event
+	// has one, to the given timeout from now.
+	Monitor(ctx context.Context, entity *types.Entity, event *types.Event, timeout time.Duration) error
+
+	// Remove removes the deadline of the entity, or of its check if the event
+	// has one.
+	Remove(ctx context.Context, entity *types.Entity, event *types.Event) error
+}
+
+// deadline is a deadline stored in etcd, along with the entity and event
+// handed to the FailureHandler once it expires.
+type deadline struct {
+	Deadline time.Time     `json:"deadline"`
+	Key      string        `json:"key"`
+	Entity   *types.Entity `json:"entity"`
+	Event    *types.Event  `json:"event,omitempty"`
+}
+
+// EtcdSupervisor is a Supervisor which keeps the deadlines in etcd, so they
+// outlive the backend which set them.
+//
+// Each deadline is kept under a key sorted by time, so the expired ones are
+// looked up with a range up to now. The key of the entity, or of its check,
+// points to the current deadline, so the store removes the deadlines of the
+// entities and checks it deletes, and the deadline is deleted with it.
+//
+// The leader of the cluster looks up the expired deadlines and deletes them.
+// Only the backend whose delete succeeded handles the failure, so each
+// expiration is handled exactly once, even while the leadership changes hands.
+// Deadlines are compared to the clock of the leader, so the clocks of the
+// backends must be kept in sync.
+type EtcdSupervisor struct {
+	client    *clientv3.Client
+	name      string
+	keys      store.KeyBuilder
+	deadlines store.KeyBuilder
+	handler   FailureHandler
+	interval  time.Duration
+
+	mu       sync.Mutex
+	cancel   context.CancelFunc
+	sweeping sync.WaitGroup
+}
+
+// NewEtcdSupervisor creates a new EtcdSupervisor, which keeps its deadlines
+// under the given name and hands their failures to the handler.
+func NewEtcdSupervisor(client *clientv3.Client, name string, handler FailureHandler) *EtcdSupervisor {
+	return &EtcdSupervisor{
+		client:    client,
+		name:      name,
+		keys:      store.NewKeyBuilder(store.MonitorsPathPrefix),
+		deadlines: store.NewKeyBuilder(path.Join("deadlines", name)),
+		handler:   handler,
+		interval:  sweepInterval,
+	}
+}
+
+// Start starts sweeping the expired deadlines whenever this backend is the
+// leader of the cluster.
+func (s *EtcdSupervisor) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	go s.lead(ctx)
+
+	return nil
+}
+
+// Stop stops sweeping the expired deadlines, and waits for the failures being
+// handled.
+func (s *EtcdSupervisor) Stop() error {
+	s.mu.Lock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.mu.Unlock()
+
+	s.sweeping.Wait()
+	return nil
+}
+
+// Monitor sets the deadline of the entity, or of its check if the event has
+// one, to the given timeout from now.
+func (s *EtcdSupervisor) Monitor(ctx context.Context, entity *types.Entity, event *types.Event, timeout time.Duration) error {
+	key := s.key(entity, event)
+	d := deadline{
+		Deadline: time.Now().Add(timeout),
+		Key:      key,
+		Entity:   entity,
+		Event:    event,
+	}
+	value, err := json.Marshal(d)
+	if err != nil {
+		return err
+	}
+
+	deadlineKey := s.deadlineKey(d.Deadline, key)
+	return s.update(ctx, key, deadlineKey, clientv3.OpPut(key, deadlineKey), clientv3.OpPut(deadlineKey, string(value)))
+}
+
+// Remove removes the deadline of the entity, or of its check if the event has
+// one.
+func (s *EtcdSupervisor) Remove(ctx context.Context, entity *types.Entity, event *types.Event) error {
+	key := s.key(entity, event)
+	return s.update(ctx, key, "", clientv3.OpDelete(key))
+}
+
+// update applies the operations setting or removing the deadline of the key,
+// and deletes its previous deadline, unless the key changed meanwhile.
+func (s *EtcdSupervisor) update(ctx context.Context, key, deadlineKey string, ops ...clientv3.Op) error {
+	for {
+		resp, err := s.client.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+
+		var rev int64
+		txnOps := append([]clientv3.Op{}, ops...)
+		if len(resp.Kvs) > 0 {
+			rev = resp.Kvs[0].ModRevision
+			if previous := string(resp.Kvs[0].Value); previous != deadlineKey {
+				txnOps = append(txnOps, clientv3.OpDelete(previous))
+			}
+		}
+
+		txn, err := s.client.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(key), "=", rev)).
+			Then(txnOps...).
+			Commit()
+		if err != nil {
+			return err
+		}
+		if txn.Succeeded {
+			return nil
+		}
+	}
+}
+
+// key returns the key of the entity, or of its check if the event has one,
+// which points to its deadline.
+func (s *EtcdSupervisor) key(entity *types.Entity, event *types.Event) string {
+	keys := s.keys.WithResource(entity)
+	if event != nil && event.HasCheck() {
+		return keys.Build(entity.ID, s.name, event.Check.Name)
+	}
+	return keys.Build(entity.ID, s.name)
+}
+
+// deadlineKey returns the key of a deadline of the key, sorted by time.
+func (s *EtcdSupervisor) deadlineKey(t time.Time, key string) string {
+	return s.deadlines.Build(fmt.Sprintf("%020d", t.UnixNano())) + strings.TrimPrefix(key, s.keys.Build())
+}
+
+// lead sweeps the expired deadlines while this backend is the leader, until
+// the supervisor is stopped. Followers wait in leader.Do until they are
+// elected, or until the backend resigns.
+func (s *EtcdSupervisor) lead(ctx context.Context) {
+	for {
+		err := leader.Do(func(leaderCtx context.Context) error {
+			return s.sweep(ctx, leaderCtx)
+		})
+		if ctx.Err() != nil || err == leader.ErrResigned {
+			return
+		}
+		if err != nil && err != context.Canceled {
+			logger.WithError(err).Error("unable to sweep the expired deadlines")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(s.interval):
+		}
+	}
+}
+
+// sweep handles the expired deadlines every interval, until the supervisor is
+// stopped or the leadership is lost.
+func (s *EtcdSupervisor) sweep(ctx, leaderCtx context.Context) error {
+	s.mu.Lock()
+	if ctx.Err() != nil {
+		s.mu.Unlock()
+		return nil
+	}
+	s.sweeping.Add(1)
+	s.mu.Unlock()
+	defer s.sweeping.Done()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-leaderCtx.Done():
+			return leaderCtx.Err()
+		case <-ticker.C:
+		}
+
+		if err := s.expire(leaderCtx); err != nil {
+			logger.WithError(err).Error("unable to look up the expired deadlines")
+		}
+	}
+}
+
+// expire deletes the expired deadlines and handles their failure.
+func (s *EtcdSupervisor) expire(ctx context.Context) error {
+	resp, err := s.client.Get(ctx, s.deadlines.Build()+"/",
+		clientv3.WithRange(s.deadlines.Build(fmt.Sprintf("%020d", time.Now().UnixNano()))))
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range resp.Kvs {
+		deadlineKey := string(kv.Key)
+		var d deadline
+		if err := json.Unmarshal(kv.Value, &d); err != nil || d.Key == "" || d.Entity == nil {
+			logger.WithField("key", deadlineKey).Error("discarding invalid deadline")
+			if _, err := s.client.Delete(ctx, deadlineKey); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Claim the deadline, unless it was set again, removed or claimed
+		// meanwhile. A deadline its key no longer points to is dropped.
+		txn, err := s.client.Txn(ctx).
+			If(clientv3.Compare(clientv3.Value(d.Key), "=", deadlineKey)).
+			Then(clientv3.OpDelete(d.Key), clientv3.OpDelete(deadlineKey)).
+			Else(clientv3.OpDelete(deadlineKey)).
+			Commit()
+		if err != nil {
+			return err
+		}
+		if !txn.Succeeded {
+			continue
+		}
+
+		if err := s.handler.HandleFailure(d.Entity, d.Event); err != nil {
+			logger.WithError(err).WithField("entity", d.Entity.GetID()).Error("error handling expired deadline")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// +build integration,!race
+
+package monitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/etcd"
+	"github.com/sensu/sensu-go/backend/leader"
+	etcdstore "github.com/sensu/sensu-go/backend/store/etcd"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type failure struct {
+	entity *types.Entity
+	event  *types.Event
+}
+
+type failureHandler chan failure
+
+func (h failureHandler) HandleFailure(entity *types.Entity, event *types.Event) error {
+	h <- failure{entity: entity, event: event}
+	return nil
+}
+
+func TestEtcdSupervisor(t *testing.T) {
+	// Every supervisor sweeps the deadlines, as during a change of leadership
+	leader.Override()
+
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+
+	client, err := e.NewClient()
+	require.NoError(t, err)
+	defer client.Close()
+
+	failures := make(failureHandler, 10)
+	newSupervisor := func() *EtcdSupervisor {
+		s := NewEtcdSupervisor(client, "test", failures)
+		s.interval = 10 * time.Millisecond
+		return s
+	}
+	receive := func() failure {
+		select {
+		case f := <-failures:
+			return f
+		case <-time.After(5 * time.Second):
+			t.Fatal("no failure handled")
+			return failure{}
+		}
+	}
+	ctx := context.Background()
+
+	// The deadline outlives the backend which set it, and is handled once
+	s1 := newSupervisor()
+	entity := types.FixtureEntity("entity1")
+	require.NoError(t, s1.Monitor(ctx, entity, nil, 100*time.Millisecond))
+
+	for i := 0; i < 3; i++ {
+		s := newSupervisor()
+		require.NoError(t, s.Start())
+		defer s.Stop()
+	}
+
+	f := receive()
+	assert.Equal(t, entity, f.entity)
+	assert.Nil(t, f.event)
+
+	// The deadlines of the checks are handled with their event
+	event := types.FixtureEvent("entity1", "check1")
+	require.NoError(t, s1.Monitor(ctx, event.Entity, event, 100*time.Millisecond))
+	f = receive()
+	assert.Equal(t, event, f.event)
+
+	// Removed and pushed back deadlines don't expire
+	require.NoError(t, s1.Monitor(ctx, types.FixtureEntity("entity2"), nil, 100*time.Millisecond))
+	require.NoError(t, s1.Remove(ctx, types.FixtureEntity("entity2"), nil))
+	require.NoError(t, s1.Monitor(ctx, entity, nil, 100*time.Millisecond))
+	require.NoError(t, s1.Monitor(ctx, entity, nil, time.Hour))
+
+	// The deadlines of the entities and checks deleted from the store are
+	// removed
+	st := etcdstore.NewStore(client, "test")
+	entity3 := types.FixtureEntity("entity3")
+	require.NoError(t, s1.Monitor(ctx, entity3, nil, 100*time.Millisecond))
+	event3 := types.FixtureEvent("entity3", "check3")
+	require.NoError(t, s1.Monitor(ctx, event3.Entity, event3, 100*time.Millisecond))
+	require.NoError(t, st.DeleteEntity(ctx, entity3))
+
+	event4 := types.FixtureEvent("entity4", "check4")
+	require.NoError(t, s1.Monitor(ctx, event4.Entity, event4, 100*time.Millisecond))
+	require.NoError(t, s1.Monitor(ctx, event4.Entity, nil, time.Hour))
+	storeCtx := types.SetContextFromResource(ctx, event4.Entity)
+	require.NoError(t, st.DeleteCheckConfigByName(storeCtx, "check4"))
+
+	time.Sleep(300 * time.Millisecond)
+	assert.Len(t, failures, 0)
+
+	// Only the deadlines pushed back remain
+	resp, err := client.Get(ctx, s1.deadlines.Build()+"/", clientv3.WithPrefix())
+	require.NoError(t, err)
+	assert.Len(t, resp.Kvs, 2)
+}
@@ -0,0 +1,81 @@
+package pipelined
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sensu/sensu-go/agent/assetmanager"
+	"github.com/sensu/sensu-go/system"
+	"github.com/sensu/sensu-go/types"
+)
+
+// newAssetManager returns the asset manager used to install the runtime assets
+// of handlers and mutators in cacheDir. Asset filters are evaluated against an
+// entity describing the backend.
+func newAssetManager(cacheDir string) *assetmanager.Manager {
+	entity := &types.Entity{
+		Class: types.EntityBackendClass,
+	}
+
+	info, err := system.Info()
+	if err != nil {
+		logger.WithError(err).Error("error getting system info")
+	}
+	entity.System = info
+
+	entity.ID = info.Hostname
+	if entity.ID == "" {
+		entity.ID, _ = os.Hostname()
+	}
+
+	return assetmanager.New(cacheDir, entity)
+}
+
+// assetsEnv installs the given runtime assets of the event organization, and
+// returns the environment of a command depending on them: the system
+// environment, with the assets injected into PATH, LD_LIBRARY_PATH & CPATH,
+// followed by envVars. envVars is returned as is if there are no assets.
+func (p *Pipelined) assetsEnv(event *types.Event, names []string, envVars []string) ([]string, error) {
+	if len(names) == 0 {
+		return envVars, nil
+	}
+
+	ctx := types.SetContextFromResource(context.Background(), event.Entity)
+	allAssets, err := p.store.GetAssets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var assets []types.Asset
+	for _, asset := range allAssets {
+		if assetIsRelevant(asset, names) {
+			assets = append(assets, *asset)
+		}
+	}
+
+	set := p.assetManager.RegisterSet(assets)
+	if err := set.InstallAll(); err != nil {
+		return nil, fmt.Errorf("error installing runtime assets: %s", err)
+	}
+
+	// The environment of the set is shared, so it must not be appended to
+	setEnv := set.Env()
+	env := make([]string, 0, len(setEnv)+len(envVars))
+	env = append(env, setEnv...)
+
+	return append(env, envVars...), nil
+}
+
+// assetIsRelevant returns true if the asset matches one of the names, which
+// are asset name prefixes like the runtime assets of checks.
+func assetIsRelevant(asset *types.Asset, names []string) bool {
+	for _, name := range names {
+		if strings.HasPrefix(asset.Name, name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,120 @@
+package pipelined
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/sha512"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// newAssetPipelined returns a Pipelined whose store holds an asset providing
+// the asset-hello command.
+func newAssetPipelined(t *testing.T) (*Pipelined, func()) {
+	var archive bytes.Buffer
+	script := []byte("#!/bin/sh\necho hello\n")
+	tw := tar.NewWriter(&archive)
+	require.NoError(t, tw.WriteHeader(&tar.Header{
+		Name: "bin/asset-hello",
+		Mode: 0755,
+		Size: int64(len(script)),
+	}))
+	_, err := tw.Write(script)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(archive.Bytes())
+	}))
+
+	sum := sha512.Sum512(archive.Bytes())
+	asset := types.FixtureAsset("hello-1.0")
+	asset.URL = server.URL
+	asset.Sha512 = hex.EncodeToString(sum[:])
+
+	store := &mockstore.MockStore{}
+	store.On("GetAssets", mock.Anything).Return([]*types.Asset{asset}, nil)
+
+	cacheDir, err := ioutil.TempDir("", "pipelined-assets")
+	require.NoError(t, err)
+
+	p := &Pipelined{store: store, assetManager: newAssetManager(cacheDir)}
+	return p, func() {
+		server.Close()
+		_ = os.RemoveAll(cacheDir)
+	}
+}
+
+func TestPipelinedPipeHandlerRuntimeAssets(t *testing.T) {
+	p, cleanup := newAssetPipelined(t)
+	defer cleanup()
+
+	handler := types.FixtureHandler("handler1")
+	handler.Command = "asset-hello"
+	handler.RuntimeAssets = []string{"hello"}
+
+	event := types.FixtureEvent("entity1", "check1")
+	result, err := p.pipeHandler(handler, event, []byte("{}"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello\n", result.Output)
+}
+
+func TestPipelinedPipeMutatorRuntimeAssets(t *testing.T) {
+	p, cleanup := newAssetPipelined(t)
+	defer cleanup()
+
+	mutator := types.FixtureMutator("mutator1")
+	mutator.Command = "asset-hello"
+	mutator.RuntimeAssets = []string{"hello"}
+	mutator.EnvVars = []string{"FOO=bar"}
+
+	event := types.FixtureEvent("entity1", "check1")
+	output, err := p.pipeMutator(mutator, event)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello\n"), output)
+}
+
+func TestPipelinedAssetsEnv(t *testing.T) {
+	p, cleanup := newAssetPipelined(t)
+	defer cleanup()
+
+	event := types.FixtureEvent("entity1", "check1")
+
+	// Without runtime assets, the env vars are used as is
+	env, err := p.assetsEnv(event, nil, []string{"FOO=bar"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"FOO=bar"}, env)
+
+	// The env vars follow the environment of the assets
+	env, err = p.assetsEnv(event, []string{"hello"}, []string{"FOO=bar"})
+	require.NoError(t, err)
+	assert.Equal(t, "FOO=bar", env[len(env)-1])
+
+	var path string
+	for _, e := range env {
+		if strings.HasPrefix(e, "PATH=") {
+			path = e
+		}
+	}
+	assert.Contains(t, path, "/bin")
+
+	// Assets that fail to be installed are reported
+	asset := types.FixtureAsset("hello-2.0")
+	asset.URL = "http://127.0.0.1:1/hello.tar"
+	store := &mockstore.MockStore{}
+	store.On("GetAssets", mock.Anything).Return([]*types.Asset{asset}, nil)
+	p.store = store
+	_, err = p.assetsEnv(event, []string{"hello"}, nil)
+	assert.Error(t, err)
+}
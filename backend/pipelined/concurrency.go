@@ -0,0 +1,217 @@
+package pipelined
+
+import (
+	"path"
+	"sync"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sensu/sensu-go/types"
+)
+
+// DefaultHandlerQueueSize is the number of events that can wait for a handler
+// with a concurrency limit, unless its queue size is configured.
+const DefaultHandlerQueueSize = 100
+
+var (
+	handlerQueueDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "sensu_handler_queue_depth",
+			Help: "Number of events waiting for a handler",
+		},
+		[]string{"organization", "environment", "handler"},
+	)
+
+	handlerQueueDropped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "sensu_handler_queue_dropped_total",
+			Help: "Number of events dropped because the queue of a handler was full",
+		},
+		[]string{"organization", "environment", "handler"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(handlerQueueDepth, handlerQueueDropped)
+}
+
+// handlerJob is an execution of a handler waiting in its queue.
+type handlerJob struct {
+	handler   *types.Handler
+	event     *types.Event
+	eventData []byte
+}
+
+// handlerQueue queues the executions of a handler, which are run by a limited
+// number of workers.
+type handlerQueue struct {
+	config  types.HandlerConcurrency
+	jobs    chan handlerJob
+	depth   prometheus.Gauge
+	dropped prometheus.Counter
+
+	// mu prevents the jobs channel from being closed while jobs are pushed
+	mu     sync.RWMutex
+	closed bool
+}
+
+// push adds the job to the queue, applying the overflow policy if the queue
+// is full. It returns false if the queue is closed.
+func (q *handlerQueue) push(job handlerJob) bool {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+
+	if q.closed {
+		return false
+	}
+
+	q.depth.Inc()
+
+	switch q.config.OverflowPolicy {
+	case types.OverflowPolicyBlock:
+		q.jobs <- job
+	case types.OverflowPolicyDropOldest:
+		for {
+			select {
+			case q.jobs <- job:
+				return true
+			default:
+			}
+
+			// Make room for the job
+			select {
+			case oldest := <-q.jobs:
+				q.drop(oldest)
+			default:
+			}
+		}
+	default:
+		select {
+		case q.jobs <- job:
+		default:
+			q.drop(job)
+		}
+	}
+
+	return true
+}
+
+// drop discards a job which could not be queued.
+func (q *handlerQueue) drop(job handlerJob) {
+	q.depth.Dec()
+	q.dropped.Inc()
+
+	logger.WithFields(logrus.Fields{
+		"handler": job.handler.Name,
+		"policy":  q.config.OverflowPolicy,
+	}).Warn("handler queue is full, dropping event")
+}
+
+// close stops accepting jobs. The workers exit once the queued jobs are
+// executed.
+func (q *handlerQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if !q.closed {
+		q.closed = true
+		close(q.jobs)
+	}
+}
+
+// handlerQueues holds the queues of the handlers having a concurrency limit.
+type handlerQueues struct {
+	mu      sync.Mutex
+	queues  map[string]*handlerQueue
+	workers sync.WaitGroup
+}
+
+// get returns the queue of the handler, creating it and starting its workers
+// if needed. The queue is replaced if the concurrency settings of the handler
+// changed.
+func (h *handlerQueues) get(p *Pipelined, handler *types.Handler) *handlerQueue {
+	key := path.Join(handler.Organization, handler.Environment, handler.Name)
+
+	h.mu.Lock()
+	q, ok := h.queues[key]
+	if ok && q.config.Equal(handler.Concurrency) {
+		h.mu.Unlock()
+		return q
+	}
+
+	old := q
+	q = h.newQueue(p, handler)
+	if h.queues == nil {
+		h.queues = make(map[string]*handlerQueue)
+	}
+	h.queues[key] = q
+	h.mu.Unlock()
+
+	// The workers of the previous queue execute its jobs before exiting
+	if old != nil {
+		old.close()
+	}
+
+	return q
+}
+
+func (h *handlerQueues) newQueue(p *Pipelined, handler *types.Handler) *handlerQueue {
+	size := int(handler.Concurrency.QueueSize)
+	if size == 0 {
+		size = DefaultHandlerQueueSize
+	}
+
+	labels := prometheus.Labels{
+		"organization": handler.Organization,
+		"environment":  handler.Environment,
+		"handler":      handler.Name,
+	}
+
+	q := &handlerQueue{
+		config:  *handler.Concurrency,
+		jobs:    make(chan handlerJob, size),
+		depth:   handlerQueueDepth.With(labels),
+		dropped: handlerQueueDropped.With(labels),
+	}
+
+	for i := 0; i < int(q.config.Limit); i++ {
+		h.workers.Add(1)
+		go func() {
+			defer h.workers.Done()
+			for job := range q.jobs {
+				q.depth.Dec()
+				p.executeHandler(job.handler, job.event, job.eventData)
+			}
+		}()
+	}
+
+	return q
+}
+
+// stop closes all the queues and waits for their workers to execute the
+// queued jobs.
+func (h *handlerQueues) stop() {
+	h.mu.Lock()
+	for key, q := range h.queues {
+		q.close()
+		delete(h.queues, key)
+	}
+	h.mu.Unlock()
+
+	h.workers.Wait()
+}
+
+// dispatchHandler executes the handler with the mutated eventData. Handlers
+// with a concurrency limit are executed by the workers of their queue, others
+// are executed by the calling pipeline.
+func (p *Pipelined) dispatchHandler(handler *types.Handler, event *types.Event, eventData []byte) {
+	if handler.Concurrency == nil || handler.Concurrency.Limit == 0 {
+		p.executeHandler(handler, event, eventData)
+		return
+	}
+
+	job := handlerJob{handler: handler, event: event, eventData: eventData}
+	for !p.queues.get(p, handler).push(job) {
+		// The queue was replaced in the meantime, use the new one
+	}
+}
@@ -0,0 +1,182 @@
+package pipelined
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHandlerQueue(policy string, size int) *handlerQueue {
+	labels := prometheus.Labels{
+		"organization": "default",
+		"environment":  "default",
+		"handler":      "test-" + policy,
+	}
+	return &handlerQueue{
+		config:  types.HandlerConcurrency{Limit: 1, OverflowPolicy: policy},
+		jobs:    make(chan handlerJob, size),
+		depth:   handlerQueueDepth.With(labels),
+		dropped: handlerQueueDropped.With(labels),
+	}
+}
+
+func testJob(name string) handlerJob {
+	return handlerJob{
+		handler: types.FixtureHandler("handler1"),
+		event:   types.FixtureEvent("entity1", name),
+	}
+}
+
+func queuedChecks(q *handlerQueue) []string {
+	q.close()
+	names := []string{}
+	for job := range q.jobs {
+		names = append(names, job.event.Check.Name)
+	}
+	return names
+}
+
+func metricValue(t *testing.T, m prometheus.Metric) float64 {
+	var metric dto.Metric
+	require.NoError(t, m.Write(&metric))
+	if metric.Gauge != nil {
+		return metric.Gauge.GetValue()
+	}
+	return metric.Counter.GetValue()
+}
+
+func TestHandlerQueueOverflowPolicies(t *testing.T) {
+	testCases := []struct {
+		policy   string
+		expected []string
+	}{
+		{
+			policy:   types.OverflowPolicyDropNewest,
+			expected: []string{"check1", "check2"},
+		},
+		{
+			policy:   types.OverflowPolicyDropOldest,
+			expected: []string{"check2", "check3"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.policy, func(t *testing.T) {
+			q := newTestHandlerQueue(tc.policy, 2)
+			for _, name := range []string{"check1", "check2", "check3"} {
+				assert.True(t, q.push(testJob(name)))
+			}
+
+			assert.Equal(t, float64(2), metricValue(t, q.depth))
+			assert.Equal(t, float64(1), metricValue(t, q.dropped))
+			assert.Equal(t, tc.expected, queuedChecks(q))
+
+			// Closed queues do not accept jobs
+			assert.False(t, q.push(testJob("check4")))
+		})
+	}
+}
+
+func TestHandlerQueueBlockPolicy(t *testing.T) {
+	q := newTestHandlerQueue(types.OverflowPolicyBlock, 1)
+	require.True(t, q.push(testJob("check1")))
+
+	pushed := make(chan struct{})
+	go func() {
+		q.push(testJob("check2"))
+		close(pushed)
+	}()
+
+	select {
+	case <-pushed:
+		t.Fatal("push should block while the queue is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	job := <-q.jobs
+	q.depth.Dec()
+	assert.Equal(t, "check1", job.event.Check.Name)
+	<-pushed
+
+	assert.Equal(t, []string{"check2"}, queuedChecks(q))
+	assert.Equal(t, float64(0), metricValue(t, q.dropped))
+}
+
+func TestPipelinedHandlerConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	var running, maxRunning, handled int
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		<-release
+
+		mu.Lock()
+		running--
+		handled++
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	p := &Pipelined{}
+	handler := types.FixtureHTTPHandler("handler1", server.URL)
+	handler.Concurrency = &types.HandlerConcurrency{Limit: 2, QueueSize: 10}
+
+	for i := 0; i < 5; i++ {
+		p.dispatchHandler(handler, types.FixtureEvent("entity1", "check1"), []byte("event"))
+	}
+
+	// Let the workers start executing the handler
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	p.queues.stop()
+
+	assert.Equal(t, 2, maxRunning)
+	assert.Equal(t, 5, handled)
+}
+
+func TestPipelinedHandlerConcurrencyChange(t *testing.T) {
+	p := &Pipelined{}
+	handler := types.FixtureHandler("handler1")
+	handler.Concurrency = &types.HandlerConcurrency{Limit: 1}
+
+	q := p.queues.get(p, handler)
+	assert.Equal(t, q, p.queues.get(p, handler))
+
+	// The queue is replaced when the settings of the handler change
+	handler.Concurrency = &types.HandlerConcurrency{Limit: 2}
+	replaced := p.queues.get(p, handler)
+	assert.NotEqual(t, q, replaced)
+	assert.False(t, q.push(testJob("check1")))
+
+	p.queues.stop()
+}
+
+func TestHandlerQueueMetrics(t *testing.T) {
+	p := &Pipelined{}
+	handler := types.FixtureHandler("handler-metrics")
+	handler.Concurrency = &types.HandlerConcurrency{Limit: 1}
+	p.queues.get(p, handler)
+	defer p.queues.stop()
+
+	// The metrics of the queue are exposed as soon as it is created
+	w := httptest.NewRecorder()
+	promhttp.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	labels := `{environment="default",handler="handler-metrics",organization="default"}`
+	assert.Contains(t, w.Body.String(), "sensu_handler_queue_depth"+labels+" 0")
+	assert.Contains(t, w.Body.String(), "sensu_handler_queue_dropped_total"+labels+" 0")
+}
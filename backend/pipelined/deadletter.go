@@ -0,0 +1,116 @@
+package pipelined
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/google/uuid"
+	"github.com/sensu/sensu-go/types"
+)
+
+// deadLetter adds the event that the handler failed to process to the
+// dead-letter queue.
+func (p *Pipelined) deadLetter(handler *types.Handler, event *types.Event, attempts int, err error) {
+	if p.deadLetters == nil {
+		return
+	}
+
+	letter := &types.DeadLetter{
+		Name:      uuid.New().String(),
+		Handler:   handler.Name,
+		Attempts:  uint32(attempts),
+		Message:   err.Error(),
+		Timestamp: time.Now().Unix(),
+		Event:     *event,
+	}
+
+	value, err := json.Marshal(letter)
+	if err != nil {
+		logger.WithError(err).Error("pipelined failed to encode a dead letter")
+		return
+	}
+
+	if err := p.deadLetters.Enqueue(context.Background(), string(value)); err != nil {
+		logger.WithError(err).Error("pipelined failed to add an event to the dead-letter queue")
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"handler":  handler.Name,
+		"attempts": attempts,
+		"name":     letter.Name,
+	}).Warn("event added to the dead-letter queue")
+}
+
+// replayDeadLetters executes again the handlers of the dead letters added to
+// the replay queue, until ctx is canceled.
+func (p *Pipelined) replayDeadLetters(ctx context.Context) {
+	defer p.wg.Done()
+
+	for {
+		item, err := p.replays.Dequeue(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			logger.WithError(err).Error("pipelined failed to dequeue a dead letter to replay")
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+
+		var letter types.DeadLetter
+		if err := json.Unmarshal([]byte(item.Value()), &letter); err != nil {
+			logger.WithError(err).Error("pipelined failed to decode a dead letter to replay")
+		} else {
+			p.replayDeadLetter(&letter)
+		}
+
+		if err := item.Ack(ctx); err != nil {
+			logger.WithError(err).Error("pipelined failed to acknowledge a replayed dead letter")
+		}
+	}
+}
+
+// replayDeadLetter mutates the event of the dead letter and executes its
+// handler again. The event does not go through the handler filters again.
+func (p *Pipelined) replayDeadLetter(letter *types.DeadLetter) {
+	event := &letter.Event
+	if err := letter.Validate(); err != nil {
+		logger.WithError(err).Error("pipelined failed to replay an invalid dead letter")
+		return
+	}
+
+	ctx := types.SetContextFromResource(context.Background(), event.Entity)
+	handler, err := p.store.GetHandlerByName(ctx, letter.Handler)
+	if err == nil && handler == nil {
+		err = errors.New("handler not found")
+	}
+	if err != nil {
+		p.recordError(event, ComponentHandler, letter.Handler, err)
+		return
+	}
+
+	eventData, err := p.mutateEvent(handler, event)
+	if err != nil {
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"handler": handler.Name,
+		"name":    letter.Name,
+	}).Info("replaying dead letter")
+
+	switch handler.Type {
+	case "pipe", "tcp", "udp", "grpc", "http":
+		p.executeHandler(handler, event, eventData)
+	default:
+		p.recordError(event, ComponentHandler, handler.Name, errors.New("unknown handler type"))
+	}
+}
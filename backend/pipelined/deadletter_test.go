@@ -0,0 +1,202 @@
+package pipelined
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/backend/queue"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newDeadLetterPipelined(store *mockstore.MockStore) *Pipelined {
+	getter := queue.NewMemoryGetter()
+	return &Pipelined{
+		store:       store,
+		stopping:    make(chan struct{}),
+		wg:          &sync.WaitGroup{},
+		deadLetters: getter.GetQueue(types.DeadLetterQueueName),
+		replays:     getter.GetQueue(types.DeadLetterReplayQueueName),
+		// Don't wait between the retries of the handlers
+		initialBackoff: time.Millisecond,
+	}
+}
+
+func listDeadLetters(t *testing.T, p *Pipelined) []*types.DeadLetter {
+	values, err := p.deadLetters.List(context.Background())
+	require.NoError(t, err)
+
+	letters := []*types.DeadLetter{}
+	for _, value := range values {
+		letter := &types.DeadLetter{}
+		require.NoError(t, json.Unmarshal([]byte(value), letter))
+		letters = append(letters, letter)
+	}
+	return letters
+}
+
+func TestPipelinedHandlerRetries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pipelined")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	store := &mockstore.MockStore{}
+	p := newDeadLetterPipelined(store)
+
+	handler := types.FakeHandlerCommand("flaky")
+	handler.Name = "handler1"
+	handler.Type = "pipe"
+	handler.EnvVars = append(handler.EnvVars, "FLAKY_HANDLER_FILE="+filepath.Join(dir, "flaky"))
+	handler.RetryPolicy = &types.HandlerRetryPolicy{MaxAttempts: 3}
+	event := types.FixtureEvent("entity1", "check1")
+
+	// The first execution fails and the second one succeeds
+	p.executeHandler(handler, event, []byte("event"))
+	p.wg.Wait()
+	store.AssertNotCalled(t, "CreateError", mock.Anything)
+	assert.Empty(t, listDeadLetters(t, p))
+}
+
+func TestPipelinedHandlerDeadLetters(t *testing.T) {
+	testCases := []struct {
+		name             string
+		policy           *types.HandlerRetryPolicy
+		expectedAttempts uint32
+		expectedLetters  int
+	}{
+		{
+			name:            "Without Retry Policy",
+			expectedLetters: 0,
+		},
+		{
+			name:             "Retryable Exit Code",
+			policy:           &types.HandlerRetryPolicy{MaxAttempts: 3},
+			expectedAttempts: 3,
+			expectedLetters:  1,
+		},
+		{
+			name: "Non-Retryable Exit Code",
+			policy: &types.HandlerRetryPolicy{
+				MaxAttempts:        3,
+				RetryableExitCodes: []uint32{2},
+			},
+			expectedAttempts: 1,
+			expectedLetters:  1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockstore.MockStore{}
+			store.On("CreateError", mock.Anything).Return(nil)
+			p := newDeadLetterPipelined(store)
+
+			handler := types.FakeHandlerCommand("fail")
+			handler.Name = "handler1"
+			handler.Type = "pipe"
+			handler.RetryPolicy = tc.policy
+			event := types.FixtureEvent("entity1", "check1")
+
+			p.executeHandler(handler, event, []byte("event"))
+			p.wg.Wait()
+			store.AssertNumberOfCalls(t, "CreateError", 1)
+
+			letters := listDeadLetters(t, p)
+			require.Len(t, letters, tc.expectedLetters)
+			if tc.expectedLetters > 0 {
+				assert.NotEmpty(t, letters[0].Name)
+				assert.Equal(t, "handler1", letters[0].Handler)
+				assert.Equal(t, tc.expectedAttempts, letters[0].Attempts)
+				assert.Equal(t, "pipe handler execution returned non-zero exit status 1", letters[0].Message)
+				assert.Equal(t, "check1", letters[0].Event.Check.Name)
+			}
+		})
+	}
+}
+
+func TestPipelinedHandlerRetriesStopped(t *testing.T) {
+	store := &mockstore.MockStore{}
+	store.On("CreateError", mock.Anything).Return(nil)
+	p := newDeadLetterPipelined(store)
+
+	handler := types.FakeHandlerCommand("fail")
+	handler.Name = "handler1"
+	handler.Type = "pipe"
+	handler.RetryPolicy = &types.HandlerRetryPolicy{MaxAttempts: 3, InitialBackoff: 60}
+	event := types.FixtureEvent("entity1", "check1")
+
+	// The pipeline is not held up by the backoff, and stopping pipelined
+	// interrupts it
+	p.executeHandler(handler, event, []byte("event"))
+	close(p.stopping)
+	p.wg.Wait()
+
+	letters := listDeadLetters(t, p)
+	require.Len(t, letters, 1)
+	assert.Equal(t, uint32(1), letters[0].Attempts)
+}
+
+func TestPipelinedReplayDeadLetter(t *testing.T) {
+	store := &mockstore.MockStore{}
+	store.On("CreateError", mock.Anything).Return(nil)
+	p := newDeadLetterPipelined(store)
+
+	handler := types.FakeHandlerCommand("cat")
+	handler.Name = "handler1"
+	handler.Type = "pipe"
+	store.On("GetHandlerByName", mock.Anything, "handler1").Return(handler, nil)
+
+	var nilHandler *types.Handler
+	store.On("GetHandlerByName", mock.Anything, "handler2").Return(nilHandler, nil)
+
+	// The handler succeeds
+	p.replayDeadLetter(types.FixtureDeadLetter("letter1", "handler1"))
+	store.AssertNotCalled(t, "CreateError", mock.Anything)
+
+	// The handler no longer exists
+	p.replayDeadLetter(types.FixtureDeadLetter("letter2", "handler2"))
+	store.AssertNumberOfCalls(t, "CreateError", 1)
+	assert.Empty(t, listDeadLetters(t, p))
+}
+
+func TestPipelinedReplayDeadLetters(t *testing.T) {
+	store := &mockstore.MockStore{}
+	p := newDeadLetterPipelined(store)
+	p.wg = &sync.WaitGroup{}
+
+	handler := types.FakeHandlerCommand("cat")
+	handler.Name = "handler1"
+	handler.Type = "pipe"
+	store.On("GetHandlerByName", mock.Anything, "handler1").Return(handler, nil)
+
+	value, err := json.Marshal(types.FixtureDeadLetter("letter1", "handler1"))
+	require.NoError(t, err)
+	require.NoError(t, p.replays.Enqueue(context.Background(), string(value)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.wg.Add(1)
+	go p.replayDeadLetters(ctx)
+
+	// Wait for the dead letter to be dequeued, then for its replay
+	for i := 0; i < 100; i++ {
+		values, err := p.replays.List(context.Background())
+		require.NoError(t, err)
+		if len(values) == 0 {
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	cancel()
+	p.wg.Wait()
+	store.AssertCalled(t, "GetHandlerByName", mock.Anything, "handler1")
+}
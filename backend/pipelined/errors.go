@@ -0,0 +1,41 @@
+package pipelined
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sensu/sensu-go/types"
+)
+
+const (
+	// ComponentFilter identifies errors raised while filtering an event.
+	ComponentFilter = "filter"
+
+	// ComponentMutator identifies errors raised while mutating an event.
+	ComponentMutator = "mutator"
+
+	// ComponentHandler identifies errors raised while handling an event.
+	ComponentHandler = "handler"
+)
+
+// recordError logs a failure of the named pipeline component and persists it
+// in the store, along with the event being processed, so it can be inspected
+// later on through the API.
+func (p *Pipelined) recordError(event *types.Event, component, name string, err error) {
+	logger.WithError(err).WithField(component, name).Errorf("pipelined %s failed", component)
+
+	perr := &types.Error{
+		Name:      uuid.New().String(),
+		Component: component,
+		Message:   fmt.Sprintf("%s %s: %s", component, name, err),
+		Timestamp: time.Now().Unix(),
+		Event:     *event,
+	}
+
+	ctx := types.SetContextFromResource(context.Background(), event.Entity)
+	if err := p.store.CreateError(ctx, perr); err != nil {
+		logger.WithError(err).Error("pipelined failed to record an error")
+	}
+}
@@ -0,0 +1,77 @@
+package pipelined
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/rpc"
+	"github.com/sensu/sensu-go/types"
+)
+
+// extensionExecutor returns an executor for the extension registered with the
+// given name in the organization of the event entity.
+func (p *Pipelined) extensionExecutor(event *types.Event, name string) (rpc.ExtensionExecutor, error) {
+	ctx := types.SetContextFromResource(context.Background(), event.Entity)
+	ext, err := p.store.GetExtension(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.extensions.Get(ext)
+}
+
+// grpcHandler sends the event, along with the mutated eventData, to the
+// extension of a Sensu grpc handler.
+func (p *Pipelined) grpcHandler(handler *types.Handler, event *types.Event, eventData []byte) error {
+	executor, err := p.extensionExecutor(event, handler.Extension)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if handler.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(handler.Timeout)*time.Second)
+		defer cancel()
+	}
+
+	if err := executor.HandleEvent(ctx, event, eventData); err != nil {
+		return err
+	}
+
+	logger.WithFields(logrus.Fields{
+		"handler":   handler.Name,
+		"extension": handler.Extension,
+	}).Info("pipelined executed event grpc handler")
+
+	return nil
+}
+
+// extensionMutator mutates the event with the MutateEvent RPC of the
+// extension registered with the given mutator name.
+func (p *Pipelined) extensionMutator(name string, event *types.Event) ([]byte, error) {
+	executor, err := p.extensionExecutor(event, name)
+	if err == store.ErrNoExtension {
+		return nil, errors.New("mutator not found")
+	} else if err != nil {
+		return nil, err
+	}
+
+	return executor.MutateEvent(context.Background(), event)
+}
+
+// extensionFilter returns true if the FilterEvent RPC of the extension
+// registered with the given filter name filters out the event.
+func (p *Pipelined) extensionFilter(name string, event *types.Event) (bool, error) {
+	executor, err := p.extensionExecutor(event, name)
+	if err == store.ErrNoExtension {
+		return false, errors.New("filter not found")
+	} else if err != nil {
+		return false, err
+	}
+
+	return executor.FilterEvent(context.Background(), event)
+}
@@ -0,0 +1,100 @@
+package pipelined
+
+import (
+	"net"
+	"testing"
+
+	"github.com/sensu/sensu-go/rpc"
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type testExtension struct {
+	handled chan *rpc.HandleEventRequest
+}
+
+func (e *testExtension) HandleEvent(ctx context.Context, req *rpc.HandleEventRequest) (*rpc.HandleEventResponse, error) {
+	e.handled <- req
+	if req.Event.Check.Status != 0 {
+		return &rpc.HandleEventResponse{Error: "check is failing"}, nil
+	}
+	return &rpc.HandleEventResponse{}, nil
+}
+
+func (e *testExtension) MutateEvent(ctx context.Context, req *rpc.MutateEventRequest) (*rpc.MutateEventResponse, error) {
+	return &rpc.MutateEventResponse{MutatedEvent: []byte(req.Event.Check.Name)}, nil
+}
+
+func (e *testExtension) FilterEvent(ctx context.Context, req *rpc.FilterEventRequest) (*rpc.FilterEventResponse, error) {
+	return &rpc.FilterEventResponse{Filtered: req.Event.Check.Name == "filtered"}, nil
+}
+
+func newExtensionPipelined(t *testing.T) (*Pipelined, *mockstore.MockStore, *testExtension, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	impl := &testExtension{handled: make(chan *rpc.HandleEventRequest, 10)}
+	server := grpc.NewServer()
+	rpc.RegisterExtensionServer(server, impl)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	ext := types.FixtureExtension("extension1")
+	ext.URL = "http://" + lis.Addr().String()
+
+	store := &mockstore.MockStore{}
+	store.On("GetExtension", mock.Anything, "extension1").Return(ext, nil)
+	store.On("CreateError", mock.Anything).Return(nil)
+
+	p := &Pipelined{store: store, extensions: rpc.NewExtensionPool()}
+	return p, store, impl, func() {
+		_ = p.extensions.Close()
+		server.Stop()
+	}
+}
+
+func TestPipelinedGRPCHandler(t *testing.T) {
+	p, store, impl, stop := newExtensionPipelined(t)
+	defer stop()
+
+	handler := types.FixtureGRPCHandler("handler1", "extension1")
+	handler.Mutator = "extension1"
+	store.On("GetMutatorByName", mock.Anything, "extension1").Return((*types.Mutator)(nil), nil)
+	store.On("GetHandlerByName", mock.Anything, "handler1").Return(handler, nil)
+
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.Handlers = []string{"handler1"}
+
+	require.NoError(t, p.handleEvent(event))
+	req := <-impl.handled
+	assert.Equal(t, "check1", req.Event.Check.Name)
+	assert.Equal(t, []byte("check1"), req.MutatedEvent)
+	store.AssertNotCalled(t, "CreateError", mock.Anything)
+
+	// Errors returned by the extension are recorded
+	event.Check.Status = 2
+	require.NoError(t, p.handleEvent(event))
+	<-impl.handled
+	store.AssertCalled(t, "CreateError", mock.MatchedBy(func(perr *types.Error) bool {
+		return perr.Component == ComponentHandler && perr.Message == "handler handler1: check is failing"
+	}))
+}
+
+func TestPipelinedExtensionFilter(t *testing.T) {
+	p, store, _, stop := newExtensionPipelined(t)
+	defer stop()
+
+	store.On("GetEventFilterByName", mock.Anything, "extension1").Return((*types.EventFilter)(nil), nil)
+
+	handler := types.FixtureHandler("handler1")
+	handler.Filters = []string{"extension1"}
+
+	assert.True(t, p.filterEvent(handler, types.FixtureEvent("entity1", "filtered")))
+	assert.False(t, p.filterEvent(handler, types.FixtureEvent("entity1", "check1")))
+}
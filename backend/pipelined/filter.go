@@ -3,6 +3,7 @@ package pipelined
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/Sirupsen/logrus"
@@ -10,11 +11,12 @@ import (
 	"github.com/sensu/sensu-go/util/eval"
 )
 
-func evaluateEventFilterStatement(event *types.Event, statement string) bool {
+func (p *Pipelined) evaluateEventFilterStatement(event *types.Event, filter *types.EventFilter, statement string) bool {
 	parameters := map[string]interface{}{"event": event}
 	result, err := eval.EvaluatePredicate(statement, parameters)
 	if err != nil {
-		logger.WithError(err).Errorf("statement '%s' is invalid", statement)
+		err = fmt.Errorf("statement '%s' is invalid: %s", statement, err)
+		p.recordError(event, ComponentFilter, filter.Name, err)
 		return false
 	}
 
@@ -22,11 +24,11 @@ func evaluateEventFilterStatement(event *types.Event, statement string) bool {
 }
 
 // Returns true if the event should be filtered.
-func evaluateEventFilter(event *types.Event, filter *types.EventFilter) bool {
+func (p *Pipelined) evaluateEventFilter(event *types.Event, filter *types.EventFilter) bool {
 	if filter.When != nil {
 		inWindows, err := filter.When.InWindows(time.Now().UTC())
 		if err != nil {
-			logger.WithField("filter", filter.Name).Error(err)
+			p.recordError(event, ComponentFilter, filter.Name, err)
 			return false
 		}
 
@@ -40,7 +42,7 @@ func evaluateEventFilter(event *types.Event, filter *types.EventFilter) bool {
 	}
 
 	for _, statement := range filter.Statements {
-		match := evaluateEventFilterStatement(event, statement)
+		match := p.evaluateEventFilterStatement(event, filter, statement)
 
 		// Allow - One of the statements did not match, filter the event
 		if filter.Action == types.EventFilterActionAllow && !match {
@@ -111,14 +113,28 @@ func (p *Pipelined) filterEvent(handler *types.Handler, event *types.Event) bool
 		ctx := types.SetContextFromResource(context.Background(), event.Entity)
 		filter, err := p.store.GetEventFilterByName(ctx, filterName)
 		if err != nil {
-			logger.WithError(err).Warningf("could not retrieve the filter %s", filterName)
+			p.recordError(event, ComponentFilter, filterName, err)
 			return false
 		}
+		if filter == nil {
+			// Use the extension registered with that name, if there is no
+			// such filter
+			filtered, err := p.extensionFilter(filterName, event)
+			if err != nil {
+				p.recordError(event, ComponentFilter, filterName, err)
+				return false
+			}
+			if filtered {
+				return true
+			}
+
+			continue
+		}
 
 		// Evaluated the filter, evaluating each of its
 		// statements against the event. The event is rejected
 		// if the product of all statements is true.
-		filtered := evaluateEventFilter(event, filter)
+		filtered := p.evaluateEventFilter(event, filter)
 		if filtered {
 			return true
 		}
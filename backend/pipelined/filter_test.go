@@ -2,9 +2,11 @@
 package pipelined
 
 import (
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/sensu/sensu-go/backend/store"
 	"github.com/sensu/sensu-go/testing/mockstore"
 	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
@@ -281,3 +283,57 @@ func TestPipelinedWhenFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestPipelinedFilterRecordsErrors(t *testing.T) {
+	p := &Pipelined{}
+	mockStore := &mockstore.MockStore{}
+	p.store = mockStore
+
+	event := types.FixtureEvent("entity1", "check1")
+
+	var nilFilter *types.EventFilter
+	invalidFilter := &types.EventFilter{
+		Name:       "invalid",
+		Action:     types.EventFilterActionDeny,
+		Statements: []string{`event.Check.Output ==`},
+	}
+	mockStore.On("GetEventFilterByName", mock.Anything, "missing").Return(nilFilter, nil)
+	mockStore.On("GetEventFilterByName", mock.Anything, "invalid").Return(invalidFilter, nil)
+
+	var nilExtension *types.Extension
+	mockStore.On("GetExtension", mock.Anything, "missing").Return(nilExtension, store.ErrNoExtension)
+	mockStore.On("CreateError", mock.Anything).Return(nil)
+
+	testCases := []struct {
+		name     string
+		filter   string
+		expected string
+	}{
+		{
+			name:     "missing filter",
+			filter:   "missing",
+			expected: "filter missing: filter not found",
+		},
+		{
+			name:     "invalid statement",
+			filter:   "invalid",
+			expected: "filter invalid: statement",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			handler := &types.Handler{
+				Type:    "pipe",
+				Filters: []string{tc.filter},
+			}
+
+			assert.False(t, p.filterEvent(handler, event))
+			mockStore.AssertCalled(t, "CreateError", mock.MatchedBy(func(perr *types.Error) bool {
+				return perr.Component == ComponentFilter &&
+					strings.HasPrefix(perr.Message, tc.expected) &&
+					perr.Event.Entity.ID == "entity1"
+			}))
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/Sirupsen/logrus"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sensu/sensu-go/command"
 	"github.com/sensu/sensu-go/types"
 )
@@ -19,6 +20,19 @@ const (
 	DefaultSocketTimeout uint32 = 60
 )
 
+var handlerDuration = prometheus.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "sensu_handler_duration_seconds",
+		Help:    "Duration of the handler executions, retries included",
+		Buckets: prometheus.ExponentialBuckets(0.01, 4, 8),
+	},
+	[]string{"organization", "environment", "handler"},
+)
+
+func init() {
+	prometheus.MustRegister(handlerDuration)
+}
+
 // handleEvent takes a Sensu event through a Sensu pipeline, filters
 // -> mutator -> handler. An event may have one or more handlers. Most
 // errors are only logged and used for flow control, they will not
@@ -47,12 +61,15 @@ func (p *Pipelined) handleEvent(event *types.Event) error {
 		filtered := p.filterEvent(handler, event)
 
 		if filtered {
-			logger.WithFields(logrus.Fields{
-				"check":        event.Check.Name,
+			fields := logrus.Fields{
 				"entity":       event.Entity.ID,
 				"organization": event.Entity.Organization,
 				"environment":  event.Entity.Environment,
-			}).Debug("event filtered")
+			}
+			if event.HasCheck() {
+				fields["check"] = event.Check.Name
+			}
+			logger.WithFields(fields).Debug("event filtered")
 			continue
 		}
 
@@ -68,14 +85,8 @@ func (p *Pipelined) handleEvent(event *types.Event) error {
 		}).Debug("sending event to handler")
 
 		switch handler.Type {
-		case "pipe":
-			if _, err := p.pipeHandler(handler, eventData); err != nil {
-				logger.Error(err)
-			}
-		case "tcp", "udp":
-			if _, err := p.socketHandler(handler, eventData); err != nil {
-				logger.Error(err)
-			}
+		case "pipe", "tcp", "udp", "grpc", "http":
+			p.dispatchHandler(handler, event, eventData)
 		default:
 			return errors.New("unknown handler type")
 		}
@@ -84,6 +95,130 @@ func (p *Pipelined) handleEvent(event *types.Event) error {
 	return nil
 }
 
+// defaultInitialBackoff is the delay before the first retry of a handler whose
+// retry policy has no initial backoff.
+const defaultInitialBackoff = time.Second
+
+// executeHandler executes a handler with the mutated eventData, retrying
+// failed executions according to the handler retry policy. An execution that
+// keeps failing is recorded as an error and, if the handler has a retry policy,
+// the event is added to the dead-letter queue. The retries of handlers without
+// a concurrency limit are run apart from the calling pipeline, so the backoff
+// between them doesn't hold up its worker.
+func (p *Pipelined) executeHandler(handler *types.Handler, event *types.Event, eventData []byte) {
+	started := time.Now()
+	policy := handler.RetryPolicy
+	if policy == nil {
+		policy = &types.HandlerRetryPolicy{}
+	}
+
+	retryable, err := p.runHandler(handler, policy, event, eventData)
+	if err == nil || !retryable || policy.MaxAttempts <= 1 || p.isStopping() {
+		p.handlerDone(handler, event, started, 1, err)
+		return
+	}
+
+	retry := func() {
+		attempts, err := p.retryHandler(handler, policy, event, eventData, err)
+		p.handlerDone(handler, event, started, attempts, err)
+	}
+
+	if handler.Concurrency == nil || handler.Concurrency.Limit == 0 {
+		p.wg.Add(1)
+		go func() {
+			defer p.wg.Done()
+			retry()
+		}()
+		return
+	}
+
+	retry()
+}
+
+// handlerDone records the outcome of the executions of a handler.
+func (p *Pipelined) handlerDone(handler *types.Handler, event *types.Event, started time.Time, attempts int, err error) {
+	handlerDuration.WithLabelValues(
+		handler.Organization, handler.Environment, handler.Name,
+	).Observe(time.Since(started).Seconds())
+
+	if err == nil {
+		return
+	}
+
+	p.recordError(event, ComponentHandler, handler.Name, err)
+
+	if handler.RetryPolicy != nil {
+		p.deadLetter(handler, event, attempts, err)
+	}
+}
+
+// retryHandler executes again a handler whose first execution failed with err,
+// until it succeeds, the failure is not retryable, the attempts of its retry
+// policy are exhausted or pipelined is stopped. The delay between attempts
+// doubles after each one. It returns the number of attempts and the error of
+// the last one.
+func (p *Pipelined) retryHandler(handler *types.Handler, policy *types.HandlerRetryPolicy, event *types.Event, eventData []byte, err error) (int, error) {
+	backoff := time.Duration(policy.InitialBackoff) * time.Second
+	if backoff == 0 {
+		backoff = p.initialBackoff
+	}
+	if backoff == 0 {
+		backoff = defaultInitialBackoff
+	}
+	maxBackoff := time.Duration(policy.MaxBackoff) * time.Second
+
+	attempts := 1
+	for attempts < int(policy.MaxAttempts) {
+		logger.WithError(err).WithFields(logrus.Fields{
+			"handler": handler.Name,
+			"attempt": attempts,
+		}).Warn("pipelined failed to execute event handler, retrying")
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-p.stopping:
+			timer.Stop()
+			return attempts, err
+		case <-timer.C:
+		}
+
+		attempts++
+		var retryable bool
+		retryable, err = p.runHandler(handler, policy, event, eventData)
+		if err == nil || !retryable {
+			break
+		}
+
+		backoff *= 2
+		if maxBackoff > 0 && backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+
+	return attempts, err
+}
+
+// runHandler executes a pipe, tcp, udp, grpc or http handler once. It returns
+// whether a failed execution is worth retrying, and its error.
+func (p *Pipelined) runHandler(handler *types.Handler, policy *types.HandlerRetryPolicy, event *types.Event, eventData []byte) (bool, error) {
+	// Failures of socket, grpc and http handlers are always worth retrying,
+	// while pipe handlers are only retried for the configured exit codes
+	var err error
+	switch handler.Type {
+	case "pipe":
+		var result *command.Execution
+		result, err = p.pipeHandler(handler, event, eventData)
+		return err != nil && result != nil && policy.IsRetryableExitCode(result.Status), err
+	case "grpc":
+		err = p.grpcHandler(handler, event, eventData)
+	case "http":
+		_, err = p.httpHandler(handler, eventData)
+	default:
+		_, err = p.socketHandler(handler, eventData)
+	}
+	return true, err
+}
+
 // expandHandlers turns a list of Sensu handler names into a list of
 // handlers, while expanding handler sets with support for some
 // nesting. Handlers are fetched from etcd.
@@ -131,24 +266,33 @@ func (p *Pipelined) expandHandlers(ctx context.Context, handlers []string, level
 
 // pipeHandler fork/executes a child process for a Sensu pipe handler
 // command and writes the mutated eventData to it via STDIN.
-func (p *Pipelined) pipeHandler(handler *types.Handler, eventData []byte) (*command.Execution, error) {
+func (p *Pipelined) pipeHandler(handler *types.Handler, event *types.Event, eventData []byte) (*command.Execution, error) {
 	handlerExec := &command.Execution{}
 
 	handlerExec.Command = handler.Command
 	handlerExec.Timeout = int(handler.Timeout)
-	handlerExec.Env = handler.EnvVars
+
+	env, err := p.assetsEnv(event, handler.RuntimeAssets, handler.EnvVars)
+	if err != nil {
+		return nil, err
+	}
+	handlerExec.Env = env
 
 	handlerExec.Input = string(eventData[:])
 
 	result, err := command.ExecuteCommand(context.Background(), handlerExec)
 
 	if err != nil {
-		logger.WithError(err).Error("pipelined failed to execute event pipe handler")
-	} else {
-		logger.WithFields(logrus.Fields{
-			"status": result.Status,
-			"output": result.Output,
-		}).Infof("pipelined executed event pipe handler")
+		return result, err
+	}
+
+	logger.WithFields(logrus.Fields{
+		"status": result.Status,
+		"output": result.Output,
+	}).Infof("pipelined executed event pipe handler")
+
+	if result.Status != 0 {
+		err = fmt.Errorf("pipe handler execution returned non-zero exit status %d", result.Status)
 	}
 
 	return result, err
@@ -182,15 +326,14 @@ func (p *Pipelined) socketHandler(handler *types.Handler, eventData []byte) (con
 	}()
 
 	bytes, err := conn.Write(eventData)
-
 	if err != nil {
-		logger.WithError(err).WithField("type", protocol).Error("pipelined failed to execute event handler")
-	} else {
-		logger.WithFields(logrus.Fields{
-			"type":  protocol,
-			"bytes": bytes,
-		}).Debug("pipelined executed event handler")
+		return conn, err
 	}
 
+	logger.WithFields(logrus.Fields{
+		"type":  protocol,
+		"bytes": bytes,
+	}).Debug("pipelined executed event handler")
+
 	return conn, nil
 }
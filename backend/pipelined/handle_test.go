@@ -29,6 +29,15 @@ func TestHelperHandlerProcess(t *testing.T) {
 	switch command {
 	case "cat":
 		fmt.Fprintf(os.Stdout, "%s", stdin)
+	case "fail":
+		os.Exit(1)
+	case "flaky":
+		// Fail on the first execution only
+		path := os.Getenv("FLAKY_HANDLER_FILE")
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			_ = ioutil.WriteFile(path, stdin, 0644)
+			os.Exit(1)
+		}
 	}
 	os.Exit(0)
 }
@@ -62,6 +71,56 @@ func TestPipelinedHandleEvent(t *testing.T) {
 	assert.NoError(t, p.handleEvent(event))
 }
 
+func TestPipelinedHandleMetricsEvent(t *testing.T) {
+	p := &Pipelined{}
+
+	store := &mockstore.MockStore{}
+	p.store = store
+
+	handler := types.FixtureHandler("handler1")
+	handler.Type = "udp"
+	handler.Filters = []string{"has_metrics", "not_silenced", "is_incident"}
+	handler.Socket = &types.HandlerSocket{
+		Host: "127.0.0.1",
+		Port: 6789,
+	}
+	event := &types.Event{
+		Entity:  types.FixtureEntity("entity1"),
+		Metrics: types.FixtureMetrics(),
+	}
+	event.Metrics.Handlers = []string{"handler1"}
+	store.On("GetHandlerByName", mock.Anything, "handler1").Return(handler, nil)
+
+	// The event has no check, which must not prevent it from going through
+	// the filters
+	assert.NoError(t, p.handleEvent(event))
+	store.AssertCalled(t, "GetHandlerByName", mock.Anything, "handler1")
+}
+
+func TestPipelinedHandleEventRecordsErrors(t *testing.T) {
+	p := &Pipelined{}
+
+	store := &mockstore.MockStore{}
+	p.store = store
+
+	handler := types.FakeHandlerCommand("fail")
+	handler.Name = "handler1"
+	handler.Type = "pipe"
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.Handlers = []string{"handler1"}
+
+	store.On("GetHandlerByName", mock.Anything, "handler1").Return(handler, nil)
+	store.On("CreateError", mock.Anything).Return(nil)
+
+	assert.NoError(t, p.handleEvent(event))
+	store.AssertCalled(t, "CreateError", mock.MatchedBy(func(perr *types.Error) bool {
+		return perr.Name != "" &&
+			perr.Component == ComponentHandler &&
+			strings.Contains(perr.Message, "handler handler1: pipe handler execution returned non-zero exit status 1") &&
+			perr.Event.Check.Name == "check1"
+	}))
+}
+
 func TestPipelinedExpandHandlers(t *testing.T) {
 	p := &Pipelined{}
 	store := &mockstore.MockStore{}
@@ -116,7 +175,7 @@ func TestPipelinedPipeHandler(t *testing.T) {
 	event := &types.Event{}
 	eventData, _ := json.Marshal(event)
 
-	handlerExec, err := p.pipeHandler(handler, eventData)
+	handlerExec, err := p.pipeHandler(handler, event, eventData)
 
 	assert.NoError(t, err)
 	assert.Equal(t, string(eventData[:]), handlerExec.Output)
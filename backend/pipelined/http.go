@@ -0,0 +1,116 @@
+package pipelined
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Sirupsen/logrus"
+	"github.com/sensu/sensu-go/types"
+)
+
+// DefaultHTTPTimeout specifies the default timeout in seconds of the requests
+// made by HTTP handlers.
+const DefaultHTTPTimeout uint32 = 60
+
+// httpClients holds the HTTP clients used by HTTP handlers, so connections are
+// reused across events. Handlers with the same TLS options share a client.
+type httpClients struct {
+	mu      sync.Mutex
+	clients map[types.TLSOptions]*http.Client
+}
+
+// get returns the client for the given TLS options, creating it if needed.
+func (c *httpClients) get(opts *types.TLSOptions) (*http.Client, error) {
+	var key types.TLSOptions
+	if opts != nil {
+		key = *opts
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if client, ok := c.clients[key]; ok {
+		return client, nil
+	}
+
+	transport := &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		MaxIdleConnsPerHost: 10,
+		IdleConnTimeout:     90 * time.Second,
+	}
+	if opts != nil {
+		tlsConfig, err := opts.ToTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	if c.clients == nil {
+		c.clients = make(map[types.TLSOptions]*http.Client)
+	}
+	client := &http.Client{Transport: transport}
+	c.clients[key] = client
+
+	return client, nil
+}
+
+// httpHandler sends the mutated eventData to the URL of a Sensu http handler,
+// and returns an error if the response status is not in the expected range.
+func (p *Pipelined) httpHandler(handler *types.Handler, eventData []byte) (int, error) {
+	config := handler.HTTP
+
+	method := config.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	timeout := handler.Timeout
+	if timeout == 0 {
+		timeout = DefaultHTTPTimeout
+	}
+
+	client, err := p.httpClients.get(config.TLS)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequest(method, config.URL, bytes.NewReader(eventData))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range config.Headers {
+		req.Header.Set(key, value)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Drain the body so the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	logger.WithFields(logrus.Fields{
+		"handler": handler.Name,
+		"method":  method,
+		"status":  resp.StatusCode,
+	}).Info("pipelined executed event http handler")
+
+	if !config.IsSuccessStatus(resp.StatusCode) {
+		return resp.StatusCode, fmt.Errorf("http handler request returned unexpected status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
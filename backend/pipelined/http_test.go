@@ -0,0 +1,99 @@
+package pipelined
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/mockstore"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipelinedHTTPHandler(t *testing.T) {
+	var method, contentType, token, body string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		contentType = r.Header.Get("Content-Type")
+		token = r.Header.Get("X-Token")
+		data, _ := ioutil.ReadAll(r.Body)
+		body = string(data)
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	p := &Pipelined{}
+
+	handler := types.FixtureHTTPHandler("handler1", server.URL+"/hook")
+	handler.HTTP.Headers = map[string]string{"X-Token": "secret"}
+
+	status, err := p.httpHandler(handler, []byte(`{"foo":"bar"}`))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, http.MethodPost, method)
+	assert.Equal(t, "application/json", contentType)
+	assert.Equal(t, "secret", token)
+	assert.Equal(t, `{"foo":"bar"}`, body)
+
+	handler.HTTP.Method = http.MethodPut
+	_, err = p.httpHandler(handler, []byte("event"))
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodPut, method)
+
+	// Statuses outside of the expected range are errors
+	handler.HTTP.URL = server.URL + "/fail"
+	status, err = p.httpHandler(handler, []byte("event"))
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusInternalServerError, status)
+
+	handler.HTTP.MaxStatus = 599
+	_, err = p.httpHandler(handler, []byte("event"))
+	assert.NoError(t, err)
+
+	// The clients are shared between handlers
+	assert.Len(t, p.httpClients.clients, 1)
+}
+
+func TestPipelinedHTTPHandlerTLS(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	p := &Pipelined{}
+	handler := types.FixtureHTTPHandler("handler1", server.URL)
+
+	// The certificate of the test server is self-signed
+	_, err := p.httpHandler(handler, []byte("event"))
+	assert.Error(t, err)
+
+	handler.HTTP.TLS = &types.TLSOptions{InsecureSkipVerify: true}
+	_, err = p.httpHandler(handler, []byte("event"))
+	assert.NoError(t, err)
+}
+
+func TestPipelinedHandleEventHTTP(t *testing.T) {
+	events := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := ioutil.ReadAll(r.Body)
+		events <- string(data)
+	}))
+	defer server.Close()
+
+	store := &mockstore.MockStore{}
+	p := &Pipelined{store: store}
+
+	handler := types.FixtureHTTPHandler("handler1", server.URL)
+	handler.Mutator = "only_check_output"
+	store.On("GetHandlerByName", mock.Anything, "handler1").Return(handler, nil)
+
+	event := types.FixtureEvent("entity1", "check1")
+	event.Check.Output = "output"
+	event.Check.Handlers = []string{"handler1"}
+
+	require.NoError(t, p.handleEvent(event))
+	assert.Equal(t, "output", <-events)
+}
@@ -18,7 +18,7 @@ func (p *Pipelined) mutateEvent(handler *types.Handler, event *types.Event) ([]b
 		eventData, err := p.jsonMutator(event)
 
 		if err != nil {
-			logger.WithError(err).Error("pipelined failed to mutate an event")
+			p.recordError(event, ComponentMutator, "json", err)
 			return nil, err
 		}
 
@@ -35,19 +35,26 @@ func (p *Pipelined) mutateEvent(handler *types.Handler, event *types.Event) ([]b
 	ctx = context.WithValue(ctx, types.EnvironmentKey, event.Entity.Environment)
 	mutator, err := p.store.GetMutatorByName(ctx, handler.Mutator)
 
-	if mutator == nil {
+	// Use the extension registered with that name, if there is no such mutator
+	if mutator == nil && err == nil {
+		eventData, err := p.extensionMutator(handler.Mutator, event)
 		if err != nil {
-			logger.WithError(err).Error("pipelined failed to retrieve a mutator")
-		} else {
-			logger.WithField("name", handler.Mutator).Error("pipelined failed to retrieve a mutator")
+			p.recordError(event, ComponentMutator, handler.Mutator, err)
+			return nil, err
 		}
+
+		return eventData, nil
+	}
+
+	if mutator == nil {
+		p.recordError(event, ComponentMutator, handler.Mutator, err)
 		return nil, err
 	}
 
 	eventData, err := p.pipeMutator(mutator, event)
 
 	if err != nil {
-		logger.WithError(err).Error("pipelined failed to mutate an event")
+		p.recordError(event, ComponentMutator, mutator.Name, err)
 		return nil, err
 	}
 
@@ -72,6 +79,9 @@ func (p *Pipelined) jsonMutator(event *types.Event) ([]byte, error) {
 // mutator can probably be removed/replaced when 2.0 has extension
 // support.
 func (p *Pipelined) onlyCheckOutputMutator(event *types.Event) []byte {
+	if !event.HasCheck() {
+		return nil
+	}
 	return []byte(event.Check.Output)
 }
 
@@ -84,7 +94,12 @@ func (p *Pipelined) pipeMutator(mutator *types.Mutator, event *types.Event) ([]b
 
 	mutatorExec.Command = mutator.Command
 	mutatorExec.Timeout = int(mutator.Timeout)
-	mutatorExec.Env = mutator.EnvVars
+
+	env, err := p.assetsEnv(event, mutator.RuntimeAssets, mutator.EnvVars)
+	if err != nil {
+		return nil, err
+	}
+	mutatorExec.Env = env
 
 	eventData, err := json.Marshal(event)
 
@@ -2,17 +2,22 @@
 package pipelined
 
 import (
+	"context"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/sensu/sensu-go/agent/assetmanager"
 	"github.com/sensu/sensu-go/backend/messaging"
 	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/rpc"
 	"github.com/sensu/sensu-go/types"
+	"github.com/sensu/sensu-go/util/path"
 )
 
 const (
 	// PipelineCount specifies how many pipelines (goroutines) are
-	// in action.
+	// in action by default.
 	PipelineCount int = 10
 )
 
@@ -29,12 +34,41 @@ type Pipelined struct {
 	subscription messaging.Subscription
 	store        store.Store
 	bus          messaging.MessageBus
+	queueGetter  types.QueueGetter
+	deadLetters  types.Queue
+	replays      types.Queue
+	cancel       context.CancelFunc
+	extensions   *rpc.ExtensionPool
+	httpClients  httpClients
+	workers      int
+	queues       handlerQueues
+	assetManager *assetmanager.Manager
+
+	// initialBackoff overrides the delay before the first retry of the
+	// handlers whose retry policy has no initial backoff
+	initialBackoff time.Duration
 }
 
 // Config configures a Pipelined.
 type Config struct {
-	Store store.Store
-	Bus   messaging.MessageBus
+	Store       store.Store
+	Bus         messaging.MessageBus
+	QueueGetter types.QueueGetter
+
+	// Workers is the number of concurrent event pipelines, PipelineCount by
+	// default.
+	Workers int
+
+	// CacheDir is the directory where the runtime assets of handlers and
+	// mutators are installed.
+	CacheDir string
+
+	// AssetTrustedKeys are the paths of the ASCII armored keyrings holding the
+	// keys trusted to sign the runtime assets.
+	AssetTrustedKeys []string
+
+	// AssetRequireSignature rejects the runtime assets that are not signed.
+	AssetRequireSignature bool
 }
 
 // Option is a functional option used to configure Pipelined.
@@ -43,13 +77,32 @@ type Option func(*Pipelined) error
 // New creates a new Pipelined with supplied Options applied.
 func New(c Config, options ...Option) (*Pipelined, error) {
 	p := &Pipelined{
-		store:     c.Store,
-		bus:       c.Bus,
-		stopping:  make(chan struct{}, 1),
-		running:   &atomic.Value{},
-		wg:        &sync.WaitGroup{},
-		errChan:   make(chan error, 1),
-		eventChan: make(chan interface{}, 100),
+		store:       c.Store,
+		bus:         c.Bus,
+		queueGetter: c.QueueGetter,
+		stopping:    make(chan struct{}, 1),
+		running:     &atomic.Value{},
+		wg:          &sync.WaitGroup{},
+		errChan:     make(chan error, 1),
+		eventChan:   make(chan interface{}, 100),
+		cancel:      func() {},
+		extensions:  rpc.NewExtensionPool(),
+		workers:     c.Workers,
+	}
+	if p.workers <= 0 {
+		p.workers = PipelineCount
+	}
+	cacheDir := c.CacheDir
+	if cacheDir == "" {
+		cacheDir = path.SystemCacheDir("sensu-backend")
+	}
+	p.assetManager = newAssetManager(cacheDir)
+	if len(c.AssetTrustedKeys) > 0 || c.AssetRequireSignature {
+		verifier, err := assetmanager.NewSignatureVerifier(c.AssetTrustedKeys, c.AssetRequireSignature)
+		if err != nil {
+			return nil, err
+		}
+		p.assetManager.SetVerifier(verifier)
 	}
 	for _, o := range options {
 		if err := o(p); err != nil {
@@ -73,7 +126,19 @@ func (p *Pipelined) Start() error {
 	}
 	p.subscription = sub
 
-	p.createPipelines(PipelineCount, p.eventChan)
+	p.createPipelines(p.workers, p.eventChan)
+
+	// Failing handler executions are kept in a dead-letter queue, from which
+	// operators can replay them through the API.
+	if p.queueGetter != nil {
+		p.deadLetters = p.queueGetter.GetQueue(types.DeadLetterQueueName)
+		p.replays = p.queueGetter.GetQueue(types.DeadLetterReplayQueueName)
+
+		var ctx context.Context
+		ctx, p.cancel = context.WithCancel(context.Background())
+		p.wg.Add(1)
+		go p.replayDeadLetters(ctx)
+	}
 
 	return nil
 }
@@ -82,11 +147,17 @@ func (p *Pipelined) Start() error {
 func (p *Pipelined) Stop() error {
 	p.running.Store(false)
 	close(p.stopping)
+	p.cancel()
 	p.wg.Wait()
+	p.queues.stop()
 	close(p.errChan)
 	err := p.subscription.Cancel()
 	close(p.eventChan)
 
+	if cerr := p.extensions.Close(); cerr != nil {
+		logger.WithError(cerr).Error("pipelined failed to close extension connections")
+	}
+
 	return err
 }
 
@@ -100,6 +171,16 @@ func (p *Pipelined) Err() <-chan error {
 	return p.errChan
 }
 
+// isStopping returns true if pipelined is being stopped.
+func (p *Pipelined) isStopping() bool {
+	select {
+	case <-p.stopping:
+		return true
+	default:
+		return false
+	}
+}
+
 // createPipelines creates several goroutines, responsible for pulling
 // Sensu events from a channel (bound to message bus "event" topic)
 // and for handling them.
@@ -45,6 +45,28 @@ func (m *Memory) Enqueue(_ context.Context, val string) error {
 	return nil
 }
 
+// List ...
+func (m *Memory) List(context.Context) ([]string, error) {
+	m.Lock()
+	defer m.Unlock()
+	values := make([]string, len(m.data))
+	copy(values, m.data)
+	return values, nil
+}
+
+// Remove ...
+func (m *Memory) Remove(_ context.Context, val string) (bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	for i, v := range m.data {
+		if v == val {
+			m.data = append(m.data[:i], m.data[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // MemoryItem is an item from MemoryQueue.
 type MemoryItem struct {
 	value string
@@ -70,10 +92,13 @@ func (m *MemoryItem) Nack(context.Context) error {
 }
 
 // Dequeue ...
-func (m *Memory) Dequeue(context.Context) (types.QueueItem, error) {
+func (m *Memory) Dequeue(ctx context.Context) (types.QueueItem, error) {
 	// cheesy blocking algo
 	var val string
 	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		m.Lock()
 		if len(m.data) == 0 {
 			m.Unlock()
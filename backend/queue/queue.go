@@ -54,7 +54,7 @@ func New(name string, client *clientv3.Client) *Queue {
 		client:      client,
 		work:        path.Join(name, workPostfix),
 		inFlight:    path.Join(name, inFlightPostfix),
-		kv:          clientv3.NewKV(client),
+		kv:          client.KV,
 		itemTimeout: itemTimeout,
 	}
 	return queue
@@ -84,7 +84,7 @@ func (i *Item) Ack(ctx context.Context) error {
 	i.once.Do(func() {
 		i.mu.Lock()
 		delCmp := clientv3.Compare(clientv3.ModRevision(i.key), "=", i.revision)
-		delReq := clientv3.OpDelete(i.value)
+		delReq := clientv3.OpDelete(i.key)
 		_, err = i.queue.kv.Txn(ctx).If(delCmp).Then(delReq).Commit()
 		i.mu.Unlock()
 		i.cancel()
@@ -128,14 +128,16 @@ func (i *Item) keepalive(ctx context.Context) {
 				putReq := clientv3.OpPut(updateKey, i.value)
 				delReq := clientv3.OpDelete(i.key)
 
-				_, err = i.queue.kv.Txn(ctx).If(putCmp, delCmp).Then(putReq, delReq).Commit()
+				response, err := i.queue.kv.Txn(ctx).If(putCmp, delCmp).Then(putReq, delReq).Commit()
 
 				if err != nil {
 					// log error
 					logger.WithError(err).Error("error updating item keepalive timestamp")
+				} else if response.Succeeded {
+					// only track the new key once it replaced the old one
+					i.key = updateKey
+					i.revision = response.Header.Revision
 				}
-
-				i.key = updateKey
 				i.mu.Unlock()
 			case <-ctx.Done():
 				return
@@ -249,6 +251,55 @@ func (q *Queue) Dequeue(ctx context.Context) (types.QueueItem, error) {
 	return q.Dequeue(ctx)
 }
 
+// List returns the values of the items in the work queue, in order, without
+// dequeuing them. Items that are in-flight are not returned.
+func (q *Queue) List(ctx context.Context) ([]string, error) {
+	response, err := q.client.Get(ctx, q.work, clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]string, len(response.Kvs))
+	for i, kv := range response.Kvs {
+		values[i] = string(kv.Value)
+	}
+	return values, nil
+}
+
+// Remove deletes the first item of the work queue with the given value,
+// without dequeuing it. It returns false if no such item is waiting in the
+// queue.
+func (q *Queue) Remove(ctx context.Context, value string) (bool, error) {
+	for {
+		response, err := q.client.Get(ctx, q.work, clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+		if err != nil {
+			return false, err
+		}
+
+		var item *mvccpb.KeyValue
+		for _, kv := range response.Kvs {
+			if string(kv.Value) == value {
+				item = kv
+				break
+			}
+		}
+		if item == nil {
+			return false, nil
+		}
+
+		// Retry if the item was dequeued concurrently
+		delCmp := clientv3.Compare(clientv3.ModRevision(string(item.Key)), "=", item.ModRevision)
+		delReq := clientv3.OpDelete(string(item.Key))
+		txnResp, err := q.kv.Txn(ctx).If(delCmp).Then(delReq).Commit()
+		if err != nil {
+			return false, err
+		}
+		if txnResp.Succeeded {
+			return true, nil
+		}
+	}
+}
+
 func (q *Queue) getItemTimestamp(key []byte) (time.Time, error) {
 	binaryTimestamp := key[len(key)-8:]
 
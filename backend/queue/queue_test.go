@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/coreos/etcd/clientv3"
 	"github.com/sensu/sensu-go/backend/etcd"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -74,6 +75,36 @@ func TestDequeueFIFO(t *testing.T) {
 	require.Equal(t, items, result)
 }
 
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+	client, err := e.NewClient()
+	require.NoError(t, err)
+
+	queue := New("testlist", client)
+	items := []string{"hello", "there", "world"}
+
+	for _, item := range items {
+		require.NoError(t, queue.Enqueue(context.Background(), item))
+	}
+
+	values, err := queue.List(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, items, values)
+
+	// In-flight items are not listed
+	item, err := queue.Dequeue(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "hello", item.Value())
+
+	values, err = queue.List(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, items[1:], values)
+	require.NoError(t, item.Ack(context.Background()))
+}
+
 func TestDequeueParallel(t *testing.T) {
 	t.Parallel()
 
@@ -196,6 +227,64 @@ func TestAck(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestAckKeptAlive(t *testing.T) {
+	t.Parallel()
+
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+	client, err := e.NewClient()
+	require.NoError(t, err)
+
+	queue := New("testackkeptalive", client)
+	queue.itemTimeout = 2 * time.Second
+	err = queue.Enqueue(context.Background(), "test item")
+	require.NoError(t, err)
+
+	item, err := queue.Dequeue(context.Background())
+	require.NoError(t, err)
+
+	// wait for the keepalive to move the item to a new in-flight key
+	time.Sleep(1500 * time.Millisecond)
+	require.NoError(t, item.Ack(context.Background()))
+
+	response, err := client.Get(context.Background(), queue.inFlight, clientv3.WithPrefix())
+	require.NoError(t, err)
+	assert.Empty(t, response.Kvs)
+
+	// the acked item must not be returned to the queue once it expires
+	time.Sleep(2 * time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = queue.Dequeue(ctx)
+	require.Error(t, err)
+}
+
+func TestRemove(t *testing.T) {
+	t.Parallel()
+
+	e, cleanup := etcd.NewTestEtcd(t)
+	defer cleanup()
+	client, err := e.NewClient()
+	require.NoError(t, err)
+
+	queue := New("testremove", client)
+	for _, item := range []string{"hello", "there", "world"} {
+		require.NoError(t, queue.Enqueue(context.Background(), item))
+	}
+
+	removed, err := queue.Remove(context.Background(), "there")
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	removed, err = queue.Remove(context.Background(), "there")
+	require.NoError(t, err)
+	assert.False(t, removed)
+
+	values, err := queue.List(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"hello", "world"}, values)
+}
+
 func TestOnce(t *testing.T) {
 	t.Parallel()
 
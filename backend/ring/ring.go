@@ -72,7 +72,7 @@ func New(name string, client *clientv3.Client) *Ring {
 	return &Ring{
 		Name:         name,
 		client:       client,
-		kv:           clientv3.NewKV(client),
+		kv:           client.KV,
 		backendID:    getBackendID(),
 		leaseTimeout: 120, // 120 seconds
 	}
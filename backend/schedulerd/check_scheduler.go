@@ -7,6 +7,7 @@ import (
 
 	"github.com/Sirupsen/logrus"
 	"github.com/sensu/sensu-go/backend/messaging"
+	"github.com/sensu/sensu-go/util/schedule"
 	sensutime "github.com/sensu/sensu-go/util/time"
 )
 
@@ -36,14 +37,14 @@ func (s *CheckScheduler) Start() error {
 
 	go func() {
 	toggle:
-		var timer CheckTimer
+		var timer schedule.CheckTimer
 		if s.checkCron != "" {
 			s.logger.Info("starting new cron scheduler")
-			timer = NewCronTimer(s.checkName, s.checkCron)
+			timer = schedule.NewCronTimer(s.checkName, s.checkCron)
 		}
 		if timer == nil || s.checkCron == "" {
 			s.logger.Info("starting new interval scheduler")
-			timer = NewIntervalTimer(s.checkName, uint(s.checkInterval))
+			timer = schedule.NewIntervalTimer(s.checkName, uint(s.checkInterval))
 		}
 
 		executor := NewCheckExecutor(s.bus, newRoundRobinScheduler(s.ctx, s.bus), s.checkOrg, s.checkEnv)
@@ -83,7 +83,8 @@ func (c *CheckExecutor) execute(check *types.CheckConfig) error {
 			"topic": topic,
 		}).Debug("sending check request")
 
-		if pubErr := c.bus.Publish(topic, request); pubErr != nil {
+		// Every backend schedules the checks for its own agents
+		if pubErr := messaging.PublishLocal(c.bus, topic, request); pubErr != nil {
 			logger.WithError(pubErr).Error("error publishing check request")
 			err = pubErr
 		}
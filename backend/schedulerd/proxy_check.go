@@ -9,6 +9,7 @@ import (
 	"github.com/sensu/sensu-go/types"
 	"github.com/sensu/sensu-go/types/dynamic"
 	"github.com/sensu/sensu-go/util/eval"
+	"github.com/sensu/sensu-go/util/schedule"
 )
 
 // matchEntities matches the provided list of entities to the entity attributes
@@ -77,7 +78,7 @@ func calculateSplayInterval(check *types.CheckConfig, numEntities float64) (floa
 	var err error
 	next := time.Duration(time.Second * time.Duration(check.Interval))
 	if check.Cron != "" {
-		if next, err = NextCronTime(time.Now(), check.Cron); err != nil {
+		if next, err = schedule.NextCronTime(time.Now(), check.Cron); err != nil {
 			return 0, err
 		}
 	}
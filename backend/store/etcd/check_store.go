@@ -33,8 +33,12 @@ func (s *Store) DeleteCheckConfigByName(ctx context.Context, name string) error
 		return errors.New("must specify name")
 	}
 
-	_, err := s.client.Delete(ctx, getCheckConfigsPath(ctx, name))
-	return err
+	if _, err := s.client.Delete(ctx, getCheckConfigsPath(ctx, name)); err != nil {
+		return err
+	}
+
+	// The deadlines of the check are deleted along with it
+	return s.deleteCheckMonitors(ctx, name)
 }
 
 // GetCheckConfigs returns check configurations for an (optional) organization.
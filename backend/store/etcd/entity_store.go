@@ -32,7 +32,12 @@ func (s *Store) DeleteEntity(ctx context.Context, e *types.Entity) error {
 	if err := e.Validate(); err != nil {
 		return err
 	}
-	_, err := s.client.Delete(ctx, getEntityPath(e))
+
+	// The deadlines of the entity are deleted along with it
+	_, err := s.client.Txn(ctx).Then(
+		clientv3.OpDelete(getEntityPath(e)),
+		clientv3.OpDelete(getEntityMonitorPath(e), clientv3.WithPrefix()),
+	).Commit()
 	return err
 }
 
@@ -42,7 +47,11 @@ func (s *Store) DeleteEntityByID(ctx context.Context, id string) error {
 		return errors.New("must specify id")
 	}
 
-	_, err := s.client.Delete(ctx, getEntitiesPath(ctx, id))
+	// The deadlines of the entity are deleted along with it
+	_, err := s.client.Txn(ctx).Then(
+		clientv3.OpDelete(getEntitiesPath(ctx, id)),
+		clientv3.OpDelete(getEntityMonitorsPath(ctx, id), clientv3.WithPrefix()),
+	).Commit()
 	return err
 }
 
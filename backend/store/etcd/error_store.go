@@ -21,9 +21,9 @@ var (
 	errorsKeyBuilder = store.NewKeyBuilder(errorsPathPrefix)
 )
 
-func errPathFromAllUniqueFields(ns store.Namespace, entity, check, ts string) string {
+func errPathFromAllUniqueFields(ns store.Namespace, entity, check, name string) string {
 	builder := errorsKeyBuilder.WithNamespace(ns)
-	return builder.Build(entity, "check", check, ts)
+	return builder.Build(entity, "check", check, name)
 }
 
 func errPathFromCheck(ns store.Namespace, entity, check string) string {
@@ -35,21 +35,21 @@ func errPathFromEntity(ns store.Namespace, entity string) string {
 	return builder.BuildPrefix(entity)
 }
 
-// DeleteError deletes an error using the given entity, check and timestamp,
-// within the organization and environment stored in ctx.
+// DeleteError deletes an error using the given entity, check and name, within
+// the organization and environment stored in ctx.
 func (s *Store) DeleteError(
 	ctx context.Context,
 	entity string,
 	check string,
-	timestamp string,
+	name string,
 ) error {
-	if entity == "" || check == "" || timestamp == "" {
-		return errors.New("must specify entity ID, check name, and timestamp")
+	if entity == "" || check == "" || name == "" {
+		return errors.New("must specify entity ID, check name, and error name")
 	}
 
 	// Build key
 	ns := store.NewNamespaceFromContext(ctx)
-	key := errPathFromAllUniqueFields(ns, entity, check, timestamp)
+	key := errPathFromAllUniqueFields(ns, entity, check, name)
 
 	// Delete
 	_, err := s.client.Delete(ctx, key)
@@ -95,21 +95,21 @@ func (s *Store) DeleteErrorsByEntityCheck(
 	return err
 }
 
-// GetError returns error associated with given entity, check and timestamp,
-// in the given ctx's organization and environment.
+// GetError returns error associated with given entity, check and name, in the
+// given ctx's organization and environment.
 func (s *Store) GetError(
 	ctx context.Context,
 	entity string,
 	check string,
-	timestamp string,
+	name string,
 ) (*types.Error, error) {
 	// Build key
 	ns := store.NewNamespaceFromContext(ctx)
-	key := errPathFromAllUniqueFields(ns, entity, check, timestamp)
+	key := errPathFromAllUniqueFields(ns, entity, check, name)
 
 	// Validate arguments
-	if entity == "" || check == "" || timestamp == "" {
-		return nil, errors.New("must specify entity id, check name, and timestamp")
+	if entity == "" || check == "" || name == "" {
+		return nil, errors.New("must specify entity id, check name, and error name")
 	} else if ns.Wildcard() {
 		return nil, errors.New("may not use wildcard to search for record")
 	}
@@ -153,7 +153,23 @@ func (s *Store) GetErrors(ctx context.Context) ([]*types.Error, error) {
 // organization and environment. A nil slice with no error is returned if none
 // were found.
 func (s *Store) GetErrorsByEntity(ctx context.Context, entity string) ([]*types.Error, error) {
-	return s.GetErrorsByEntityCheck(ctx, entity, "")
+	if entity == "" {
+		return nil, errors.New("must specify entity id")
+	}
+
+	// Build key
+	ns := store.NewNamespaceFromContext(ctx)
+	key := errPathFromEntity(ns, entity)
+
+	// Fetch
+	resp, err := s.client.Get(ctx, key, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	// Unmarshal
+	rejectFn := shouldRejectError(ns, entity, "")
+	return unmarshalErrorKVs(resp.Kvs, rejectFn)
 }
 
 // GetErrorsByEntityCheck returns an error using the given entity and check,
@@ -181,6 +197,10 @@ func (s *Store) GetErrorsByEntityCheck(ctx context.Context, entity, check string
 
 // CreateError creates or updates a given error.
 func (s *Store) CreateError(ctx context.Context, perr *types.Error) error {
+	if err := perr.Validate(); err != nil {
+		return err
+	}
+
 	// Obtain new lease
 	lease, err := s.client.Grant(ctx, errorsKeyTTL)
 	if err != nil {
@@ -194,11 +214,11 @@ func (s *Store) CreateError(ctx context.Context, perr *types.Error) error {
 	}
 
 	// Build key
-	key := errorsKeyBuilder.WithContext(ctx).Build(
+	key := errPathFromAllUniqueFields(
+		store.NewNamespaceFromContext(ctx),
 		perr.Event.Entity.ID,
-		"check", // Eventually will need a conditional when metrics are implemented
-		perr.Event.Check.Name,
-		string(perr.Timestamp),
+		perr.CheckName(),
+		perr.Name,
 	)
 
 	// Configure transaction
@@ -215,7 +235,7 @@ func (s *Store) CreateError(ctx context.Context, perr *types.Error) error {
 		return fmt.Errorf(
 			"could not create the error %s/%s in environment %s/%s",
 			perr.Event.Entity.ID,
-			perr.Event.Check.Name,
+			perr.Name,
 			perr.GetOrganization(),
 			perr.GetEnvironment(),
 		)
@@ -265,8 +285,7 @@ func shouldRejectError(ns store.Namespace, entity string, check string) rejectEr
 
 	if check != "" {
 		rejectWhereCheckDoesNotMatch = func(perr *types.Error) bool {
-			errCheck := perr.Event.Check
-			return errCheck != nil && errCheck.Name != check
+			return perr.CheckName() != check
 		}
 	}
 
@@ -42,14 +42,14 @@ func TestErrorStorage(t *testing.T) {
 		assert.EqualValues(t, []*types.Error{perr}, checkErrors)
 
 		// GetError
-		perrRes, err := store.GetError(ctx, perr.Event.Entity.ID, perr.Event.Check.Name, string(perr.Timestamp))
+		perrRes, err := store.GetError(ctx, perr.Event.Entity.ID, perr.Event.Check.Name, perr.Name)
 		require.NoError(t, err)
 		assert.EqualValues(t, perr, perrRes)
 
 		// Delete error
 		cerr = store.CreateError(ctx, perr) // Ensure error is present
 		require.NoError(t, cerr)
-		err = store.DeleteError(ctx, perr.Event.Entity.ID, perr.Event.Check.Name, string(perr.Timestamp))
+		err = store.DeleteError(ctx, perr.Event.Entity.ID, perr.Event.Check.Name, perr.Name)
 		require.NoError(t, err)
 		allErrors, err = store.GetErrors(ctx) // Check that error is gone
 		require.NoError(t, err)
@@ -74,3 +74,39 @@ func TestErrorStorage(t *testing.T) {
 		require.Empty(t, allErrors)
 	})
 }
+
+func TestMetricsErrorStorage(t *testing.T) {
+	testWithEtcd(t, func(store store.Store) {
+		perr := types.FixtureError("name", "ms")
+		perr.Event.Check = nil
+		perr.Event.Metrics = types.FixtureMetrics()
+		ctx := context.Background()
+		ctx = context.WithValue(ctx, types.OrganizationKey, perr.GetOrganization())
+		ctx = context.WithValue(ctx, types.EnvironmentKey, perr.GetEnvironment())
+
+		require.NoError(t, store.CreateError(ctx, perr))
+
+		entityErrors, err := store.GetErrorsByEntity(ctx, perr.Event.Entity.ID)
+		require.NoError(t, err)
+		assert.EqualValues(t, []*types.Error{perr}, entityErrors)
+
+		// The error is not associated with any check
+		checkErrors, err := store.GetErrorsByEntityCheck(ctx, perr.Event.Entity.ID, "check")
+		require.NoError(t, err)
+		assert.Empty(t, checkErrors)
+
+		// The error is found under the placeholder check
+		checkErrors, err = store.GetErrorsByEntityCheck(ctx, perr.Event.Entity.ID, types.MetricsErrorCheck)
+		require.NoError(t, err)
+		assert.EqualValues(t, []*types.Error{perr}, checkErrors)
+
+		result, err := store.GetError(ctx, perr.Event.Entity.ID, types.MetricsErrorCheck, perr.Name)
+		require.NoError(t, err)
+		assert.EqualValues(t, perr, result)
+
+		require.NoError(t, store.DeleteError(ctx, perr.Event.Entity.ID, types.MetricsErrorCheck, perr.Name))
+		allErrors, err := store.GetErrors(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, allErrors)
+	})
+}
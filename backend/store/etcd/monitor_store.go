@@ -0,0 +1,52 @@
+package etcd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/sensu/sensu-go/backend/store"
+	"github.com/sensu/sensu-go/types"
+)
+
+var (
+	monitorKeyBuilder = store.NewKeyBuilder(store.MonitorsPathPrefix)
+)
+
+// getEntityMonitorPath returns the prefix of the keys pointing to the deadlines
+// of an entity, and of its checks, kept by the monitors.
+func getEntityMonitorPath(entity *types.Entity) string {
+	return monitorKeyBuilder.WithResource(entity).Build(entity.ID) + "/"
+}
+
+// getEntityMonitorsPath returns the prefix of the keys pointing to the
+// deadlines of an entity, and of its checks, kept by the monitors.
+func getEntityMonitorsPath(ctx context.Context, id string) string {
+	return monitorKeyBuilder.WithContext(ctx).Build(id) + "/"
+}
+
+// deleteCheckMonitors deletes the keys pointing to the deadlines of a check,
+// for every entity of the environment. Their deadlines are dropped once they
+// expire.
+func (s *Store) deleteCheckMonitors(ctx context.Context, name string) error {
+	prefix := monitorKeyBuilder.WithContext(ctx).Build() + "/"
+	resp, err := s.client.Get(ctx, prefix, clientv3.WithPrefix(), clientv3.WithKeysOnly())
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range resp.Kvs {
+		// The keys of the checks are made of the entity, the monitor and the
+		// check
+		key := string(kv.Key)
+		parts := strings.Split(strings.TrimPrefix(key, prefix), "/")
+		if len(parts) != 3 || parts[2] != name {
+			continue
+		}
+		if _, err := s.client.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
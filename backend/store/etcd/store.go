@@ -1,8 +1,6 @@
 package etcd
 
 import (
-	"path"
-
 	"github.com/coreos/etcd/clientv3"
 )
 
@@ -13,15 +11,13 @@ const (
 
 // Store is an implementation of the sensu-go/backend/store.Store iface.
 type Store struct {
-	client         *clientv3.Client
-	keepalivesPath string
+	client *clientv3.Client
 }
 
 // NewStore creates a new Store.
 func NewStore(client *clientv3.Client, name string) *Store {
 	store := &Store{
-		client:         client,
-		keepalivesPath: path.Join(EtcdRoot, keepalivesPathPrefix, name),
+		client: client,
 	}
 
 	return store
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 
+	"github.com/coreos/etcd/clientv3"
 	"github.com/sensu/sensu-go/backend/etcd"
 	"github.com/sensu/sensu-go/backend/store"
 	etcdstore "github.com/sensu/sensu-go/backend/store/etcd"
@@ -16,6 +17,7 @@ type IntegrationTestStore struct {
 	*etcdstore.Store
 	// underscores to avoid collision w/ store
 	_etcd        *etcd.Etcd
+	_client      *clientv3.Client
 	_removeTmpFn func()
 }
 
@@ -30,6 +32,11 @@ func (e *IntegrationTestStore) GetStore() store.Store {
 	return e.Store
 }
 
+// GetClient returns the etcd client of the store
+func (e *IntegrationTestStore) GetClient() *clientv3.Client {
+	return e._client
+}
+
 // NewStoreInstance returns new isolated store
 func NewStoreInstance() (*IntegrationTestStore, error) {
 	// Create temp dir
@@ -73,6 +80,7 @@ func NewStoreInstance() (*IntegrationTestStore, error) {
 	return &IntegrationTestStore{
 		Store:        st,
 		_etcd:        e,
+		_client:      client,
 		_removeTmpFn: removeTmp,
 	}, nil
 }
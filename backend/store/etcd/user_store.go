@@ -169,6 +169,17 @@ func (s *Store) GetAllUsers() ([]*types.User, error) {
 	return usersArray, nil
 }
 
+// RestoreUser stores a User whose password is already hashed.
+func (s *Store) RestoreUser(u *types.User) error {
+	bytes, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.Put(context.TODO(), getUserPath(u.Username), string(bytes))
+	return err
+}
+
 // UpdateUser updates a User.
 func (s *Store) UpdateUser(u *types.User) error {
 	// Hash the password
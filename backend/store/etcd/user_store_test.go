@@ -49,6 +49,12 @@ func TestUserStorage(t *testing.T) {
 		err = store.CreateUser(user)
 		assert.Error(t, err)
 
+		// Restoring a user keeps its already hashed password
+		err = store.RestoreUser(result)
+		assert.NoError(t, err)
+		_, err = store.AuthenticateUser(ctx, "foo", password)
+		assert.NoError(t, err)
+
 		mockedUser := types.FixtureUser("bar")
 		mockedUser.Password = password
 		err = store.UpdateUser(mockedUser)
@@ -32,8 +32,7 @@ func (s *Store) GetCheckConfigWatcher(ctx context.Context) <-chan store.WatchEve
 	ch := make(chan store.WatchEventCheckConfig)
 
 	go func() {
-		watcher := clientv3.NewWatcher(s.client)
-		watcherChan := watcher.Watch(ctx, checkKeyBuilder.Build(""), clientv3.WithPrefix(), clientv3.WithCreatedNotify())
+		watcherChan := s.client.Watch(ctx, checkKeyBuilder.Build(""), clientv3.WithPrefix(), clientv3.WithCreatedNotify())
 		defer close(ch)
 
 		var (
@@ -75,8 +74,7 @@ func (s *Store) GetAssetWatcher(ctx context.Context) <-chan store.WatchEventAsse
 	ch := make(chan store.WatchEventAsset)
 
 	go func() {
-		watcher := clientv3.NewWatcher(s.client)
-		watcherChan := watcher.Watch(ctx, assetKeyBuilder.Build(""), clientv3.WithPrefix(), clientv3.WithCreatedNotify())
+		watcherChan := s.client.Watch(ctx, assetKeyBuilder.Build(""), clientv3.WithPrefix(), clientv3.WithCreatedNotify())
 		defer close(ch)
 
 		var (
@@ -118,8 +116,7 @@ func (s *Store) GetHookConfigWatcher(ctx context.Context) <-chan store.WatchEven
 	ch := make(chan store.WatchEventHookConfig)
 
 	go func() {
-		watcher := clientv3.NewWatcher(s.client)
-		watcherChan := watcher.Watch(ctx, hookKeyBuilder.Build(""), clientv3.WithPrefix(), clientv3.WithCreatedNotify())
+		watcherChan := s.client.Watch(ctx, hookKeyBuilder.Build(""), clientv3.WithPrefix(), clientv3.WithCreatedNotify())
 		defer close(ch)
 
 		var (
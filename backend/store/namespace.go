@@ -12,6 +12,10 @@ const (
 
 	// Root is the root of the sensu keyspace.
 	Root = "/sensu.io"
+
+	// MonitorsPathPrefix is the prefix under which the monitors keep the
+	// deadlines of the entities, and of their checks, by entity.
+	MonitorsPathPrefix = "monitors"
 )
 
 // Namespace describes the values in-which a Sensu resource may reside.
@@ -92,9 +92,6 @@ type Store interface {
 	// HookConfigStore provides an interface for managing hooks configuration
 	HookConfigStore
 
-	// KeepaliveStore provides an interface for managing entities keepalives
-	KeepaliveStore
-
 	// MutatorStore provides an interface for managing events mutators
 	MutatorStore
 
@@ -252,9 +249,9 @@ type EnvironmentStore interface {
 
 // ErrorStore provides methods for managing pipeline errors
 type ErrorStore interface {
-	// DeleteError deletes an error using the given entity, check and timestamp,
+	// DeleteError deletes an error using the given entity, check and name,
 	// within the organization and environment stored in ctx.
-	DeleteError(ctx context.Context, entity, check, timestamp string) error
+	DeleteError(ctx context.Context, entity, check, name string) error
 
 	// DeleteErrorsByEntity deletes all errors associated with the given entity,
 	// within the organization and environment stored in ctx.
@@ -264,9 +261,9 @@ type ErrorStore interface {
 	// entity and check within the organization and environment stored in ctx.
 	DeleteErrorsByEntityCheck(ctx context.Context, entity, check string) error
 
-	// GetError returns error associated with given entity, check and timestamp,
-	// in the given ctx's organization and environment.
-	GetError(ctx context.Context, entity, check, timestamp string) (*types.Error, error)
+	// GetError returns error associated with given entity, check and name, in
+	// the given ctx's organization and environment.
+	GetError(ctx context.Context, entity, check, name string) (*types.Error, error)
 
 	// GetErrors returns all errors in the given ctx's organization and
 	// environment.
@@ -348,19 +345,6 @@ type HandlerStore interface {
 	UpdateHandler(ctx context.Context, handler *types.Handler) error
 }
 
-// KeepaliveStore provides methods for managing entities keepalives
-type KeepaliveStore interface {
-	// DeleteFailingKeepalive deletes a failing keepalive record for a given entity.
-	DeleteFailingKeepalive(ctx context.Context, entity *types.Entity) error
-
-	// GetFailingKeepalives returns a slice of failing keepalives.
-	GetFailingKeepalives(ctx context.Context) ([]*types.KeepaliveRecord, error)
-
-	// UpdateFailingKeepalive updates the given entity keepalive with the given expiration
-	// in unix timestamp format
-	UpdateFailingKeepalive(ctx context.Context, entity *types.Entity, expiration int64) error
-}
-
 // MutatorStore provides methods for managing events mutators
 type MutatorStore interface {
 	// DeleteMutatorByName deletes a mutator using the given name and the
@@ -485,6 +469,10 @@ type UserStore interface {
 	// no error is  returned if none were found.
 	GetAllUsers() ([]*types.User, error)
 
+	// RestoreUser stores a user whose password is already hashed, such as one
+	// read from a backup, without hashing it again.
+	RestoreUser(user *types.User) error
+
 	// UpdateHandler updates a given user.
 	UpdateUser(user *types.User) error
 }
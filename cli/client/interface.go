@@ -11,6 +11,7 @@ type APIClient interface {
 	CheckAPIClient
 	EntityAPIClient
 	EnvironmentAPIClient
+	ErrorAPIClient
 	EventAPIClient
 	FilterAPIClient
 	HandlerAPIClient
@@ -83,6 +84,13 @@ type EnvironmentAPIClient interface {
 	UpdateEnvironment(*types.Environment) error
 }
 
+// ErrorAPIClient client methods for pipeline errors
+type ErrorAPIClient interface {
+	FetchError(entity, check, name string) (*types.Error, error)
+	ListErrors(string) ([]types.Error, error)
+	DeleteError(entity, check, name string) error
+}
+
 // EventAPIClient client methods for events
 type EventAPIClient interface {
 	FetchEvent(string, string) (*types.Event, error)
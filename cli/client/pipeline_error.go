@@ -0,0 +1,59 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/sensu/sensu-go/types"
+)
+
+func pipelineErrorPath(entity, check, name string) string {
+	const path = "/errors/%s/%s/%s"
+	return fmt.Sprintf(path, url.PathEscape(entity), url.PathEscape(check), url.PathEscape(name))
+}
+
+// FetchError fetches a specific pipeline error
+func (client *RestClient) FetchError(entity, check, name string) (*types.Error, error) {
+	var perr *types.Error
+	res, err := client.R().Get(pipelineErrorPath(entity, check, name))
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &perr)
+	return perr, err
+}
+
+// ListErrors fetches pipeline errors from Sensu API
+func (client *RestClient) ListErrors(org string) ([]types.Error, error) {
+	var perrs []types.Error
+
+	res, err := client.R().Get("/errors?org=" + url.QueryEscape(org))
+	if err != nil {
+		return perrs, err
+	}
+
+	if res.StatusCode() >= 400 {
+		return nil, unmarshalError(res)
+	}
+
+	err = json.Unmarshal(res.Body(), &perrs)
+	return perrs, err
+}
+
+// DeleteError deletes a pipeline error.
+func (client *RestClient) DeleteError(entity, check, name string) error {
+	res, err := client.R().Delete(pipelineErrorPath(entity, check, name))
+	if err != nil {
+		return err
+	}
+	if res.StatusCode() >= 400 {
+		return unmarshalError(res)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package testing
+
+import "github.com/sensu/sensu-go/types"
+
+// FetchError for use with mock lib
+func (c *MockClient) FetchError(entity, check, name string) (*types.Error, error) {
+	args := c.Called(entity, check, name)
+	return args.Get(0).(*types.Error), args.Error(1)
+}
+
+// ListErrors for use with mock lib
+func (c *MockClient) ListErrors(org string) ([]types.Error, error) {
+	args := c.Called(org)
+	return args.Get(0).([]types.Error), args.Error(1)
+}
+
+// DeleteError for use with mock lib
+func (c *MockClient) DeleteError(entity, check, name string) error {
+	args := c.Called(entity, check, name)
+	return args.Error(0)
+}
@@ -3,6 +3,7 @@ package asset
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"strings"
 
 	"github.com/AlecAivazis/survey"
@@ -39,6 +40,9 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	_ = cmd.Flags().StringP("url", "u", "", "the URL of the asset")
 	_ = cmd.Flags().StringSliceP("metadata", "m", []string{}, "metadata associated with asset")
 	_ = cmd.Flags().StringSlice("filter", []string{}, "queries used by an entity to determine if it should include the asset")
+	_ = cmd.Flags().StringSlice("mirror", []string{}, "URL of a mirror of the asset, tried in order when the asset can't be fetched from its URL")
+	_ = cmd.Flags().StringSlice("header", []string{}, "HTTP header sent with the requests fetching the asset, in the format 'KEY: VALUE'")
+	_ = cmd.Flags().String("signature-file", "", "path to the ASCII armored OpenPGP detached signature of the asset")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
@@ -147,6 +151,10 @@ func (cfgPtr *ConfigureAsset) administerQuestionnaire() error {
 			Name:   "filters",
 			Prompt: &survey.Input{Message: "Filters:"},
 		},
+		{
+			Name:   "mirrors",
+			Prompt: &survey.Input{Message: "Mirrors:"},
+		},
 	}
 
 	return survey.Ask(qs, &cfgPtr.cfg)
@@ -159,6 +167,9 @@ func (cfgPtr *ConfigureAsset) configureFromFlags() {
 	cfgPtr.setURL()
 	cfgPtr.setMeta()
 	cfgPtr.setFilters()
+	cfgPtr.setMirrors()
+	cfgPtr.setHeaders()
+	cfgPtr.setSignature()
 }
 
 func (cfgPtr *ConfigureAsset) setName() {
@@ -212,6 +223,40 @@ func (cfgPtr *ConfigureAsset) setFilters() {
 	}
 }
 
+func (cfgPtr *ConfigureAsset) setMirrors() {
+	if mirrors, err := cfgPtr.Flags.GetStringSlice("mirror"); err != nil {
+		panic(err)
+	} else {
+		cfgPtr.cfg.Mirrors = strings.Join(mirrors, ",")
+	}
+}
+
+func (cfgPtr *ConfigureAsset) setHeaders() {
+	if headers, err := cfgPtr.Flags.GetStringSlice("header"); err != nil {
+		panic(err)
+	} else {
+		err = cfgPtr.cfg.SetHeaders(headers)
+		cfgPtr.addError(err)
+	}
+}
+
+func (cfgPtr *ConfigureAsset) setSignature() {
+	path, err := cfgPtr.Flags.GetString("signature-file")
+	if err != nil {
+		panic(err)
+	}
+	if path == "" {
+		return
+	}
+
+	signature, err := ioutil.ReadFile(path)
+	if err != nil {
+		cfgPtr.addError(fmt.Errorf("unable to read signature file: %s", err))
+		return
+	}
+	cfgPtr.cfg.Signature = string(signature)
+}
+
 func (cfgPtr *ConfigureAsset) addError(err error) {
 	if err != nil {
 		cfgPtr.errors = append(cfgPtr.errors, err)
@@ -226,28 +271,46 @@ type Config struct {
 	URL     string
 	Meta    map[string]string
 	Filters string
+	Mirrors string
+	Headers map[string]string
+
+	Signature string
 }
 
 // SetMeta sets metadata given values
 func (cfgPtr *Config) SetMeta(metadata []string) error {
-	cfgPtr.Meta = make(map[string]string, len(metadata))
-	for _, meta := range metadata {
+	meta, err := parseKeyValues("Metadata", metadata)
+	cfgPtr.Meta = meta
+	return err
+}
+
+// SetHeaders sets HTTP headers given values
+func (cfgPtr *Config) SetHeaders(headers []string) error {
+	h, err := parseKeyValues("Header", headers)
+	cfgPtr.Headers = h
+	return err
+}
+
+func parseKeyValues(label string, values []string) (map[string]string, error) {
+	result := make(map[string]string, len(values))
+	for _, value := range values {
 		// TODO(james): naive
-		splitMeta := strings.SplitAfterN(meta, ":", 2)
+		splitValue := strings.SplitAfterN(value, ":", 2)
 
-		if len(splitMeta) == 2 {
-			key := strings.TrimSpace(strings.TrimRight(splitMeta[0], ":"))
-			val := strings.TrimSpace(splitMeta[1])
-			cfgPtr.Meta[key] = val
+		if len(splitValue) == 2 {
+			key := strings.TrimSpace(strings.TrimRight(splitValue[0], ":"))
+			val := strings.TrimSpace(splitValue[1])
+			result[key] = val
 		} else {
-			return fmt.Errorf(
-				"Metadata value '%s' appears invalid;"+
+			return result, fmt.Errorf(
+				"%s value '%s' appears invalid;"+
 					"should be in format 'KEY: VALUE'.",
-				splitMeta,
+				label,
+				splitValue,
 			)
 		}
 	}
-	return nil
+	return result, nil
 }
 
 // Copy applies configured details to given asset
@@ -258,4 +321,7 @@ func (cfgPtr *Config) Copy(asset *types.Asset) {
 	asset.URL = cfgPtr.URL
 	asset.Metadata = cfgPtr.Meta
 	asset.Filters = helpers.SafeSplitCSV(cfgPtr.Filters)
+	asset.Mirrors = helpers.SafeSplitCSV(cfgPtr.Mirrors)
+	asset.Headers = cfgPtr.Headers
+	asset.Signature = cfgPtr.Signature
 }
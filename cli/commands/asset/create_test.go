@@ -88,6 +88,9 @@ func TestConfigureAsset(t *testing.T) {
 	flags.StringSlice("filter", []string{}, "")
 	flags.String("sha512", "12345qwerty", "")
 	flags.String("url", "http://lol", "")
+	flags.StringSlice("mirror", []string{}, "")
+	flags.StringSlice("header", []string{}, "")
+	flags.String("signature-file", "", "")
 
 	// Too many args
 	cfg := ConfigureAsset{Flags: flags, Args: []string{"one", "too many"}, Org: "default"}
@@ -116,3 +119,32 @@ func TestConfigureAsset(t *testing.T) {
 	_, errs = cfg.Configure()
 	assert.NotEmpty(errs)
 }
+
+func TestConfigureAssetMirrorsHeaders(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("url", "http://lol"))
+	require.NoError(t, cmd.Flags().Set("sha512", "12345qwerty"))
+	require.NoError(t, cmd.Flags().Set("mirror", "https://mirror1.lol"))
+	require.NoError(t, cmd.Flags().Set("mirror", "file:///opt/assets/ruby22.tar"))
+	require.NoError(t, cmd.Flags().Set("header", "Authorization: Bearer lol"))
+
+	cfg := ConfigureAsset{Flags: cmd.Flags(), Args: []string{"ruby22"}, Org: "default"}
+	asset, errs := cfg.Configure()
+	assert.Empty(errs)
+	assert.Equal([]string{"https://mirror1.lol", "file:///opt/assets/ruby22.tar"}, asset.Mirrors)
+	assert.Equal("Bearer lol", asset.Headers["Authorization"])
+	assert.Empty(asset.Signature)
+
+	// Bad header
+	require.NoError(t, cmd.Flags().Set("header", "Authorization- lol"))
+	_, errs = cfg.Configure()
+	assert.NotEmpty(errs)
+
+	// Missing signature file
+	require.NoError(t, cmd.Flags().Set("signature-file", "/missing/ruby22.tar.asc"))
+	_, errs = cfg.Configure()
+	assert.NotEmpty(errs)
+}
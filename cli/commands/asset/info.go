@@ -7,6 +7,7 @@ import (
 
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/globals"
 	"github.com/sensu/sensu-go/cli/elements/list"
 	"github.com/sensu/sensu-go/types"
 	"github.com/spf13/cobra"
@@ -70,10 +71,18 @@ func printAssetToList(r *types.Asset, writer io.Writer) error {
 				Label: "URL",
 				Value: r.URL,
 			},
+			{
+				Label: "Mirrors",
+				Value: strings.Join(r.Mirrors, ", "),
+			},
 			{
 				Label: "SHA-512 Checksum",
 				Value: r.Sha512,
 			},
+			{
+				Label: "Signed",
+				Value: globals.BooleanStyleP(r.Signature != ""),
+			},
 			{
 				Label: "Filters",
 				Value: strings.Join(r.Filters, ", "),
@@ -3,9 +3,11 @@ package asset
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/AlecAivazis/survey"
 	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
 	"github.com/sensu/sensu-go/types"
 	"github.com/spf13/cobra"
 )
@@ -57,8 +59,9 @@ func UpdateCommand(cli *cli.SensuCli) *cobra.Command {
 }
 
 type assetOptions struct {
-	URL    string
-	Sha512 string
+	URL     string
+	Sha512  string
+	Mirrors string
 }
 
 func newAssetOptions() *assetOptions {
@@ -69,11 +72,13 @@ func newAssetOptions() *assetOptions {
 func (opts *assetOptions) copyFrom(a *types.Asset) {
 	opts.URL = a.URL
 	opts.Sha512 = a.Sha512
+	opts.Mirrors = strings.Join(a.Mirrors, ",")
 }
 
 func (opts *assetOptions) copyTo(a *types.Asset) {
 	a.URL = opts.URL
 	a.Sha512 = opts.Sha512
+	a.Mirrors = helpers.SafeSplitCSV(opts.Mirrors)
 }
 
 func (opts *assetOptions) administerQuestionnaire() error {
@@ -88,6 +93,10 @@ func (opts *assetOptions) administerQuestionnaire() error {
 			Prompt:   &survey.Input{Message: "SHA-512 Checksum:", Default: opts.Sha512},
 			Validate: survey.Required,
 		},
+		{
+			Name:   "mirrors",
+			Prompt: &survey.Input{Message: "Mirrors:", Default: opts.Mirrors},
+		},
 	}
 
 	return survey.Ask(qs, opts)
@@ -3,6 +3,7 @@ package check
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/commands/flags"
@@ -95,6 +96,8 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 	cmd.Flags().String("ttl", "", "time to live in seconds for which a check result is valid")
 	cmd.Flags().String("high-flap-threshold", "", "flap detection high threshold (percent state change) for the check")
 	cmd.Flags().String("low-flap-threshold", "", "flap detection low threshold (percent state change) for the check")
+	cmd.Flags().String("output-metric-format", "", "the metric format generated by the check command, one of "+strings.Join(types.OutputMetricFormats, ", "))
+	cmd.Flags().String("output-metric-handlers", "", "comma separated list of handlers to invoke with the metrics extracted from the check output")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
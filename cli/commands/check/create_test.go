@@ -89,6 +89,40 @@ func TestCreateCommandRunEClosureWithDepsSTDIN(t *testing.T) {
 	assert.Regexp("OK", out)
 }
 
+func TestCreateCommandRunEClosureWithOutputMetrics(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateCheck", mock.AnythingOfType("*types.CheckConfig")).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "echo 'heyhey'"))
+	require.NoError(t, cmd.Flags().Set("subscriptions", "system"))
+	require.NoError(t, cmd.Flags().Set("interval", "10"))
+	require.NoError(t, cmd.Flags().Set("output-metric-format", "graphite_plaintext"))
+	require.NoError(t, cmd.Flags().Set("output-metric-handlers", "influxdb"))
+	out, err := test.RunCmd(cmd, []string{"can-holla"})
+	require.NoError(t, err)
+
+	assert.Regexp("OK", out)
+}
+
+func TestCreateCommandRunEClosureWithInvalidOutputMetricFormat(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "echo 'heyhey'"))
+	require.NoError(t, cmd.Flags().Set("subscriptions", "system"))
+	require.NoError(t, cmd.Flags().Set("interval", "10"))
+	require.NoError(t, cmd.Flags().Set("output-metric-format", "foo"))
+	out, err := test.RunCmd(cmd, []string{"can-holla"})
+
+	assert.Empty(out)
+	assert.Error(err)
+}
+
 func TestCreateCommandRunEClosureWithServerErr(t *testing.T) {
 	assert := assert.New(t)
 
@@ -87,6 +87,14 @@ func printCheckToList(r *types.CheckConfig, writer io.Writer) error {
 				Label: "Handlers",
 				Value: strings.Join(r.Handlers, ", "),
 			},
+			{
+				Label: "Output Metric Format",
+				Value: r.OutputMetricFormat,
+			},
+			{
+				Label: "Output Metric Handlers",
+				Value: strings.Join(r.OutputMetricHandlers, ", "),
+			},
 			{
 				Label: "Runtime Assets",
 				Value: strings.Join(r.RuntimeAssets, ", "),
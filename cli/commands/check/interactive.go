@@ -17,22 +17,24 @@ const (
 )
 
 type checkOpts struct {
-	Name              string `survey:"name"`
-	Command           string `survey:"command"`
-	Interval          string `survey:"interval"`
-	Cron              string `survey:"cron"`
-	Subscriptions     string `survey:"subscriptions"`
-	Handlers          string `survey:"handlers"`
-	RuntimeAssets     string `survey:"assets"`
-	Env               string
-	Org               string
-	Publish           string `survey:"publish"`
-	ProxyEntityID     string `survey:"proxy-entity-id"`
-	Stdin             string `survey:"stdin"`
-	Timeout           string `survey:"timeout"`
-	TTL               string `survey:"ttl"`
-	HighFlapThreshold string `survey:"high-flap-threshold"`
-	LowFlapThreshold  string `survey:"low-flap-threshold"`
+	Name                 string `survey:"name"`
+	Command              string `survey:"command"`
+	Interval             string `survey:"interval"`
+	Cron                 string `survey:"cron"`
+	Subscriptions        string `survey:"subscriptions"`
+	Handlers             string `survey:"handlers"`
+	RuntimeAssets        string `survey:"assets"`
+	Env                  string
+	Org                  string
+	Publish              string `survey:"publish"`
+	ProxyEntityID        string `survey:"proxy-entity-id"`
+	Stdin                string `survey:"stdin"`
+	Timeout              string `survey:"timeout"`
+	TTL                  string `survey:"ttl"`
+	HighFlapThreshold    string `survey:"high-flap-threshold"`
+	LowFlapThreshold     string `survey:"low-flap-threshold"`
+	OutputMetricFormat   string `survey:"output-metric-format"`
+	OutputMetricHandlers string `survey:"output-metric-handlers"`
 }
 
 func newCheckOpts() *checkOpts {
@@ -55,6 +57,8 @@ func (opts *checkOpts) withCheck(check *types.CheckConfig) {
 	opts.Timeout = strconv.Itoa(int(check.Timeout))
 	opts.HighFlapThreshold = strconv.Itoa(int(check.HighFlapThreshold))
 	opts.LowFlapThreshold = strconv.Itoa(int(check.LowFlapThreshold))
+	opts.OutputMetricFormat = check.OutputMetricFormat
+	opts.OutputMetricHandlers = strings.Join(check.OutputMetricHandlers, ",")
 }
 
 func (opts *checkOpts) withFlags(flags *pflag.FlagSet) {
@@ -72,6 +76,8 @@ func (opts *checkOpts) withFlags(flags *pflag.FlagSet) {
 	opts.TTL, _ = flags.GetString("ttl")
 	opts.HighFlapThreshold, _ = flags.GetString("high-flap-threshold")
 	opts.LowFlapThreshold, _ = flags.GetString("low-flap-threshold")
+	opts.OutputMetricFormat, _ = flags.GetString("output-metric-format")
+	opts.OutputMetricHandlers, _ = flags.GetString("output-metric-handlers")
 
 	if org, _ := flags.GetString("organization"); org != "" {
 		opts.Org = org
@@ -226,6 +232,27 @@ func (opts *checkOpts) administerQuestionnaire(editing bool) error {
 				Default: opts.LowFlapThreshold,
 			},
 		},
+		{
+			Name: "output-metric-format",
+			Prompt: &survey.Input{
+				Message: "Output Metric Format:",
+				Help:    "The metric format generated by the check command. Value must be one of " + strings.Join(types.OutputMetricFormats, ", ") + ", or empty.",
+				Default: opts.OutputMetricFormat,
+			},
+			Validate: func(val interface{}) error {
+				if str := val.(string); str != "" {
+					return types.ValidateOutputMetricFormat(str)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "output-metric-handlers",
+			Prompt: &survey.Input{
+				Message: "Output Metric Handlers:",
+				Default: opts.OutputMetricHandlers,
+			},
+		},
 	}...)
 
 	return survey.Ask(qs, opts)
@@ -255,4 +282,6 @@ func (opts *checkOpts) Copy(check *types.CheckConfig) {
 	check.Ttl = int64(ttl)
 	check.HighFlapThreshold = uint32(highFlap)
 	check.LowFlapThreshold = uint32(lowFlap)
+	check.OutputMetricFormat = opts.OutputMetricFormat
+	check.OutputMetricHandlers = helpers.SafeSplitCSV(opts.OutputMetricHandlers)
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/sensu/sensu-go/cli/commands/logout"
 	"github.com/sensu/sensu-go/cli/commands/mutator"
 	"github.com/sensu/sensu-go/cli/commands/organization"
+	"github.com/sensu/sensu-go/cli/commands/pipelineerror"
 	"github.com/sensu/sensu-go/cli/commands/role"
 	"github.com/sensu/sensu-go/cli/commands/silenced"
 	"github.com/sensu/sensu-go/cli/commands/user"
@@ -38,6 +39,7 @@ func AddCommands(rootCmd *cobra.Command, cli *cli.SensuCli) {
 		config.HelpCommand(cli),
 		entity.HelpCommand(cli),
 		environment.HelpCommand(cli),
+		pipelineerror.HelpCommand(cli),
 		event.HelpCommand(cli),
 		filter.HelpCommand(cli),
 		handler.HelpCommand(cli),
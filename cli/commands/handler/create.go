@@ -64,13 +64,18 @@ func CreateCommand(cli *cli.SensuCli) *cobra.Command {
 
 	cmd.Flags().String("command", "", "command to be executed. The event data is passed to the process via STDIN")
 	cmd.Flags().String("env-vars", "", "comma separated list of key=value environment variables for the mutator command")
+	cmd.Flags().String("extension", "", "name of the registered extension to send events to, for grpc handlers")
 	cmd.Flags().String("filters", "", "comma separated list of filters to use when filtering events for the handler")
+	cmd.Flags().String("http-url", "", "URL the event data is sent to, for http handlers")
+	cmd.Flags().String("http-method", "", "HTTP method of the requests of http handlers (default POST)")
+	cmd.Flags().String("http-headers", "", "comma separated list of key=value HTTP headers for http handlers")
 	cmd.Flags().String("handlers", "", "comma separated list of handlers to call using the handler set")
 	cmd.Flags().StringP("mutator", "m", "", "Sensu event mutator (name) to use to mutate event data for the handler")
+	cmd.Flags().String("runtime-assets", "", "comma separated list of assets the handler command depends on")
 	cmd.Flags().String("socket-host", "", "host of handler socket")
 	cmd.Flags().String("socket-port", "", "port of handler socket")
 	cmd.Flags().StringP("timeout", "i", "", "execution duration timeout in seconds (hard stop)")
-	cmd.Flags().StringP("type", "t", typeDefault, "type of handler (pipe, tcp, udp, or set)")
+	cmd.Flags().StringP("type", "t", typeDefault, "type of handler (pipe, grpc, http, tcp, udp, or set)")
 
 	helpers.AddInteractiveFlag(cmd.Flags())
 	return cmd
@@ -6,6 +6,7 @@ import (
 
 	client "github.com/sensu/sensu-go/cli/client/testing"
 	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -53,6 +54,65 @@ func TestCreateCommandRunEClosureWithFlags(t *testing.T) {
 	assert.Nil(err)
 }
 
+func TestCreateCommandRunEClosureWithGRPCType(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateHandler", mock.MatchedBy(func(handler *types.Handler) bool {
+		return handler.Type == types.HandlerGRPCType && handler.Extension == "ext"
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("type", "grpc"))
+	require.NoError(t, cmd.Flags().Set("extension", "ext"))
+	out, err := test.RunCmd(cmd, []string{"test-handler"})
+
+	assert.Regexp("OK", out)
+	assert.Nil(err)
+}
+
+func TestCreateCommandRunEClosureWithHTTPType(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateHandler", mock.MatchedBy(func(handler *types.Handler) bool {
+		return handler.Type == types.HandlerHTTPType &&
+			handler.HTTP.URL == "https://example.com/hook" &&
+			handler.HTTP.Method == "PUT" &&
+			handler.HTTP.Headers["X-Token"] == "secret"
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("type", "http"))
+	require.NoError(t, cmd.Flags().Set("http-url", "https://example.com/hook"))
+	require.NoError(t, cmd.Flags().Set("http-method", "put"))
+	require.NoError(t, cmd.Flags().Set("http-headers", "X-Token=secret"))
+	out, err := test.RunCmd(cmd, []string{"test-handler"})
+
+	assert.Regexp("OK", out)
+	assert.Nil(err)
+}
+
+func TestCreateCommandRunEClosureWithRuntimeAssets(t *testing.T) {
+	assert := assert.New(t)
+
+	cli := test.NewMockCLI()
+	client := cli.Client.(*client.MockClient)
+	client.On("CreateHandler", mock.MatchedBy(func(handler *types.Handler) bool {
+		return len(handler.RuntimeAssets) == 2 && handler.RuntimeAssets[0] == "slack"
+	})).Return(nil)
+
+	cmd := CreateCommand(cli)
+	require.NoError(t, cmd.Flags().Set("command", "slack-handler"))
+	require.NoError(t, cmd.Flags().Set("runtime-assets", "slack,ruby"))
+	out, err := test.RunCmd(cmd, []string{"test-handler"})
+
+	assert.Regexp("OK", out)
+	assert.Nil(err)
+}
+
 func TestCreateCommandRunEClosureWithAPIErr(t *testing.T) {
 	assert := assert.New(t)
 
@@ -72,6 +72,22 @@ func printCheckToList(handler *types.Handler, writer io.Writer) error {
 			table.TitleStyle("RUN:"),
 			handler.Command,
 		)
+	case types.HandlerGRPCType:
+		execute = fmt.Sprintf(
+			"%s %s",
+			table.TitleStyle("SEND:"),
+			handler.Extension,
+		)
+	case types.HandlerHTTPType:
+		method := handler.HTTP.Method
+		if method == "" {
+			method = "POST"
+		}
+		execute = fmt.Sprintf(
+			"%s %s",
+			table.TitleStyle(method+":"),
+			handler.HTTP.URL,
+		)
 	case types.HandlerSetType:
 		execute = fmt.Sprintf(
 			"%s %s",
@@ -113,6 +129,10 @@ func printCheckToList(handler *types.Handler, writer io.Writer) error {
 				Label: "Environment Variables",
 				Value: strings.Join(handler.EnvVars, ", "),
 			},
+			{
+				Label: "Runtime Assets",
+				Value: strings.Join(handler.RuntimeAssets, ", "),
+			},
 		},
 	}
 
@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"sort"
 	"strconv"
 	"strings"
 
@@ -11,18 +12,23 @@ import (
 )
 
 type handlerOpts struct {
-	Name       string `survey:"name"`
-	Command    string `survey:"command"`
-	EnvVars    string `survey:"env-vars"`
-	Filters    string `survey:"filters"`
-	Handlers   string `survey:"handlers"`
-	Mutator    string `survey:"mutator"`
-	SocketHost string `survey:"socketHost"`
-	SocketPort string `survey:"socketPort"`
-	Timeout    string `survey:"timeout"`
-	Type       string `survey:"type"`
-	Env        string
-	Org        string
+	Name        string `survey:"name"`
+	Command     string `survey:"command"`
+	EnvVars     string `survey:"env-vars"`
+	Extension   string `survey:"extension"`
+	Filters     string `survey:"filters"`
+	Handlers    string `survey:"handlers"`
+	HTTPURL     string `survey:"httpURL"`
+	HTTPMethod  string `survey:"httpMethod"`
+	HTTPHeaders string `survey:"httpHeaders"`
+	Mutator     string `survey:"mutator"`
+	Assets      string `survey:"runtime-assets"`
+	SocketHost  string `survey:"socketHost"`
+	SocketPort  string `survey:"socketPort"`
+	Timeout     string `survey:"timeout"`
+	Type        string `survey:"type"`
+	Env         string
+	Org         string
 }
 
 const (
@@ -42,9 +48,11 @@ func (opts *handlerOpts) withHandler(handler *types.Handler) {
 
 	opts.Command = handler.Command
 	opts.EnvVars = strings.Join(handler.EnvVars, ",")
+	opts.Extension = handler.Extension
 	opts.Filters = strings.Join(handler.Filters, ",")
 	opts.Handlers = strings.Join(handler.Handlers, ",")
 	opts.Mutator = handler.Mutator
+	opts.Assets = strings.Join(handler.RuntimeAssets, ",")
 	opts.Timeout = strconv.FormatUint(uint64(handler.Timeout), 10)
 	opts.Type = handler.Type
 
@@ -52,14 +60,30 @@ func (opts *handlerOpts) withHandler(handler *types.Handler) {
 		opts.SocketHost = handler.Socket.Host
 		opts.SocketPort = strconv.FormatUint(uint64(handler.Socket.Port), 10)
 	}
+
+	if handler.HTTP != nil {
+		opts.HTTPURL = handler.HTTP.URL
+		opts.HTTPMethod = handler.HTTP.Method
+		headers := make([]string, 0, len(handler.HTTP.Headers))
+		for key, value := range handler.HTTP.Headers {
+			headers = append(headers, key+"="+value)
+		}
+		sort.Strings(headers)
+		opts.HTTPHeaders = strings.Join(headers, ",")
+	}
 }
 
 func (opts *handlerOpts) withFlags(flags *pflag.FlagSet) {
 	opts.Command, _ = flags.GetString("command")
 	opts.EnvVars, _ = flags.GetString("env-vars")
+	opts.Extension, _ = flags.GetString("extension")
 	opts.Filters, _ = flags.GetString("filters")
 	opts.Handlers, _ = flags.GetString("handlers")
+	opts.HTTPURL, _ = flags.GetString("http-url")
+	opts.HTTPMethod, _ = flags.GetString("http-method")
+	opts.HTTPHeaders, _ = flags.GetString("http-headers")
 	opts.Mutator, _ = flags.GetString("mutator")
+	opts.Assets, _ = flags.GetString("runtime-assets")
 	opts.SocketHost, _ = flags.GetString("socket-host")
 	opts.SocketPort, _ = flags.GetString("socket-port")
 	opts.Timeout, _ = flags.GetString("timeout")
@@ -82,6 +106,10 @@ func (opts *handlerOpts) administerQuestionnaire(editing bool) error {
 	switch opts.Type {
 	case types.HandlerPipeType:
 		return opts.queryForCommand()
+	case types.HandlerGRPCType:
+		return opts.queryForExtension()
+	case types.HandlerHTTPType:
+		return opts.queryForHTTP()
 	case types.HandlerTCPType:
 		fallthrough
 	case types.HandlerUDPType:
@@ -159,7 +187,7 @@ func (opts *handlerOpts) queryForBaseParameters(editing bool) error {
 			Name: "type",
 			Prompt: &survey.Select{
 				Message: "Type:",
-				Options: []string{"pipe", "tcp", "udp", "set"},
+				Options: []string{"pipe", "grpc", "http", "tcp", "udp", "set"},
 				Default: opts.Type,
 			},
 			Validate: survey.Required,
@@ -179,6 +207,61 @@ func (opts *handlerOpts) queryForCommand() error {
 			},
 			Validate: survey.Required,
 		},
+		{
+			Name: "runtime-assets",
+			Prompt: &survey.Input{
+				Message: "Runtime Assets:",
+				Default: opts.Assets,
+				Help:    "comma separated list of assets the handler command depends on",
+			},
+		},
+	}
+
+	return survey.Ask(qs, opts)
+}
+
+func (opts *handlerOpts) queryForExtension() error {
+	var qs = []*survey.Question{
+		{
+			Name: "extension",
+			Prompt: &survey.Input{
+				Message: "Extension:",
+				Default: opts.Extension,
+				Help:    "name of the registered extension to send events to",
+			},
+			Validate: survey.Required,
+		},
+	}
+
+	return survey.Ask(qs, opts)
+}
+
+func (opts *handlerOpts) queryForHTTP() error {
+	var qs = []*survey.Question{
+		{
+			Name: "httpURL",
+			Prompt: &survey.Input{
+				Message: "URL:",
+				Default: opts.HTTPURL,
+			},
+			Validate: survey.Required,
+		},
+		{
+			Name: "httpMethod",
+			Prompt: &survey.Input{
+				Message: "Method:",
+				Default: opts.HTTPMethod,
+				Help:    "HTTP method of the requests, POST by default",
+			},
+		},
+		{
+			Name: "httpHeaders",
+			Prompt: &survey.Input{
+				Message: "Headers:",
+				Default: opts.HTTPHeaders,
+				Help:    "A list of comma-separated key=value pairs of HTTP headers.",
+			},
+		},
 	}
 
 	return survey.Ask(qs, opts)
@@ -230,7 +313,9 @@ func (opts *handlerOpts) Copy(handler *types.Handler) {
 
 	handler.Command = opts.Command
 	handler.EnvVars = helpers.SafeSplitCSV(opts.EnvVars)
+	handler.Extension = opts.Extension
 	handler.Mutator = opts.Mutator
+	handler.RuntimeAssets = helpers.SafeSplitCSV(opts.Assets)
 	handler.Type = strings.ToLower(opts.Type)
 
 	if len(opts.Timeout) > 0 {
@@ -248,6 +333,22 @@ func (opts *handlerOpts) Copy(handler *types.Handler) {
 		}
 	}
 
+	if len(opts.HTTPURL) > 0 {
+		handler.HTTP = &types.HandlerHTTP{
+			URL:    opts.HTTPURL,
+			Method: strings.ToUpper(opts.HTTPMethod),
+		}
+		for _, header := range helpers.SafeSplitCSV(opts.HTTPHeaders) {
+			kv := strings.SplitN(header, "=", 2)
+			if len(kv) == 2 {
+				if handler.HTTP.Headers == nil {
+					handler.HTTP.Headers = make(map[string]string)
+				}
+				handler.HTTP.Headers[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+			}
+		}
+	}
+
 	filters := helpers.SafeSplitCSV(opts.Filters)
 	handler.Filters = make([]string, len(filters))
 	for i, f := range filters {
@@ -108,6 +108,22 @@ func printToTable(results interface{}, writer io.Writer) {
 						table.TitleStyle("RUN:"),
 						handler.Command,
 					)
+				case types.HandlerGRPCType:
+					return fmt.Sprintf(
+						"%s %s",
+						table.TitleStyle("SEND:"),
+						handler.Extension,
+					)
+				case types.HandlerHTTPType:
+					method := handler.HTTP.Method
+					if method == "" {
+						method = "POST"
+					}
+					return fmt.Sprintf(
+						"%s %s",
+						table.TitleStyle(method+":"),
+						handler.HTTP.URL,
+					)
 				case types.HandlerSetType:
 					return fmt.Sprintf(
 						"%s %s",
@@ -45,6 +45,7 @@ func TestCreateCommandRunEClosureWithAllFlags(t *testing.T) {
 	require.NoError(t, cmd.Flags().Set("command", "echo 'I like turtles'"))
 	require.NoError(t, cmd.Flags().Set("timeout", "60"))
 	require.NoError(t, cmd.Flags().Set("env-vars", "key1=val1,key2=val2"))
+	require.NoError(t, cmd.Flags().Set("runtime-assets", "jq"))
 	out, err := test.RunCmd(cmd, []string{"can-holla"})
 
 	assert.Regexp("OK", out)
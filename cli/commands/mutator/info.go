@@ -4,6 +4,7 @@ import (
 	"errors"
 	"io"
 	"strconv"
+	"strings"
 
 	"github.com/sensu/sensu-go/cli"
 	"github.com/sensu/sensu-go/cli/commands/helpers"
@@ -62,6 +63,10 @@ func printToList(mutator *types.Mutator, writer io.Writer) {
 				Label: "Timeout",
 				Value: strconv.FormatUint(uint64(mutator.Timeout), 10),
 			},
+			{
+				Label: "Runtime Assets",
+				Value: strings.Join(mutator.RuntimeAssets, ", "),
+			},
 			{
 				Label: "Organization",
 				Value: mutator.Organization,
@@ -15,6 +15,7 @@ type mutatorOpts struct {
 	Command string `survey:"command"`
 	Timeout string `survey:"timeout"`
 	EnvVars string `survey:"env-vars"`
+	Assets  string `survey:"runtime-assets"`
 	Env     string
 	Org     string
 }
@@ -32,12 +33,14 @@ func (opts *mutatorOpts) withMutator(mutator *types.Mutator) {
 	opts.Command = mutator.Command
 	opts.Timeout = strconv.FormatUint(uint64(mutator.Timeout), 10)
 	opts.EnvVars = strings.Join(mutator.EnvVars, ",")
+	opts.Assets = strings.Join(mutator.RuntimeAssets, ",")
 }
 
 func (opts *mutatorOpts) withFlags(flags *pflag.FlagSet) {
 	opts.Command, _ = flags.GetString("command")
 	opts.Timeout, _ = flags.GetString("timeout")
 	opts.EnvVars, _ = flags.GetString("env-vars")
+	opts.Assets, _ = flags.GetString("runtime-assets")
 
 	if org, _ := flags.GetString("organization"); org != "" {
 		opts.Org = org
@@ -98,6 +101,14 @@ func (opts *mutatorOpts) administerQuestionnaire(editing bool) error {
 				Default: opts.EnvVars,
 			},
 		},
+		{
+			Name: "runtime-assets",
+			Prompt: &survey.Input{
+				Message: "Runtime Assets:",
+				Help:    "comma separated list of assets the mutator command depends on",
+				Default: opts.Assets,
+			},
+		},
 	}...)
 
 	return survey.Ask(qs, opts)
@@ -110,6 +121,7 @@ func (opts *mutatorOpts) Copy(mutator *types.Mutator) {
 
 	mutator.Command = opts.Command
 	mutator.EnvVars = helpers.SafeSplitCSV(opts.EnvVars)
+	mutator.RuntimeAssets = helpers.SafeSplitCSV(opts.Assets)
 
 	if len(opts.Timeout) > 0 {
 		t, _ := strconv.ParseUint(opts.Timeout, 10, 32)
@@ -0,0 +1,49 @@
+package pipelineerror
+
+import (
+	"fmt"
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteCommandRunEClosure(t *testing.T) {
+	cli := test.NewMockCLI()
+	cli.Client.(*client.MockClient).
+		On("DeleteError", "foo", "check_foo", "error1").
+		Return(nil)
+
+	cmd := DeleteCommand(cli)
+	require.NoError(t, cmd.Flags().Set("skip-confirm", "t"))
+	out, err := test.RunCmd(cmd, []string{"foo", "check_foo", "error1"})
+
+	assert.Contains(t, out, "Deleted")
+	assert.Nil(t, err)
+}
+
+func TestDeleteCommandRunMissingArgs(t *testing.T) {
+	cli := test.NewMockCLI()
+	cmd := DeleteCommand(cli)
+	require.NoError(t, cmd.Flags().Set("skip-confirm", "t"))
+	out, err := test.RunCmd(cmd, []string{"foo", "check_foo"})
+
+	require.Error(t, err)
+	assert.Contains(t, out, "Usage")
+}
+
+func TestDeleteCommandRunEClosureWithErr(t *testing.T) {
+	cli := test.NewMockCLI()
+	cli.Client.(*client.MockClient).
+		On("DeleteError", "foo", "check_foo", "error1").
+		Return(fmt.Errorf("error"))
+
+	cmd := DeleteCommand(cli)
+	require.NoError(t, cmd.Flags().Set("skip-confirm", "t"))
+	out, err := test.RunCmd(cmd, []string{"foo", "check_foo", "error1"})
+
+	assert.Equal(t, "error", err.Error())
+	assert.Empty(t, out)
+}
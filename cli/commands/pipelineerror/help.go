@@ -0,0 +1,23 @@
+// Package pipelineerror provides the sensuctl commands used to manage the
+// errors recorded by the event pipeline.
+package pipelineerror
+
+import (
+	"github.com/sensu/sensu-go/cli"
+	"github.com/spf13/cobra"
+)
+
+// HelpCommand defines new error command
+func HelpCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "error",
+		Short: "Manage pipeline errors",
+	}
+
+	// Add sub-commands
+	cmd.AddCommand(ListCommand(cli))
+	cmd.AddCommand(InfoCommand(cli))
+	cmd.AddCommand(DeleteCommand(cli))
+
+	return cmd
+}
@@ -0,0 +1,84 @@
+package pipelineerror
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/list"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// InfoCommand defines new error info command
+func InfoCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "info [ENTITY] [CHECK] [NAME]",
+		Short:        "show detailed pipeline error information",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 3 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+
+			// Fetch error from API
+			perr, err := cli.Client.FetchError(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+
+			// Determine the format to use to output the data
+			var format string
+			if format = helpers.GetChangedStringValueFlag("format", cmd.Flags()); format == "" {
+				format = cli.Config.Format()
+			}
+
+			if format == "json" {
+				return helpers.PrintJSON(perr, cmd.OutOrStdout())
+			}
+			return printToList(perr, cmd.OutOrStdout())
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+
+	return cmd
+}
+
+func printToList(perr *types.Error, writer io.Writer) error {
+	cfg := &list.Config{
+		Title: fmt.Sprintf("%s - %s", perr.Event.Entity.ID, perr.Name),
+		Rows: []*list.Row{
+			{
+				Label: "Name",
+				Value: perr.Name,
+			},
+			{
+				Label: "Entity",
+				Value: perr.Event.Entity.ID,
+			},
+			{
+				Label: "Check",
+				Value: perr.CheckName(),
+			},
+			{
+				Label: "Component",
+				Value: perr.Component,
+			},
+			{
+				Label: "Message",
+				Value: perr.Message,
+			},
+			{
+				Label: "Timestamp",
+				Value: time.Unix(perr.Timestamp, 0).String(),
+			},
+		},
+	}
+
+	return list.Print(writer, cfg)
+}
@@ -0,0 +1,50 @@
+package pipelineerror
+
+import (
+	"testing"
+
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInfoCommandRunEClosure(t *testing.T) {
+	cli := test.NewMockCLI()
+	cli.Client.(*client.MockClient).
+		On("FetchError", "agent", "check", "error1").
+		Return(types.FixtureError("error1", "handler slack: exit status 1"), nil)
+	cli.Config.(*client.MockConfig).On("Format").Return("json")
+
+	cmd := InfoCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"agent", "check", "error1"})
+
+	assert.Contains(t, out, "handler slack: exit status 1")
+	assert.Nil(t, err)
+}
+
+func TestInfoCommandRunEClosureWithTable(t *testing.T) {
+	cli := test.NewMockCLI()
+	cli.Client.(*client.MockClient).
+		On("FetchError", "agent", "check", "error1").
+		Return(types.FixtureError("error1", "handler slack: exit status 1"), nil)
+	cli.Config.(*client.MockConfig).On("Format").Return("tabular")
+
+	cmd := InfoCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"agent", "check", "error1"})
+
+	assert.Contains(t, out, "Component")
+	assert.Contains(t, out, "pipelined")
+	assert.Nil(t, err)
+}
+
+func TestInfoCommandRunMissingArgs(t *testing.T) {
+	cli := test.NewMockCLI()
+	cli.Config.(*client.MockConfig).On("Format").Return("json")
+	cmd := InfoCommand(cli)
+	out, err := test.RunCmd(cmd, []string{"agent"})
+
+	require.Error(t, err)
+	assert.Contains(t, out, "Usage")
+}
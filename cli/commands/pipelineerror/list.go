@@ -0,0 +1,98 @@
+package pipelineerror
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/sensu/sensu-go/cli"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	"github.com/sensu/sensu-go/cli/commands/helpers"
+	"github.com/sensu/sensu-go/cli/elements/table"
+	"github.com/sensu/sensu-go/types"
+	"github.com/spf13/cobra"
+)
+
+// ListCommand defines new list errors command
+func ListCommand(cli *cli.SensuCli) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "list",
+		Short:        "list pipeline errors",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				_ = cmd.Help()
+				return errors.New("invalid argument(s) received")
+			}
+			org := cli.Config.Organization()
+			if ok, _ := cmd.Flags().GetBool(flags.AllOrgs); ok {
+				org = "*"
+			}
+
+			// Fetch errors from API
+			results, err := cli.Client.ListErrors(org)
+			if err != nil {
+				return err
+			}
+
+			// Print the results based on the user preferences
+			return helpers.Print(cmd, cli.Config.Format(), printToTable, results)
+		},
+	}
+
+	helpers.AddFormatFlag(cmd.Flags())
+	helpers.AddAllOrganization(cmd.Flags())
+
+	return cmd
+}
+
+func printToTable(results interface{}, writer io.Writer) {
+	table := table.New([]*table.Column{
+		{
+			Title:       "Name",
+			ColumnStyle: table.PrimaryTextStyle,
+			CellTransformer: func(data interface{}) string {
+				perr, _ := data.(types.Error)
+				return perr.Name
+			},
+		},
+		{
+			Title: "Entity",
+			CellTransformer: func(data interface{}) string {
+				perr, _ := data.(types.Error)
+				return perr.Event.Entity.ID
+			},
+		},
+		{
+			Title: "Check",
+			CellTransformer: func(data interface{}) string {
+				perr, _ := data.(types.Error)
+				return perr.CheckName()
+			},
+		},
+		{
+			Title: "Component",
+			CellTransformer: func(data interface{}) string {
+				perr, _ := data.(types.Error)
+				return perr.Component
+			},
+		},
+		{
+			Title: "Message",
+			CellTransformer: func(data interface{}) string {
+				perr, _ := data.(types.Error)
+				return perr.Message
+			},
+		},
+		{
+			Title: "Timestamp",
+			CellTransformer: func(data interface{}) string {
+				perr, _ := data.(types.Error)
+				time := time.Unix(perr.Timestamp, 0)
+				return time.String()
+			},
+		},
+	})
+
+	table.Render(writer, results)
+}
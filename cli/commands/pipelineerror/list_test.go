@@ -0,0 +1,81 @@
+package pipelineerror
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sensu/sensu-go/cli"
+	client "github.com/sensu/sensu-go/cli/client/testing"
+	"github.com/sensu/sensu-go/cli/commands/flags"
+	test "github.com/sensu/sensu-go/cli/commands/testing"
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCommand(t *testing.T) {
+	cli := newConfiguredCLI()
+	cmd := ListCommand(cli)
+
+	assert.NotNil(t, cmd, "cmd should be returned")
+	assert.NotNil(t, cmd.RunE, "cmd should be able to be executed")
+	assert.Regexp(t, "list", cmd.Use)
+	assert.Regexp(t, "errors", cmd.Short)
+}
+
+func TestListCommandRunEClosure(t *testing.T) {
+	cli := newConfiguredCLI()
+	cli.Client.(*client.MockClient).On("ListErrors", mock.Anything).Return([]types.Error{
+		*types.FixtureError("error1", "handler something failed"),
+		*types.FixtureError("error2", "mutator funny failed"),
+	}, nil)
+
+	cmd := ListCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flags.Format, "json"))
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.Contains(t, out, "something")
+	assert.Contains(t, out, "funny")
+	assert.Nil(t, err)
+}
+
+func TestListCommandRunEClosureWithTable(t *testing.T) {
+	metricsErr := types.FixtureError("error2", "handler failed")
+	metricsErr.Event.Check = nil
+
+	cli := newConfiguredCLI()
+	cli.Client.(*client.MockClient).On("ListErrors", mock.Anything).Return([]types.Error{
+		*types.FixtureError("error1", "handler failed"),
+		*metricsErr,
+	}, nil)
+
+	cmd := ListCommand(cli)
+	require.NoError(t, cmd.Flags().Set(flags.Format, "none"))
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.Contains(t, out, "Component") // Heading
+	assert.Contains(t, out, "error1")
+	assert.Contains(t, out, "error2")
+	assert.Nil(t, err)
+}
+
+func TestListCommandRunEClosureWithErr(t *testing.T) {
+	cli := newConfiguredCLI()
+	cli.Client.(*client.MockClient).
+		On("ListErrors", mock.Anything).
+		Return([]types.Error{}, errors.New("fire"))
+
+	cmd := ListCommand(cli)
+	out, err := test.RunCmd(cmd, []string{})
+
+	assert.Empty(t, out)
+	assert.Error(t, err)
+}
+
+func newConfiguredCLI() *cli.SensuCli {
+	cli := test.NewMockCLI()
+	config := cli.Config.(*client.MockConfig)
+	config.On("Format").Return("json")
+	return cli
+}
@@ -2,8 +2,10 @@
 package command
 
 import (
-	"bytes"
 	"context"
+	"errors"
+	"io"
+	"os"
 	"os/exec"
 	"strings"
 	"syscall"
@@ -48,9 +50,31 @@ type Execution struct {
 	// not specified.
 	Timeout int
 
+	// User is the name or ID of the user the command runs as.
+	User string
+
+	// Group is the name or ID of the group the command runs as.
+	Group string
+
+	// Limits are the resource limits of the command process.
+	Limits ResourceLimits
+
+	// MaxOutputSize is the maximum size, in bytes, of the captured output,
+	// which is truncated beyond it. The output is not limited if 0.
+	MaxOutputSize int
+
 	// Combined command execution STDOUT/ERR.
 	Output string
 
+	// Stdout is the command execution STDOUT, truncated to MaxOutputSize.
+	Stdout string
+
+	// Stderr is the command execution STDERR, truncated to MaxOutputSize.
+	Stderr string
+
+	// OutputTruncated indicates that the output exceeded MaxOutputSize.
+	OutputTruncated bool
+
 	// Command execution exit status.
 	Status int
 
@@ -58,6 +82,27 @@ type Execution struct {
 	Duration float64
 }
 
+// ResourceLimits are the limits of the resources used by a command process,
+// which are only supported on Linux. A limit of 0 means no limit.
+type ResourceLimits struct {
+	// CPUTime is the maximum CPU time in seconds.
+	CPUTime uint64
+
+	// Memory is the maximum size of the virtual memory in bytes.
+	Memory uint64
+
+	// OpenFiles is the maximum number of open files.
+	OpenFiles uint64
+
+	// Processes is the maximum number of processes of the user.
+	Processes uint64
+}
+
+// IsSet returns true if any of the limits is set.
+func (l ResourceLimits) IsSet() bool {
+	return l.CPUTime > 0 || l.Memory > 0 || l.OpenFiles > 0 || l.Processes > 0
+}
+
 // ExecuteCommand executes a system command (fork/exec) with a
 // timeout, optionally writing to STDIN, capturing its combined output
 // (STDOUT/ERR) and exit status.
@@ -78,8 +123,37 @@ func ExecuteCommand(ctx context.Context, execution *Execution) (*Execution, erro
 	ctx, timeout := context.WithCancel(ctx)
 	defer timeout()
 
+	// The resource limits are applied once the shell process is started, so
+	// the shell waits for them before running the command.
+	commandStr := execution.Command
+	var gate, release *os.File
+	if execution.Limits.IsSet() {
+		if !resourceLimitsSupported {
+			return execution, errors.New("resource limits are not supported on this platform")
+		}
+
+		var err error
+		gate, release, err = os.Pipe()
+		if err != nil {
+			return execution, err
+		}
+		defer gate.Close()
+		defer release.Close()
+
+		commandStr = "read _ <&3; exec 3<&-; " + commandStr
+	}
+
 	// Taken from Sensu-Spawn (Sensu 1.x.x).
-	cmd = Command(ctx, execution.Command)
+	cmd = Command(ctx, commandStr)
+	if gate != nil {
+		cmd.ExtraFiles = []*os.File{gate}
+	}
+
+	if execution.User != "" || execution.Group != "" {
+		if err := SetCredential(cmd, execution.User, execution.Group); err != nil {
+			return execution, err
+		}
+	}
 
 	// Set the ENV for the command if it is set
 	if len(execution.Env) > 0 {
@@ -87,11 +161,13 @@ func ExecuteCommand(ctx context.Context, execution *Execution) (*Execution, erro
 	}
 
 	// Share an output buffer between STDOUT/ERR, following the
-	// Nagios plugin spec.
-	var output bytes.Buffer
+	// Nagios plugin spec, while also capturing them separately.
+	output := &outputBuffer{max: execution.MaxOutputSize}
+	stdout := &outputBuffer{max: execution.MaxOutputSize}
+	stderr := &outputBuffer{max: execution.MaxOutputSize}
 
-	cmd.Stdout = &output
-	cmd.Stderr = &output
+	cmd.Stdout = io.MultiWriter(output, stdout)
+	cmd.Stderr = io.MultiWriter(output, stderr)
 
 	// If Input is specified, write to STDIN.
 	if execution.Input != "" {
@@ -121,12 +197,24 @@ func ExecuteCommand(ctx context.Context, execution *Execution) (*Execution, erro
 		return execution, err
 	}
 
+	if gate != nil {
+		if err := setResourceLimits(cmd.Process.Pid, execution.Limits); err != nil {
+			_ = cmd.Process.Kill()
+			_ = cmd.Wait()
+			return execution, err
+		}
+		_, _ = release.Write([]byte("\n"))
+	}
+
 	err := cmd.Wait()
 	if timer != nil {
 		timer.Stop()
 	}
 
 	execution.Output = output.String()
+	execution.Stdout = stdout.String()
+	execution.Stderr = stderr.String()
+	execution.OutputTruncated = output.Truncated()
 
 	// The command execution timed out if the context was cancelled prematurely
 	if ctx.Err() == context.Canceled {
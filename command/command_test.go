@@ -102,3 +102,39 @@ func TestExecuteCommand(t *testing.T) {
 	assert.Equal(t, 2, sleepMultipleExec.Status)
 	assert.NotEqual(t, 0, sleepMultipleExec.Duration)
 }
+
+func TestExecuteCommandOutput(t *testing.T) {
+	// test that stdout and stderr are also captured separately
+	outputs := &Execution{Command: "echo foo && echo bar 1>&2"}
+
+	outputsExec, outputsErr := ExecuteCommand(context.Background(), outputs)
+	assert.NoError(t, outputsErr)
+	assert.Equal(t, "foo\n", testutil.CleanOutput(outputsExec.Stdout))
+	assert.Equal(t, "bar\n", testutil.CleanOutput(outputsExec.Stderr))
+	assert.Equal(t, "foo\nbar\n", testutil.CleanOutput(outputsExec.Output))
+	assert.False(t, outputsExec.OutputTruncated)
+
+	// test that the output is truncated beyond the maximum size
+	long := &Execution{Command: "echo foobarbaz", MaxOutputSize: 6}
+
+	longExec, longErr := ExecuteCommand(context.Background(), long)
+	assert.NoError(t, longErr)
+	assert.Equal(t, "foobar", longExec.Output)
+	assert.True(t, longExec.OutputTruncated)
+	assert.Equal(t, 0, longExec.Status)
+}
+
+func TestOutputBuffer(t *testing.T) {
+	buf := &outputBuffer{max: 5}
+
+	n, err := buf.Write([]byte("foo"))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.False(t, buf.Truncated())
+
+	n, err = buf.Write([]byte("barbaz"))
+	assert.NoError(t, err)
+	assert.Equal(t, 6, n)
+	assert.Equal(t, "fooba", buf.String())
+	assert.True(t, buf.Truncated())
+}
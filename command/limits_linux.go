@@ -0,0 +1,39 @@
+package command
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// rlimitNproc is the RLIMIT_NPROC resource, missing from the syscall package.
+const rlimitNproc = 6
+
+// resourceLimitsSupported indicates if resource limits can be applied to
+// commands on this platform.
+const resourceLimitsSupported = true
+
+// setResourceLimits applies the resource limits to the running process.
+func setResourceLimits(pid int, limits ResourceLimits) error {
+	resources := []struct {
+		resource int
+		value    uint64
+	}{
+		{syscall.RLIMIT_CPU, limits.CPUTime},
+		{syscall.RLIMIT_AS, limits.Memory},
+		{syscall.RLIMIT_NOFILE, limits.OpenFiles},
+		{rlimitNproc, limits.Processes},
+	}
+
+	for _, r := range resources {
+		if r.value == 0 {
+			continue
+		}
+		rlimit := syscall.Rlimit{Cur: r.value, Max: r.value}
+		_, _, errno := syscall.RawSyscall6(syscall.SYS_PRLIMIT64, uintptr(pid), uintptr(r.resource), uintptr(unsafe.Pointer(&rlimit)), 0, 0, 0)
+		if errno != 0 {
+			return errno
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sensu/sensu-go/testing/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteCommandLimits(t *testing.T) {
+	ulimit := &Execution{
+		Command: "ulimit -n",
+		Limits:  ResourceLimits{OpenFiles: 64},
+	}
+
+	ulimitExec, ulimitErr := ExecuteCommand(context.Background(), ulimit)
+	assert.NoError(t, ulimitErr)
+	assert.Equal(t, "64\n", testutil.CleanOutput(ulimitExec.Output))
+	assert.Equal(t, 0, ulimitExec.Status)
+}
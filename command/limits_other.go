@@ -0,0 +1,14 @@
+// +build !linux
+
+package command
+
+import "errors"
+
+// resourceLimitsSupported indicates if resource limits can be applied to
+// commands on this platform.
+const resourceLimitsSupported = false
+
+// setResourceLimits is not supported on this platform.
+func setResourceLimits(pid int, limits ResourceLimits) error {
+	return errors.New("resource limits are not supported on this platform")
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"bytes"
+	"sync"
+)
+
+// outputBuffer captures the output of a command, up to a maximum size beyond
+// which the output is discarded. It is safe for concurrent writes, so STDOUT
+// and STDERR can share it.
+type outputBuffer struct {
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	max       int
+	truncated bool
+}
+
+// Write appends p to the buffer, truncating it to the maximum size. It never
+// fails, so the command is not interrupted by the truncation.
+func (b *outputBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	n := len(p)
+	if b.max > 0 {
+		room := b.max - b.buf.Len()
+		if room < 0 {
+			room = 0
+		}
+		if n > room {
+			p = p[:room]
+			b.truncated = true
+		}
+	}
+	_, _ = b.buf.Write(p)
+
+	return n, nil
+}
+
+// String returns the captured output.
+func (b *outputBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// Truncated returns true if some of the output was discarded.
+func (b *outputBuffer) Truncated() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.truncated
+}
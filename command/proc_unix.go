@@ -5,6 +5,8 @@ package command
 import (
 	"context"
 	"os/exec"
+	"os/user"
+	"strconv"
 	"syscall"
 )
 
@@ -15,10 +17,78 @@ func Command(ctx context.Context, command string) *exec.Cmd {
 
 // SetProcessGroup sets the process group of the command process
 func SetProcessGroup(cmd *exec.Cmd) {
-	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Setpgid = true
+}
+
+// SetCredential sets the user and group, given by name or ID, of the command
+// process. The group defaults to the primary group of the user, and the user
+// to the current one.
+func SetCredential(cmd *exec.Cmd, username, groupname string) error {
+	var (
+		u   *user.User
+		err error
+	)
+	if username != "" {
+		u, err = lookupUser(username)
+	} else {
+		u, err = user.Current()
+	}
+	if err != nil {
+		return err
+	}
+
+	gid := u.Gid
+	if groupname != "" {
+		g, err := lookupGroup(groupname)
+		if err != nil {
+			return err
+		}
+		gid = g.Gid
+	}
+
+	uidNum, err := strconv.ParseUint(u.Uid, 10, 32)
+	if err != nil {
+		return err
+	}
+	gidNum, err := strconv.ParseUint(gid, 10, 32)
+	if err != nil {
+		return err
+	}
+
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.Credential = &syscall.Credential{Uid: uint32(uidNum), Gid: uint32(gidNum)}
+
+	return nil
 }
 
 // KillProcess kills the command process and any child processes
 func KillProcess(cmd *exec.Cmd) error {
 	return syscall.Kill(-cmd.Process.Pid, syscall.SIGKILL)
 }
+
+// lookupUser looks up a user by name, then by ID.
+func lookupUser(username string) (*user.User, error) {
+	u, err := user.Lookup(username)
+	if _, ok := err.(user.UnknownUserError); ok {
+		if _, perr := strconv.Atoi(username); perr == nil {
+			return user.LookupId(username)
+		}
+	}
+	return u, err
+}
+
+// lookupGroup looks up a group by name, then by ID.
+func lookupGroup(groupname string) (*user.Group, error) {
+	g, err := user.LookupGroup(groupname)
+	if _, ok := err.(user.UnknownGroupError); ok {
+		if _, perr := strconv.Atoi(groupname); perr == nil {
+			return user.LookupGroupId(groupname)
+		}
+	}
+	return g, err
+}
@@ -4,6 +4,7 @@ package command
 
 import (
 	"context"
+	"errors"
 	"os/exec"
 	"syscall"
 )
@@ -15,7 +16,15 @@ func Command(ctx context.Context, command string) *exec.Cmd {
 
 // SetProcessGroup sets the process group of the command process
 func SetProcessGroup(cmd *exec.Cmd) {
-	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+	if cmd.SysProcAttr == nil {
+		cmd.SysProcAttr = &syscall.SysProcAttr{}
+	}
+	cmd.SysProcAttr.CreationFlags |= syscall.CREATE_NEW_PROCESS_GROUP
+}
+
+// SetCredential is not supported on Windows.
+func SetCredential(cmd *exec.Cmd, username, groupname string) error {
+	return errors.New("running commands as another user is not supported on windows")
 }
 
 // KillProcess kills the command process and any child processes
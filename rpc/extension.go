@@ -0,0 +1,220 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// DefaultDialTimeout is the time allowed to establish the connection to an
+	// extension.
+	DefaultDialTimeout = 10 * time.Second
+
+	// DefaultCallTimeout is the time allowed for an extension to answer a
+	// request, unless a timeout is given by the caller.
+	DefaultCallTimeout = 60 * time.Second
+)
+
+// errClosed is returned when an extension is requested from a closed pool
+var errClosed = errors.New("extension pool is closed")
+
+// ExtensionExecutor invokes the RPCs of an extension.
+type ExtensionExecutor interface {
+	// HandleEvent sends the event, along with its mutated form, to the
+	// extension.
+	HandleEvent(ctx context.Context, event *types.Event, mutated []byte) error
+
+	// MutateEvent returns the event mutated by the extension.
+	MutateEvent(ctx context.Context, event *types.Event) ([]byte, error)
+
+	// FilterEvent returns true if the extension filters out the event.
+	FilterEvent(ctx context.Context, event *types.Event) (bool, error)
+}
+
+// ExtensionPool dials the extensions and keeps one connection per extension
+// URL, which is shared by all the callers of the extension.
+type ExtensionPool struct {
+	// DialTimeout is the time allowed to establish a connection.
+	DialTimeout time.Duration
+
+	// CallTimeout is the time allowed for a request, when the caller's
+	// context has no deadline.
+	CallTimeout time.Duration
+
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+// NewExtensionPool returns an empty ExtensionPool using the default
+// timeouts.
+func NewExtensionPool() *ExtensionPool {
+	return &ExtensionPool{
+		DialTimeout: DefaultDialTimeout,
+		CallTimeout: DefaultCallTimeout,
+		conns:       make(map[string]*grpc.ClientConn),
+	}
+}
+
+// Get returns an ExtensionExecutor for the extension, dialing it if there is
+// no connection to its URL yet. Dialing doesn't block the other callers; if
+// two of them dial the same URL, the first connection is kept.
+func (p *ExtensionPool) Get(ext *types.Extension) (ExtensionExecutor, error) {
+	conn, err := p.conn(ext.URL)
+	if err != nil {
+		return nil, err
+	}
+	if conn == nil {
+		dialed, err := p.dial(ext.URL)
+		if err != nil {
+			return nil, fmt.Errorf("could not dial extension %s: %s", ext.Name, err)
+		}
+		if conn, err = p.keep(ext.URL, dialed); err != nil {
+			return nil, err
+		}
+	}
+
+	return &grpcExtension{
+		client:      NewExtensionClient(conn),
+		callTimeout: p.CallTimeout,
+	}, nil
+}
+
+// conn returns the connection to the URL, or nil if there is none yet.
+func (p *ExtensionPool) conn(rawURL string) (*grpc.ClientConn, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conns == nil {
+		return nil, errClosed
+	}
+	return p.conns[rawURL], nil
+}
+
+// keep adds the dialed connection to the pool and returns it, unless another
+// connection to the URL was added meanwhile, in which case the dialed one is
+// closed and the other one returned.
+func (p *ExtensionPool) keep(rawURL string, dialed *grpc.ClientConn) (*grpc.ClientConn, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.conns == nil {
+		_ = dialed.Close()
+		return nil, errClosed
+	}
+	if conn, ok := p.conns[rawURL]; ok {
+		_ = dialed.Close()
+		return conn, nil
+	}
+	p.conns[rawURL] = dialed
+	return dialed, nil
+}
+
+// Close closes all the connections of the pool. The pool can not be used
+// afterwards.
+func (p *ExtensionPool) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var err error
+	for _, conn := range p.conns {
+		if cerr := conn.Close(); cerr != nil {
+			err = cerr
+		}
+	}
+	p.conns = nil
+
+	return err
+}
+
+// dial connects to the extension URL, using TLS if its scheme is https. URLs
+// without a scheme are considered as host:port addresses.
+func (p *ExtensionPool) dial(rawURL string) (*grpc.ClientConn, error) {
+	target := rawURL
+	opts := []grpc.DialOption{grpc.WithBlock(), grpc.WithTimeout(p.DialTimeout)}
+
+	u, err := url.Parse(rawURL)
+	if err == nil && u.Host != "" {
+		target = u.Host
+		if u.Scheme == "https" {
+			creds := credentials.NewTLS(&tls.Config{ServerName: u.Hostname()})
+			opts = append(opts, grpc.WithTransportCredentials(creds))
+		} else {
+			opts = append(opts, grpc.WithInsecure())
+		}
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+
+	return grpc.Dial(target, opts...)
+}
+
+// grpcExtension is an ExtensionExecutor using a pooled gRPC connection.
+type grpcExtension struct {
+	client      ExtensionClient
+	callTimeout time.Duration
+}
+
+// withTimeout applies the call timeout to ctx if it has no deadline.
+func (e *grpcExtension) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || e.callTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, e.callTimeout)
+}
+
+// HandleEvent sends the event to the extension HandleEvent RPC.
+func (e *grpcExtension) HandleEvent(ctx context.Context, event *types.Event, mutated []byte) error {
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := e.client.HandleEvent(ctx, &HandleEventRequest{Event: event, MutatedEvent: mutated})
+	if err != nil {
+		return err
+	}
+	if resp.Error != "" {
+		return errors.New(resp.Error)
+	}
+
+	return nil
+}
+
+// MutateEvent sends the event to the extension MutateEvent RPC.
+func (e *grpcExtension) MutateEvent(ctx context.Context, event *types.Event) ([]byte, error) {
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := e.client.MutateEvent(ctx, &MutateEventRequest{Event: event})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != "" {
+		return nil, errors.New(resp.Error)
+	}
+
+	return resp.MutatedEvent, nil
+}
+
+// FilterEvent sends the event to the extension FilterEvent RPC.
+func (e *grpcExtension) FilterEvent(ctx context.Context, event *types.Event) (bool, error) {
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	resp, err := e.client.FilterEvent(ctx, &FilterEventRequest{Event: event})
+	if err != nil {
+		return false, err
+	}
+	if resp.Error != "" {
+		return false, errors.New(resp.Error)
+	}
+
+	return resp.Filtered, nil
+}
@@ -0,0 +1,172 @@
+package rpc
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+type testExtension struct {
+	err   string
+	delay time.Duration
+}
+
+func (e testExtension) HandleEvent(ctx context.Context, req *HandleEventRequest) (*HandleEventResponse, error) {
+	time.Sleep(e.delay)
+	if req.Event == nil || len(req.MutatedEvent) == 0 {
+		return nil, errors.New("missing event")
+	}
+	return &HandleEventResponse{Error: e.err}, nil
+}
+
+func (e testExtension) MutateEvent(ctx context.Context, req *MutateEventRequest) (*MutateEventResponse, error) {
+	return &MutateEventResponse{MutatedEvent: []byte(req.Event.Check.Name), Error: e.err}, nil
+}
+
+func (e testExtension) FilterEvent(ctx context.Context, req *FilterEventRequest) (*FilterEventResponse, error) {
+	return &FilterEventResponse{Filtered: req.Event.Check.Status == 0, Error: e.err}, nil
+}
+
+func newTestExtension(t *testing.T, impl testExtension) (*types.Extension, func()) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	RegisterExtensionServer(server, impl)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	ext := types.FixtureExtension("extension1")
+	ext.URL = "http://" + lis.Addr().String()
+	return ext, server.Stop
+}
+
+func TestExtensionPool(t *testing.T) {
+	ext, stop := newTestExtension(t, testExtension{})
+	defer stop()
+
+	pool := NewExtensionPool()
+	defer pool.Close()
+
+	executor, err := pool.Get(ext)
+	require.NoError(t, err)
+
+	event := types.FixtureEvent("entity1", "check1")
+	ctx := context.Background()
+
+	assert.NoError(t, executor.HandleEvent(ctx, event, []byte("event")))
+
+	mutated, err := executor.MutateEvent(ctx, event)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("check1"), mutated)
+
+	filtered, err := executor.FilterEvent(ctx, event)
+	require.NoError(t, err)
+	assert.True(t, filtered)
+
+	// The connection is shared between the executors of the extension
+	_, err = pool.Get(ext)
+	require.NoError(t, err)
+	assert.Len(t, pool.conns, 1)
+}
+
+func TestExtensionPoolErrors(t *testing.T) {
+	ext, stop := newTestExtension(t, testExtension{err: "extension failure"})
+	defer stop()
+
+	pool := NewExtensionPool()
+	defer pool.Close()
+
+	executor, err := pool.Get(ext)
+	require.NoError(t, err)
+
+	event := types.FixtureEvent("entity1", "check1")
+	ctx := context.Background()
+
+	assert.EqualError(t, executor.HandleEvent(ctx, event, []byte("event")), "extension failure")
+
+	_, err = executor.MutateEvent(ctx, event)
+	assert.EqualError(t, err, "extension failure")
+
+	_, err = executor.FilterEvent(ctx, event)
+	assert.EqualError(t, err, "extension failure")
+}
+
+func TestExtensionPoolTimeouts(t *testing.T) {
+	ext, stop := newTestExtension(t, testExtension{delay: time.Second})
+	defer stop()
+
+	pool := NewExtensionPool()
+	pool.CallTimeout = 10 * time.Millisecond
+	defer pool.Close()
+
+	executor, err := pool.Get(ext)
+	require.NoError(t, err)
+
+	event := types.FixtureEvent("entity1", "check1")
+	assert.Error(t, executor.HandleEvent(context.Background(), event, []byte("event")))
+
+	// Unreachable extensions fail to be dialed
+	pool.DialTimeout = 10 * time.Millisecond
+	unreachable := types.FixtureExtension("extension2")
+	unreachable.URL = "http://127.0.0.1:1"
+	_, err = pool.Get(unreachable)
+	assert.Error(t, err)
+}
+
+func TestExtensionPoolClose(t *testing.T) {
+	pool := NewExtensionPool()
+	require.NoError(t, pool.Close())
+
+	_, err := pool.Get(types.FixtureExtension("extension1"))
+	assert.Error(t, err)
+}
+
+func TestExtensionPoolConcurrentDials(t *testing.T) {
+	ext, stop := newTestExtension(t, testExtension{})
+	defer stop()
+
+	pool := NewExtensionPool()
+	pool.DialTimeout = time.Second
+	defer pool.Close()
+
+	// An unreachable extension doesn't block the others while it is dialed
+	unreachable := types.FixtureExtension("extension2")
+	unreachable.URL = "http://127.0.0.1:1"
+	errs := make(chan error, 1)
+	go func() {
+		_, err := pool.Get(unreachable)
+		errs <- err
+	}()
+
+	// The first connection to a URL is kept
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := pool.Get(ext)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	pool.mu.Lock()
+	assert.Len(t, pool.conns, 1)
+	pool.mu.Unlock()
+	select {
+	case <-errs:
+		t.Fatal("the extension was dialed after the unreachable one")
+	default:
+	}
+	assert.Error(t, <-errs)
+}
@@ -0,0 +1,72 @@
+package system
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/shirou/gopsutil/host"
+	"github.com/shirou/gopsutil/mem"
+)
+
+// A Collector adds optional information about the local system to a System.
+type Collector func(*types.System) error
+
+// Collectors are the optional system collectors, by name.
+var Collectors = map[string]Collector{
+	"cpu":    CollectCPU,
+	"kernel": CollectKernel,
+	"memory": CollectMemory,
+	"uptime": CollectUptime,
+}
+
+// Collect runs the named collectors against system.
+func Collect(system *types.System, names []string) error {
+	for _, name := range names {
+		collector, ok := Collectors[name]
+		if !ok {
+			return fmt.Errorf("unknown system collector %q", name)
+		}
+		if err := collector(system); err != nil {
+			return fmt.Errorf("system collector %q failed: %s", name, err)
+		}
+	}
+
+	return nil
+}
+
+// CollectCPU collects the number of logical CPUs.
+func CollectCPU(system *types.System) error {
+	system.CPUs = uint32(runtime.NumCPU())
+	return nil
+}
+
+// CollectKernel collects the version of the OS kernel.
+func CollectKernel(system *types.System) error {
+	info, err := host.Info()
+	if err != nil {
+		return err
+	}
+	system.KernelVersion = info.KernelVersion
+	return nil
+}
+
+// CollectMemory collects the total amount of memory.
+func CollectMemory(system *types.System) error {
+	vm, err := mem.VirtualMemory()
+	if err != nil {
+		return err
+	}
+	system.Memory = vm.Total
+	return nil
+}
+
+// CollectUptime collects the number of seconds since boot.
+func CollectUptime(system *types.System) error {
+	uptime, err := host.Uptime()
+	if err != nil {
+		return err
+	}
+	system.Uptime = uptime
+	return nil
+}
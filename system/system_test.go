@@ -5,6 +5,7 @@ package system
 import (
 	"testing"
 
+	"github.com/sensu/sensu-go/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -24,3 +25,14 @@ func TestInfo(t *testing.T) {
 	//assert.NotEmpty(t, nInterface.MAC) // can be empty
 	assert.NotEmpty(t, nInterface.Addresses)
 }
+
+func TestCollect(t *testing.T) {
+	var info types.System
+	assert.NoError(t, Collect(&info, []string{"cpu", "kernel", "memory", "uptime"}))
+	assert.NotZero(t, info.CPUs)
+	assert.NotZero(t, info.Memory)
+	assert.NotZero(t, info.Uptime)
+	assert.NotEmpty(t, info.KernelVersion)
+
+	assert.Error(t, Collect(&info, []string{"gpu"}))
+}
@@ -0,0 +1,36 @@
+package mockmonitor
+
+import (
+	"context"
+	"time"
+
+	"github.com/sensu/sensu-go/types"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockSupervisor ...
+type MockSupervisor struct {
+	mock.Mock
+}
+
+// Start ...
+func (m *MockSupervisor) Start() error {
+	return nil
+}
+
+// Stop ...
+func (m *MockSupervisor) Stop() error {
+	return nil
+}
+
+// Monitor ...
+func (m *MockSupervisor) Monitor(ctx context.Context, entity *types.Entity, event *types.Event, timeout time.Duration) error {
+	args := m.Called(ctx, entity, event, timeout)
+	return args.Error(0)
+}
+
+// Remove ...
+func (m *MockSupervisor) Remove(ctx context.Context, entity *types.Entity, event *types.Event) error {
+	args := m.Called(ctx, entity, event)
+	return args.Error(0)
+}
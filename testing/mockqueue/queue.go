@@ -24,6 +24,18 @@ func (m *MockQueue) Dequeue(ctx context.Context) (types.QueueItem, error) {
 	return args.Get(0).(types.QueueItem), args.Error(1)
 }
 
+// List ...
+func (m *MockQueue) List(ctx context.Context) ([]string, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// Remove ...
+func (m *MockQueue) Remove(ctx context.Context, value string) (bool, error) {
+	args := m.Called(ctx, value)
+	return args.Bool(0), args.Error(1)
+}
+
 // Getter ...
 type Getter struct {
 	mock.Mock
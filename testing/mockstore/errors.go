@@ -7,8 +7,8 @@ import (
 )
 
 // DeleteError ...
-func (s *MockStore) DeleteError(ctx context.Context, e, c, t string) error {
-	args := s.Called(ctx, e, c, t)
+func (s *MockStore) DeleteError(ctx context.Context, e, c, n string) error {
+	args := s.Called(ctx, e, c, n)
 	return args.Error(0)
 }
 
@@ -24,8 +24,8 @@ func (s *MockStore) DeleteErrorsByEntity(ctx context.Context, e string) error {
 }
 
 // GetError ...
-func (s *MockStore) GetError(ctx context.Context, e, c, t string) (*types.Error, error) {
-	args := s.Called(ctx, e, c, t)
+func (s *MockStore) GetError(ctx context.Context, e, c, n string) (*types.Error, error) {
+	args := s.Called(ctx, e, c, n)
 	return args.Get(0).(*types.Error), args.Error(1)
 }
 
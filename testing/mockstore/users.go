@@ -45,6 +45,12 @@ func (s *MockStore) GetAllUsers() ([]*types.User, error) {
 	return args.Get(0).([]*types.User), args.Error(1)
 }
 
+// RestoreUser ...
+func (s *MockStore) RestoreUser(user *types.User) error {
+	args := s.Called(user)
+	return args.Error(0)
+}
+
 // UpdateUser ...
 func (s *MockStore) UpdateUser(user *types.User) error {
 	args := s.Called(user)
@@ -4,11 +4,17 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/sensu/sensu-go/types"
 )
 
+// pingInterval is the interval at which clients ping the backend. A connection
+// that goes without any message or pong for 3 intervals is considered dead,
+// which notices the half-open connections.
+var pingInterval = 10 * time.Second
+
 // connect establish the connection to a given websocket backend and returns it
 // along with any error encountered
 func connect(wsServerURL string, tlsOpts *types.TLSOptions, requestHeader http.Header) (*websocket.Conn, error) {
@@ -41,6 +47,32 @@ func connect(wsServerURL string, tlsOpts *types.TLSOptions, requestHeader http.H
 	return conn, nil
 }
 
+// heartbeat pings the backend at every interval until the connection is closed,
+// and extends the read deadline of the connection whenever a pong is received.
+func heartbeat(conn *websocket.Conn, interval time.Duration) {
+	_ = conn.SetReadDeadline(time.Now().Add(readTimeout(interval)))
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(readTimeout(interval)))
+	})
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for range ticker.C {
+			// WriteControl is safe for concurrent use with the other writes
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(interval)); err != nil {
+				return
+			}
+		}
+	}()
+}
+
+// readTimeout returns the duration a client connection can go without reading
+// anything from the backend.
+func readTimeout(interval time.Duration) time.Duration {
+	return 3 * interval
+}
+
 // Connect causes the transport Client to connect to a given websocket backend.
 // This is a thin wrapper around a websocket connection that makes the
 // connection safe for concurrent use by multiple goroutines.
@@ -50,5 +82,8 @@ func Connect(wsServerURL string, tlsOpts *types.TLSOptions, requestHeader http.H
 		return nil, err
 	}
 
-	return NewTransport(conn), nil
+	t := newTransport(conn)
+	t.pingInterval = pingInterval
+	heartbeat(conn, t.pingInterval)
+	return t, nil
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/sensu/sensu-go/types"
@@ -23,6 +24,10 @@ const (
 	// MessageTypeEvent is the message type string for events.
 	MessageTypeEvent = "event"
 
+	// MessageTypeDeregistration is the message type sent by ephemeral agents
+	// shutting down, with their entity, so they are deregistered right away.
+	MessageTypeDeregistration = "deregistration"
+
 	// HeaderKeyAgentID is the HTTP request header specifying the Agent ID
 	HeaderKeyAgentID = "Sensu-AgentID"
 
@@ -114,30 +119,47 @@ type WebSocketTransport struct {
 	Connection *websocket.Conn
 	closed     bool
 	mutex      *sync.RWMutex
+
+	// pingInterval is the interval at which the connections established by the
+	// agents ping the backend, or zero for the connections of the backend
+	pingInterval time.Duration
+
+	// writeMu serializes the writes to the connection, which supports a single
+	// concurrent writer
+	writeMu *sync.Mutex
 }
 
 // NewTransport creates an initialized Transport and return its pointer.
 func NewTransport(conn *websocket.Conn) Transport {
+	return newTransport(conn)
+}
+
+func newTransport(conn *websocket.Conn) *WebSocketTransport {
 	return &WebSocketTransport{
 		Connection: conn,
 		closed:     false,
 		mutex:      &sync.RWMutex{},
+		writeMu:    &sync.Mutex{},
 	}
 }
 
-// Close attempts to send a "going away" message over the websocket connection.
-// This will cause a Write over the websocket transport, which can cause a
-// panic. We rescue potential panics and consider the connection closed,
-// returning nil, because the connection _will_ be closed. Hay!
+// Close attempts to send a "going away" message over the websocket connection,
+// then closes the underlying network connection. This will cause a Write over
+// the websocket transport, which can cause a panic. We rescue potential panics
+// and consider the connection closed, returning nil, because the connection
+// _will_ be closed. Hay!
 func (t *WebSocketTransport) Close() error {
+	t.writeMu.Lock()
+	defer t.writeMu.Unlock()
 	t.mutex.Lock()
 	defer func() {
-		// WriteMessage can annoyingly panic, because the websocket conn isn't safe
-		// for concurrent use. Recover here, and unlock the mutex.
+		// WriteMessage can annoyingly panic when the connection is already
+		// gone. Recover here, and unlock the mutex.
 		_ = recover()
 		t.mutex.Unlock()
 	}()
 	t.closed = true
+	defer func() { _ = t.Connection.Close() }()
 	return t.Connection.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, "bye"))
 }
 
@@ -157,9 +179,14 @@ func (t *WebSocketTransport) Receive() (*Message, error) {
 		t.mutex.RUnlock()
 		return nil, ClosedError{"the websocket connection is no longer open"}
 	}
+	conn := t.Connection
 	t.mutex.RUnlock()
 
-	_, p, err := t.Connection.ReadMessage()
+	_, p, err := conn.ReadMessage()
+	if err == nil && t.pingInterval > 0 {
+		// Any message received shows that the connection is still alive
+		err = conn.SetReadDeadline(time.Now().Add(readTimeout(t.pingInterval)))
+	}
 	if err != nil {
 		t.mutex.Lock()
 		t.closed = true
@@ -198,13 +225,19 @@ func (t *WebSocketTransport) Reconnect(wsServerURL string, tlsOpts *types.TLSOpt
 	if err != nil {
 		return err
 	}
+	if t.pingInterval > 0 {
+		heartbeat(conn, t.pingInterval)
+	}
 
 	// Replace the connection in the Transport cient with this new connection and
-	// mark it as ready to be used
+	// mark it as ready to be used. The previous connection is closed, since it
+	// may only have been marked as closed after an error.
 	t.mutex.Lock()
+	previous := t.Connection
 	t.Connection = conn
 	t.closed = false
 	t.mutex.Unlock()
+	_ = previous.Close()
 
 	return nil
 }
@@ -218,10 +251,13 @@ func (t *WebSocketTransport) Send(m *Message) error {
 		t.mutex.RUnlock()
 		return ClosedError{"the websocket connection is no longer open"}
 	}
+	conn := t.Connection
 	t.mutex.RUnlock()
 
 	msg := Encode(m.Type, m.Payload)
-	err := t.Connection.WriteMessage(websocket.BinaryMessage, msg)
+	t.writeMu.Lock()
+	err := conn.WriteMessage(websocket.BinaryMessage, msg)
+	t.writeMu.Unlock()
 	if err != nil {
 		// If we get _any_ error, let's just considered the connection closed,
 		// because it's _really_ hard to figure out what errors from the
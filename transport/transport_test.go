@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -71,6 +72,82 @@ func TestClosedWebsocket(t *testing.T) {
 	assert.IsType(t, ClosedError{}, err)
 }
 
+func TestReconnectClosesPreviousConnection(t *testing.T) {
+	server := NewServer()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := server.Serve(w, r)
+		assert.NoError(t, err)
+	}))
+	defer ts.Close()
+
+	url := strings.Replace(ts.URL, "http", "ws", 1)
+	clientTransport, err := Connect(url, nil, nil)
+	require.NoError(t, err)
+	transport := clientTransport.(*WebSocketTransport)
+	previous := transport.Connection.UnderlyingConn()
+
+	// The connection is only marked as closed, as after a receive error
+	transport.mutex.Lock()
+	transport.closed = true
+	transport.mutex.Unlock()
+
+	require.NoError(t, transport.Reconnect(url, nil, nil))
+	assert.False(t, transport.Closed())
+	_, err = previous.Write([]byte{0})
+	assert.Error(t, err)
+
+	// Closing the transport closes the network connection
+	current := transport.Connection.UnderlyingConn()
+	_ = transport.Close()
+	_, err = current.Write([]byte{0})
+	assert.Error(t, err)
+}
+
+func TestHeartbeat(t *testing.T) {
+	defer func(interval time.Duration) { pingInterval = interval }(pingInterval)
+	pingInterval = 20 * time.Millisecond
+
+	done := make(chan struct{})
+	defer close(done)
+	server := NewServer()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		transport, err := server.Serve(w, r)
+		require.NoError(t, err)
+		if r.URL.Path == "/unresponsive" {
+			// The pings are only answered while reading
+			<-done
+			return
+		}
+
+		go func() {
+			for {
+				if _, err := transport.Receive(); err != nil {
+					return
+				}
+			}
+		}()
+		time.Sleep(5 * pingInterval)
+		assert.NoError(t, transport.Send(&Message{"testMessageType", []byte{}}))
+		<-done
+	}))
+	defer ts.Close()
+	url := strings.Replace(ts.URL, "http", "ws", 1)
+
+	// The pongs keep the connection alive past its read timeout
+	clientTransport, err := Connect(url, nil, nil)
+	require.NoError(t, err)
+	msg, err := clientTransport.Receive()
+	require.NoError(t, err)
+	assert.Equal(t, "testMessageType", msg.Type)
+
+	// A backend that no longer answers is noticed
+	clientTransport, err = Connect(url+"/unresponsive", nil, nil)
+	require.NoError(t, err)
+	_, err = clientTransport.Receive()
+	assert.IsType(t, ConnectionError{}, err)
+	assert.True(t, clientTransport.Closed())
+}
+
 // This was all mostly to prove that performance of encoding/decoding was
 // not super-linear.
 
@@ -10,6 +10,7 @@
 		asset.proto
 		authentication.proto
 		check.proto
+		dead_letter.proto
 		entity.proto
 		environment.proto
 		error.proto
@@ -22,7 +23,9 @@
 		metrics.proto
 		mutator.proto
 		organization.proto
+		prometheus.proto
 		rbac.proto
+		sandbox.proto
 		silenced.proto
 		time_window.proto
 		tls.proto
@@ -38,6 +41,7 @@
 		CheckConfig
 		Check
 		CheckHistory
+		DeadLetter
 		Entity
 		System
 		Network
@@ -50,6 +54,9 @@
 		EventFilter
 		Handler
 		HandlerSocket
+		HandlerHTTP
+		HandlerConcurrency
+		HandlerRetryPolicy
 		HookConfig
 		Hook
 		HookList
@@ -59,8 +66,11 @@
 		MetricTag
 		Mutator
 		Organization
+		PrometheusScrape
+		PrometheusRelabel
 		Rule
 		Role
+		Sandbox
 		Silenced
 		TimeWindowWhen
 		TimeWindowDays
@@ -637,7 +647,7 @@ var (
 func init() { proto.RegisterFile("adhoc.proto", fileDescriptorAdhoc) }
 
 var fileDescriptorAdhoc = []byte{
-	// 232 bytes of a gzipped FileDescriptorProto
+	// 229 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4e, 0x4c, 0xc9, 0xc8,
 	0x4f, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2e, 0x4e, 0xcd, 0x2b, 0x2e, 0xd5, 0x2b,
 	0xa9, 0x2c, 0x48, 0x2d, 0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
@@ -647,10 +657,10 @@ var fileDescriptorAdhoc = []byte{
 	0x27, 0xce, 0x57, 0xf7, 0xe4, 0x59, 0x93, 0x33, 0x52, 0x93, 0xb3, 0x83, 0xc0, 0xc2, 0x42, 0x5a,
 	0x5c, 0xbc, 0xc5, 0xa5, 0x49, 0xc5, 0xc9, 0x45, 0x99, 0x05, 0x25, 0x99, 0xf9, 0x79, 0xc5, 0x12,
 	0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x06, 0xa1, 0x4a, 0x09, 0xc9,
-	0x71, 0xb1, 0x27, 0x17, 0xa5, 0x26, 0x96, 0xe4, 0x17, 0x49, 0x30, 0x83, 0x4d, 0x83, 0xa8, 0x82,
-	0x09, 0x0a, 0xc9, 0x70, 0xb1, 0x15, 0xa5, 0x26, 0x16, 0xe7, 0xe7, 0x49, 0xb0, 0x20, 0x49, 0x43,
-	0xc5, 0x9c, 0x94, 0x7f, 0x3c, 0x94, 0x63, 0x5c, 0xf1, 0x48, 0x8e, 0x71, 0xc7, 0x23, 0x39, 0xc6,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc6, 0x63, 0x39,
-	0x86, 0x28, 0x56, 0xb0, 0x6f, 0x93, 0xd8, 0xc0, 0xbe, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff,
-	0xa9, 0x02, 0x7b, 0x08, 0x10, 0x01, 0x00, 0x00,
+	0x71, 0xb1, 0x27, 0x17, 0xa5, 0x26, 0x96, 0xe4, 0x17, 0x49, 0x30, 0x2b, 0x30, 0xc2, 0x55, 0xc1,
+	0x04, 0x85, 0x64, 0xb8, 0xd8, 0x8a, 0x52, 0x13, 0x8b, 0xf3, 0xf3, 0x24, 0x58, 0x90, 0xa4, 0xa1,
+	0x62, 0x4e, 0xca, 0x3f, 0x1e, 0xca, 0x31, 0xae, 0x78, 0x24, 0xc7, 0xb8, 0xe3, 0x91, 0x1c, 0xe3,
+	0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe3, 0xb1, 0x1c,
+	0x43, 0x14, 0x2b, 0xd8, 0xb7, 0x49, 0x6c, 0x60, 0x5f, 0x18, 0x03, 0x06, 0x00, 0xa9, 0x02, 0x7b,
+	0x08, 0x10, 0x01, 0x00, 0x00,
 }
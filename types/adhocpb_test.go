@@ -10,6 +10,7 @@ It is generated from these files:
 	asset.proto
 	authentication.proto
 	check.proto
+	dead_letter.proto
 	entity.proto
 	environment.proto
 	error.proto
@@ -22,7 +23,9 @@ It is generated from these files:
 	metrics.proto
 	mutator.proto
 	organization.proto
+	prometheus.proto
 	rbac.proto
+	sandbox.proto
 	silenced.proto
 	time_window.proto
 	tls.proto
@@ -38,6 +41,7 @@ It has these top-level messages:
 	CheckConfig
 	Check
 	CheckHistory
+	DeadLetter
 	Entity
 	System
 	Network
@@ -50,6 +54,9 @@ It has these top-level messages:
 	EventFilter
 	Handler
 	HandlerSocket
+	HandlerHTTP
+	HandlerConcurrency
+	HandlerRetryPolicy
 	HookConfig
 	Hook
 	HookList
@@ -59,8 +66,11 @@ It has these top-level messages:
 	MetricTag
 	Mutator
 	Organization
+	PrometheusScrape
+	PrometheusRelabel
 	Rule
 	Role
+	Sandbox
 	Silenced
 	TimeWindowWhen
 	TimeWindowDays
@@ -9,6 +9,7 @@ import (
 	"path"
 	"regexp"
 
+	"github.com/sensu/sensu-go/types/dynamic"
 	"github.com/sensu/sensu-go/util/eval"
 )
 
@@ -36,17 +37,62 @@ func (a *Asset) Validate() error {
 		return errors.New("URL cannot be empty")
 	}
 
-	u, err := url.Parse(a.URL)
+	for _, assetURL := range a.URLs() {
+		if err := validateAssetURL(assetURL); err != nil {
+			return err
+		}
+	}
+
+	// Validate the statements and forbid govaluate's modifier tokens
+	return eval.ValidateStatements(a.Filters, true)
+}
+
+// URLs returns the URL of the asset followed by its mirrors, in the order in
+// which they are tried.
+func (a *Asset) URLs() []string {
+	return append([]string{a.URL}, a.Mirrors...)
+}
+
+// RedactHeaders returns a copy of the asset whose header values, which may hold
+// credentials, are redacted.
+func (a *Asset) RedactHeaders() *Asset {
+	redacted := *a
+	if len(a.Headers) > 0 {
+		redacted.Headers = make(map[string]string, len(a.Headers))
+		for name := range a.Headers {
+			redacted.Headers[name] = dynamic.Redacted
+		}
+	}
+	return &redacted
+}
+
+// RestoreHeaders sets the redacted header values of the asset back to the ones
+// of the stored asset, so a redacted asset can be updated.
+func (a *Asset) RestoreHeaders(stored *Asset) {
+	for name, value := range a.Headers {
+		if storedValue, ok := stored.Headers[name]; ok && value == dynamic.Redacted {
+			a.Headers[name] = storedValue
+		}
+	}
+}
+
+func validateAssetURL(assetURL string) error {
+	u, err := url.Parse(assetURL)
 	if err != nil {
-		return errors.New("invalid URL provided")
+		return fmt.Errorf("invalid URL provided: %s", assetURL)
 	}
 
-	if u.Scheme != "https" && u.Scheme != "http" {
-		return errors.New("URL must be HTTP or HTTPS")
+	switch u.Scheme {
+	case "https", "http":
+	case "file":
+		if u.Path == "" {
+			return fmt.Errorf("file URL must have an absolute path: %s", assetURL)
+		}
+	default:
+		return fmt.Errorf("URL must be HTTP, HTTPS or file: %s", assetURL)
 	}
 
-	// Validate the statements and forbid govaluate's modifier tokens
-	return eval.ValidateStatements(a.Filters, true)
+	return nil
 }
 
 // GetEnvironment refers to the organization the check belongs to
@@ -31,6 +31,14 @@ type Asset struct {
 	Filters []string `protobuf:"bytes,5,rep,name=filters" json:"filters"`
 	// Organization indicates to which org an asset belongs to
 	Organization string `protobuf:"bytes,6,opt,name=organization,proto3" json:"organization,omitempty"`
+	// Mirrors are the locations of the asset tried, in order, when it can't be
+	// fetched from its URL
+	Mirrors []string `protobuf:"bytes,7,rep,name=mirrors" json:"mirrors,omitempty"`
+	// Headers are the HTTP headers sent with the requests fetching the asset
+	Headers map[string]string `protobuf:"bytes,8,rep,name=headers" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Signature is the ASCII armored OpenPGP detached signature of the asset,
+	// verified against the trusted keys of the agents
+	Signature string `protobuf:"bytes,9,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
 func (m *Asset) Reset()                    { *m = Asset{} }
@@ -80,6 +88,27 @@ func (m *Asset) GetOrganization() string {
 	return ""
 }
 
+func (m *Asset) GetMirrors() []string {
+	if m != nil {
+		return m.Mirrors
+	}
+	return nil
+}
+
+func (m *Asset) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *Asset) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Asset)(nil), "sensu.types.Asset")
 }
@@ -136,6 +165,25 @@ func (this *Asset) Equal(that interface{}) bool {
 	if this.Organization != that1.Organization {
 		return false
 	}
+	if len(this.Mirrors) != len(that1.Mirrors) {
+		return false
+	}
+	for i := range this.Mirrors {
+		if this.Mirrors[i] != that1.Mirrors[i] {
+			return false
+		}
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
+	if this.Signature != that1.Signature {
+		return false
+	}
 	return true
 }
 func (m *Asset) Marshal() (dAtA []byte, err error) {
@@ -209,6 +257,44 @@ func (m *Asset) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintAsset(dAtA, i, uint64(len(m.Organization)))
 		i += copy(dAtA[i:], m.Organization)
 	}
+	if len(m.Mirrors) > 0 {
+		for _, s := range m.Mirrors {
+			dAtA[i] = 0x3a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Headers) > 0 {
+		for k, _ := range m.Headers {
+			dAtA[i] = 0x42
+			i++
+			v := m.Headers[k]
+			mapSize := 1 + len(k) + sovAsset(uint64(len(k))) + 1 + len(v) + sovAsset(uint64(len(v)))
+			i = encodeVarintAsset(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintAsset(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintAsset(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Signature) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintAsset(dAtA, i, uint64(len(m.Signature)))
+		i += copy(dAtA[i:], m.Signature)
+	}
 	return i, nil
 }
 
@@ -239,6 +325,19 @@ func NewPopulatedAsset(r randyAsset, easy bool) *Asset {
 		this.Filters[i] = string(randStringAsset(r))
 	}
 	this.Organization = string(randStringAsset(r))
+	v3 := r.Intn(10)
+	this.Mirrors = make([]string, v3)
+	for i := 0; i < v3; i++ {
+		this.Mirrors[i] = string(randStringAsset(r))
+	}
+	if r.Intn(10) != 0 {
+		v4 := r.Intn(10)
+		this.Headers = make(map[string]string)
+		for i := 0; i < v4; i++ {
+			this.Headers[randStringAsset(r)] = randStringAsset(r)
+		}
+	}
+	this.Signature = string(randStringAsset(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -263,9 +362,9 @@ func randUTF8RuneAsset(r randyAsset) rune {
 	return rune(ru + 61)
 }
 func randStringAsset(r randyAsset) string {
-	v3 := r.Intn(100)
-	tmps := make([]rune, v3)
-	for i := 0; i < v3; i++ {
+	v5 := r.Intn(100)
+	tmps := make([]rune, v5)
+	for i := 0; i < v5; i++ {
 		tmps[i] = randUTF8RuneAsset(r)
 	}
 	return string(tmps)
@@ -287,11 +386,11 @@ func randFieldAsset(dAtA []byte, r randyAsset, fieldNumber int, wire int) []byte
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateAsset(dAtA, uint64(key))
-		v4 := r.Int63()
+		v6 := r.Int63()
 		if r.Intn(2) == 0 {
-			v4 *= -1
+			v6 *= -1
 		}
-		dAtA = encodeVarintPopulateAsset(dAtA, uint64(v4))
+		dAtA = encodeVarintPopulateAsset(dAtA, uint64(v6))
 	case 1:
 		dAtA = encodeVarintPopulateAsset(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -349,6 +448,24 @@ func (m *Asset) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAsset(uint64(l))
 	}
+	if len(m.Mirrors) > 0 {
+		for _, s := range m.Mirrors {
+			l = len(s)
+			n += 1 + l + sovAsset(uint64(l))
+		}
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovAsset(uint64(len(k))) + 1 + len(v) + sovAsset(uint64(len(v)))
+			n += mapEntrySize + 1 + sovAsset(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovAsset(uint64(l))
+	}
 	return n
 }
 
@@ -657,6 +774,182 @@ func (m *Asset) Unmarshal(dAtA []byte) error {
 			}
 			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mirrors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAsset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mirrors = append(m.Mirrors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAsset
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAsset
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAsset
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthAsset
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAsset
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthAsset
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipAsset(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthAsset
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAsset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAsset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAsset(dAtA[iNdEx:])
@@ -786,25 +1079,31 @@ var (
 func init() { proto.RegisterFile("asset.proto", fileDescriptorAsset) }
 
 var fileDescriptorAsset = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4e, 0x2c, 0x2e, 0x4e,
-	0x2d, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x2e, 0x4e, 0xcd, 0x2b, 0x2e, 0xd5, 0x2b,
-	0xa9, 0x2c, 0x48, 0x2d, 0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0x4f, 0xcf, 0x4f, 0xcf, 0xd7, 0x07, 0xab, 0x49, 0x2a, 0x4d, 0x03, 0xf3, 0xc0, 0x1c, 0x30,
-	0x0b, 0xa2, 0x57, 0x69, 0x16, 0x13, 0x17, 0xab, 0x23, 0xc8, 0x2c, 0x21, 0x21, 0x2e, 0x96, 0xbc,
-	0xc4, 0xdc, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x30, 0x5b, 0x48, 0x92, 0x8b, 0xb9,
-	0xb4, 0x28, 0x47, 0x82, 0x09, 0x24, 0xe4, 0xc4, 0xfe, 0xe8, 0x9e, 0x3c, 0x73, 0x68, 0x90, 0x4f,
-	0x10, 0x48, 0x4c, 0x48, 0x8c, 0x8b, 0xad, 0x38, 0x23, 0xd1, 0xd4, 0xd0, 0x48, 0x82, 0x19, 0xac,
-	0x01, 0xca, 0x13, 0x72, 0xe2, 0xe2, 0xc8, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0x94, 0x60,
-	0x51, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xd0, 0x43, 0x72, 0x9f, 0x1e, 0xd8, 0x32, 0x3d, 0x5f, 0xa8,
-	0x12, 0xd7, 0xbc, 0x92, 0xa2, 0x4a, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xe0, 0xfa, 0x84,
-	0x54, 0xb9, 0xd8, 0xd3, 0x32, 0x73, 0x4a, 0x52, 0x8b, 0x8a, 0x25, 0x58, 0x15, 0x98, 0x35, 0x38,
-	0x9d, 0xb8, 0x5f, 0xdd, 0x93, 0x87, 0x09, 0x05, 0xc1, 0x18, 0x42, 0x4a, 0x5c, 0x3c, 0xf9, 0x45,
-	0xe9, 0x89, 0x79, 0x99, 0x55, 0x89, 0x25, 0x99, 0xf9, 0x79, 0x12, 0x6c, 0x60, 0x87, 0xa0, 0x88,
-	0x49, 0x59, 0x73, 0xf1, 0xa2, 0xd8, 0x25, 0x24, 0xc0, 0xc5, 0x9c, 0x9d, 0x5a, 0x09, 0xf5, 0x25,
-	0x88, 0x29, 0x24, 0xc2, 0xc5, 0x5a, 0x96, 0x98, 0x53, 0x9a, 0x0a, 0xf1, 0x66, 0x10, 0x84, 0x63,
-	0xc5, 0x64, 0xc1, 0xe8, 0xa4, 0xfc, 0xe3, 0xa1, 0x1c, 0xe3, 0x8a, 0x47, 0x72, 0x8c, 0x3b, 0x1e,
-	0xc9, 0x31, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x33,
-	0x1e, 0xcb, 0x31, 0x44, 0xb1, 0x82, 0x3d, 0x94, 0xc4, 0x06, 0x0e, 0x48, 0x63, 0x40, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x54, 0x0d, 0xc6, 0xf2, 0x93, 0x01, 0x00, 0x00,
+	// 409 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0xaa, 0xd3, 0x40,
+	0x18, 0xed, 0x34, 0x49, 0xd3, 0x4c, 0x2a, 0xe8, 0xf8, 0x37, 0xed, 0x22, 0x09, 0x15, 0xa1, 0x0b,
+	0x4d, 0xb1, 0x52, 0x90, 0xba, 0x32, 0x20, 0xb8, 0xd0, 0x4d, 0xd0, 0x8d, 0xbb, 0xa9, 0x9d, 0xa6,
+	0xc1, 0x26, 0x53, 0x66, 0x26, 0x42, 0x7c, 0x0e, 0x17, 0x3e, 0x82, 0x8f, 0xe0, 0x23, 0x74, 0xe9,
+	0x13, 0x04, 0x6f, 0xee, 0xae, 0x4f, 0x70, 0x97, 0x97, 0x4c, 0xd2, 0xde, 0x14, 0xee, 0xe6, 0xee,
+	0xbe, 0x73, 0x38, 0xe7, 0xcc, 0xe1, 0xfb, 0x06, 0xda, 0x44, 0x08, 0x2a, 0xfd, 0x1d, 0x67, 0x92,
+	0x21, 0x5b, 0xd0, 0x54, 0x64, 0xbe, 0xcc, 0x77, 0x54, 0x8c, 0x5e, 0x46, 0xb1, 0xdc, 0x64, 0x4b,
+	0xff, 0x1b, 0x4b, 0xa6, 0x11, 0x8b, 0xd8, 0x54, 0x69, 0x96, 0xd9, 0x5a, 0x21, 0x05, 0xd4, 0x54,
+	0x7b, 0xc7, 0xbf, 0x74, 0x68, 0xbc, 0xab, 0xb2, 0x10, 0x82, 0x7a, 0x4a, 0x12, 0x8a, 0x81, 0x07,
+	0x26, 0x56, 0xa8, 0x66, 0x34, 0x84, 0x5a, 0xc6, 0xb7, 0xb8, 0x5b, 0x51, 0x81, 0x59, 0x16, 0xae,
+	0xf6, 0x25, 0xfc, 0x18, 0x56, 0x1c, 0x7a, 0x02, 0x7b, 0x62, 0x43, 0xe6, 0xaf, 0x66, 0x58, 0x53,
+	0x86, 0x06, 0xa1, 0x00, 0xf6, 0x13, 0x2a, 0xc9, 0x8a, 0x48, 0x82, 0x75, 0x4f, 0x9b, 0xd8, 0x33,
+	0xcf, 0x6f, 0xf5, 0xf3, 0xd5, 0x63, 0xfe, 0xa7, 0x46, 0xf2, 0x3e, 0x95, 0x3c, 0x0f, 0xf4, 0x7d,
+	0xe1, 0x76, 0xc2, 0x93, 0x0f, 0x3d, 0x87, 0xe6, 0x3a, 0xde, 0x4a, 0xca, 0x05, 0x36, 0x3c, 0x6d,
+	0x62, 0x05, 0xf6, 0xa1, 0x70, 0x8f, 0x54, 0x78, 0x1c, 0xd0, 0x18, 0x0e, 0x18, 0x8f, 0x48, 0x1a,
+	0xff, 0x24, 0x32, 0x66, 0x29, 0xee, 0xa9, 0x22, 0x67, 0x1c, 0x9a, 0x42, 0x33, 0x89, 0x39, 0x67,
+	0x5c, 0x60, 0x53, 0x45, 0x3d, 0x3e, 0x14, 0xee, 0x83, 0x86, 0x7a, 0xc1, 0x92, 0x58, 0xd2, 0x64,
+	0x27, 0xf3, 0xf0, 0xa8, 0x42, 0x9f, 0xa1, 0xb9, 0xa1, 0x64, 0x55, 0xbd, 0xdd, 0x57, 0xf5, 0xdd,
+	0x5b, 0xea, 0x7f, 0xa8, 0x15, 0x75, 0xfb, 0x61, 0xd5, 0xbe, 0x4a, 0x6d, 0x7c, 0xed, 0xd4, 0x86,
+	0x42, 0x73, 0x68, 0x89, 0x38, 0x4a, 0x89, 0xcc, 0x38, 0xc5, 0x96, 0x5a, 0xe7, 0xd3, 0x43, 0xe1,
+	0x3e, 0x3c, 0x91, 0x2d, 0xd3, 0x8d, 0x72, 0xf4, 0x16, 0xde, 0x3b, 0xdb, 0x14, 0xba, 0x0f, 0xb5,
+	0xef, 0x34, 0x6f, 0x6e, 0x54, 0x8d, 0xe8, 0x11, 0x34, 0x7e, 0x90, 0x6d, 0x46, 0xeb, 0x23, 0x85,
+	0x35, 0x58, 0x74, 0xdf, 0x80, 0xd1, 0x02, 0x0e, 0xda, 0x3d, 0xef, 0xe2, 0x0d, 0x9e, 0x5d, 0x5d,
+	0x38, 0xe0, 0x4f, 0xe9, 0x80, 0xbf, 0xa5, 0x03, 0xf6, 0xa5, 0x03, 0xfe, 0x95, 0x0e, 0xf8, 0x5f,
+	0x3a, 0xe0, 0xf7, 0xa5, 0xd3, 0xf9, 0x6a, 0xa8, 0x5d, 0x2c, 0x7b, 0xea, 0x0b, 0xbd, 0xbe, 0x1e,
+	0x00, 0x29, 0x25, 0xb0, 0xe2, 0x8d, 0x02, 0x00, 0x00,
 }
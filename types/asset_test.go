@@ -41,7 +41,17 @@ func TestValidator(t *testing.T) {
 
 	// Given asset with a non-HTTP URL it should not pass
 	asset = FixtureAsset("name")
+	asset.URL = "ftp://localhost/my_script.sh"
+	assert.Error(asset.Validate())
+
+	// Given asset with a file URL it should pass
+	asset = FixtureAsset("name")
 	asset.URL = "file:///root/my_script.sh"
+	assert.NoError(asset.Validate())
+
+	// Given asset with an invalid mirror it should not pass
+	asset = FixtureAsset("name")
+	asset.Mirrors = []string{"https://mirror.localhost/asset.tar", "asdfasdf"}
 	assert.Error(asset.Validate())
 
 	// Given asset with a filter that has a modifier token
@@ -59,3 +69,31 @@ func TestValidator(t *testing.T) {
 	asset.Filters = []string{`entity.OS in ("macos", "linux")`}
 	assert.NoError(asset.Validate())
 }
+
+func TestAssetURLs(t *testing.T) {
+	asset := FixtureAsset("name")
+	asset.Mirrors = []string{"https://mirror.localhost/asset.tar", "file:///opt/assets/asset.tar"}
+
+	assert.Equal(t, []string{
+		asset.URL,
+		"https://mirror.localhost/asset.tar",
+		"file:///opt/assets/asset.tar",
+	}, asset.URLs())
+}
+
+func TestAssetRedactHeaders(t *testing.T) {
+	asset := FixtureAsset("name")
+	asset.Headers = map[string]string{"Authorization": "Bearer secret"}
+
+	redacted := asset.RedactHeaders()
+	assert.Equal(t, map[string]string{"Authorization": "REDACTED"}, redacted.Headers)
+	assert.Equal(t, "Bearer secret", asset.Headers["Authorization"])
+
+	// The redacted values are restored, the new ones kept
+	redacted.Headers["X-Mirror"] = "mirror"
+	redacted.RestoreHeaders(asset)
+	assert.Equal(t, map[string]string{
+		"Authorization": "Bearer secret",
+		"X-Mirror":      "mirror",
+	}, redacted.Headers)
+}
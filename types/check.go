@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/robfig/cron"
@@ -18,6 +19,28 @@ const CheckRequestType = "check_request"
 // DefaultSplayCoverage is the default splay coverage for proxy check requests
 const DefaultSplayCoverage = 90.0
 
+const (
+	// GraphiteOutputMetricFormat is the accepted string for graphite metrics
+	GraphiteOutputMetricFormat = "graphite_plaintext"
+
+	// InfluxDBOutputMetricFormat is the accepted string for influxDB metrics
+	InfluxDBOutputMetricFormat = "influxdb_line"
+
+	// NagiosOutputMetricFormat is the accepted string for nagios metrics
+	NagiosOutputMetricFormat = "nagios_perfdata"
+
+	// OpenTSDBOutputMetricFormat is the accepted string for OpenTSDB metrics
+	OpenTSDBOutputMetricFormat = "opentsdb_line"
+)
+
+// OutputMetricFormats represents all the accepted output_metric_format's a check can have
+var OutputMetricFormats = []string{
+	GraphiteOutputMetricFormat,
+	InfluxDBOutputMetricFormat,
+	NagiosOutputMetricFormat,
+	OpenTSDBOutputMetricFormat,
+}
+
 // NewCheck creates a new Check. It copies the fields from CheckConfig that
 // match with Check's fields.
 //
@@ -26,27 +49,31 @@ const DefaultSplayCoverage = 90.0
 // and encoding/json.
 func NewCheck(c *CheckConfig) *Check {
 	check := &Check{
-		Command:            c.Command,
-		Environment:        c.Environment,
-		Handlers:           c.Handlers,
-		HighFlapThreshold:  c.HighFlapThreshold,
-		Interval:           c.Interval,
-		LowFlapThreshold:   c.LowFlapThreshold,
-		Name:               c.Name,
-		Organization:       c.Organization,
-		Publish:            c.Publish,
-		RuntimeAssets:      c.RuntimeAssets,
-		Subscriptions:      c.Subscriptions,
-		ExtendedAttributes: c.ExtendedAttributes,
-		ProxyEntityID:      c.ProxyEntityID,
-		CheckHooks:         c.CheckHooks,
-		Stdin:              c.Stdin,
-		Subdue:             c.Subdue,
-		Cron:               c.Cron,
-		Ttl:                c.Ttl,
-		Timeout:            c.Timeout,
-		ProxyRequests:      c.ProxyRequests,
-		RoundRobin:         c.RoundRobin,
+		Command:              c.Command,
+		Environment:          c.Environment,
+		Handlers:             c.Handlers,
+		HighFlapThreshold:    c.HighFlapThreshold,
+		Interval:             c.Interval,
+		LowFlapThreshold:     c.LowFlapThreshold,
+		Name:                 c.Name,
+		Organization:         c.Organization,
+		Publish:              c.Publish,
+		RuntimeAssets:        c.RuntimeAssets,
+		Subscriptions:        c.Subscriptions,
+		ExtendedAttributes:   c.ExtendedAttributes,
+		ProxyEntityID:        c.ProxyEntityID,
+		CheckHooks:           c.CheckHooks,
+		Stdin:                c.Stdin,
+		Subdue:               c.Subdue,
+		Cron:                 c.Cron,
+		Ttl:                  c.Ttl,
+		Timeout:              c.Timeout,
+		ProxyRequests:        c.ProxyRequests,
+		RoundRobin:           c.RoundRobin,
+		OutputMetricFormat:   c.OutputMetricFormat,
+		OutputMetricHandlers: c.OutputMetricHandlers,
+		Sandbox:              c.Sandbox,
+		Prometheus:           c.Prometheus,
 	}
 	return check
 }
@@ -94,6 +121,12 @@ func (c *Check) Validate() error {
 		}
 	}
 
+	if c.OutputMetricFormat != "" {
+		if err := ValidateOutputMetricFormat(c.OutputMetricFormat); err != nil {
+			return err
+		}
+	}
+
 	return c.Subdue.Validate()
 }
 
@@ -137,6 +170,11 @@ func (c *CheckConfig) Get(name string) (interface{}, error) {
 	return dynamic.GetField(c, name)
 }
 
+// URIPath returns the path component of a CheckConfig URI.
+func (c *CheckConfig) URIPath() string {
+	return fmt.Sprintf("/checks/%s", url.PathEscape(c.Name))
+}
+
 // Validate returns an error if the check does not pass validation tests.
 func (c *CheckConfig) Validate() error {
 	if err := ValidateName(c.Name); err != nil {
@@ -189,9 +227,38 @@ func (c *CheckConfig) Validate() error {
 		}
 	}
 
+	if c.OutputMetricFormat != "" {
+		if err := ValidateOutputMetricFormat(c.OutputMetricFormat); err != nil {
+			return err
+		}
+	}
+
+	if c.Prometheus != nil {
+		if c.Command != "" {
+			return errors.New("must only specify either a command or a prometheus scrape")
+		}
+		if c.OutputMetricFormat != "" {
+			return errors.New("prometheus checks can't have an output metric format")
+		}
+		if err := c.Prometheus.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return c.Subdue.Validate()
 }
 
+// ValidateOutputMetricFormat returns an error if the string is not a valid metric
+// format
+func ValidateOutputMetricFormat(format string) error {
+	for _, f := range OutputMetricFormats {
+		if format == f {
+			return nil
+		}
+	}
+	return fmt.Errorf("output metric format %q is not valid, must be one of: %s", format, strings.Join(OutputMetricFormats, ", "))
+}
+
 // Validate returns an error if the ProxyRequests does not pass validation tests
 func (p *ProxyRequests) Validate() error {
 	if p.SplayCoverage > 100 {
@@ -140,6 +140,17 @@ type CheckConfig struct {
 	ProxyRequests *ProxyRequests `protobuf:"bytes,20,opt,name=proxy_requests,json=proxyRequests" json:"proxy_requests,omitempty"`
 	// RoundRobin enables round-robin scheduling if set true.
 	RoundRobin bool `protobuf:"varint,21,opt,name=round_robin,json=roundRobin,proto3" json:"round_robin,omitempty"`
+	// OutputMetricFormat is the metric protocol that the check's output will be
+	// expected to follow in order to be extracted.
+	OutputMetricFormat string `protobuf:"bytes,22,opt,name=output_metric_format,json=outputMetricFormat,proto3" json:"output_metric_format,omitempty"`
+	// OutputMetricHandlers is the list of event handlers that will respond to metrics
+	// that have been extracted from the check.
+	OutputMetricHandlers []string `protobuf:"bytes,23,rep,name=output_metric_handlers,json=outputMetricHandlers" json:"output_metric_handlers"`
+	// Sandbox restricts the execution of the check command.
+	Sandbox *Sandbox `protobuf:"bytes,24,opt,name=sandbox" json:"sandbox,omitempty"`
+	// Prometheus scrapes a Prometheus exposition endpoint instead of executing
+	// the command, and emits the scraped metric points.
+	Prometheus *PrometheusScrape `protobuf:"bytes,25,opt,name=prometheus" json:"prometheus,omitempty"`
 }
 
 func (m *CheckConfig) Reset()                    { *m = CheckConfig{} }
@@ -294,6 +305,34 @@ func (m *CheckConfig) GetRoundRobin() bool {
 	return false
 }
 
+func (m *CheckConfig) GetOutputMetricFormat() string {
+	if m != nil {
+		return m.OutputMetricFormat
+	}
+	return ""
+}
+
+func (m *CheckConfig) GetOutputMetricHandlers() []string {
+	if m != nil {
+		return m.OutputMetricHandlers
+	}
+	return nil
+}
+
+func (m *CheckConfig) GetSandbox() *Sandbox {
+	if m != nil {
+		return m.Sandbox
+	}
+	return nil
+}
+
+func (m *CheckConfig) GetPrometheus() *PrometheusScrape {
+	if m != nil {
+		return m.Prometheus
+	}
+	return nil
+}
+
 // A Check is a check specification and optionally the results of the check's
 // execution.
 type Check struct {
@@ -366,6 +405,26 @@ type Check struct {
 	Silenced []string `protobuf:"bytes,33,rep,name=silenced" json:"silenced,omitempty"`
 	// Hooks describes the results of multiple hooks; if event is associated to hook execution.
 	Hooks []*Hook `protobuf:"bytes,34,rep,name=hooks" json:"hooks,omitempty"`
+	// OutputMetricFormat is the metric protocol that the check's output will be
+	// expected to follow in order to be extracted.
+	OutputMetricFormat string `protobuf:"bytes,35,opt,name=output_metric_format,json=outputMetricFormat,proto3" json:"output_metric_format,omitempty"`
+	// OutputMetricHandlers is the list of event handlers that will respond to metrics
+	// that have been extracted from the check.
+	OutputMetricHandlers []string `protobuf:"bytes,36,rep,name=output_metric_handlers,json=outputMetricHandlers" json:"output_metric_handlers"`
+	// Sandbox restricts the execution of the check command.
+	Sandbox *Sandbox `protobuf:"bytes,37,opt,name=sandbox" json:"sandbox,omitempty"`
+	// OutputTruncated indicates that the output was truncated to the maximum
+	// output size of the sandbox.
+	OutputTruncated bool `protobuf:"varint,38,opt,name=output_truncated,json=outputTruncated,proto3" json:"output_truncated,omitempty"`
+	// Prometheus scrapes a Prometheus exposition endpoint instead of executing
+	// the command, and emits the scraped metric points.
+	Prometheus *PrometheusScrape `protobuf:"bytes,39,opt,name=prometheus" json:"prometheus,omitempty"`
+	// Stdout is the STDOUT of the check command, truncated to the maximum output
+	// size of the sandbox.
+	Stdout string `protobuf:"bytes,40,opt,name=stdout,proto3" json:"stdout,omitempty"`
+	// Stderr is the STDERR of the check command, truncated to the maximum output
+	// size of the sandbox.
+	Stderr string `protobuf:"bytes,41,opt,name=stderr,proto3" json:"stderr,omitempty"`
 	// ExtendedAttributes store serialized arbitrary JSON-encoded data
 	ExtendedAttributes []byte `protobuf:"bytes,99,opt,name=ExtendedAttributes,proto3" json:"-"`
 }
@@ -606,6 +665,55 @@ func (m *Check) GetHooks() []*Hook {
 	return nil
 }
 
+func (m *Check) GetOutputMetricFormat() string {
+	if m != nil {
+		return m.OutputMetricFormat
+	}
+	return ""
+}
+
+func (m *Check) GetOutputMetricHandlers() []string {
+	if m != nil {
+		return m.OutputMetricHandlers
+	}
+	return nil
+}
+
+func (m *Check) GetSandbox() *Sandbox {
+	if m != nil {
+		return m.Sandbox
+	}
+	return nil
+}
+
+func (m *Check) GetOutputTruncated() bool {
+	if m != nil {
+		return m.OutputTruncated
+	}
+	return false
+}
+
+func (m *Check) GetPrometheus() *PrometheusScrape {
+	if m != nil {
+		return m.Prometheus
+	}
+	return nil
+}
+
+func (m *Check) GetStdout() string {
+	if m != nil {
+		return m.Stdout
+	}
+	return ""
+}
+
+func (m *Check) GetStderr() string {
+	if m != nil {
+		return m.Stderr
+	}
+	return ""
+}
+
 func (m *Check) GetExtendedAttributes() []byte {
 	if m != nil {
 		return m.ExtendedAttributes
@@ -842,6 +950,23 @@ func (this *CheckConfig) Equal(that interface{}) bool {
 	if this.RoundRobin != that1.RoundRobin {
 		return false
 	}
+	if this.OutputMetricFormat != that1.OutputMetricFormat {
+		return false
+	}
+	if len(this.OutputMetricHandlers) != len(that1.OutputMetricHandlers) {
+		return false
+	}
+	for i := range this.OutputMetricHandlers {
+		if this.OutputMetricHandlers[i] != that1.OutputMetricHandlers[i] {
+			return false
+		}
+	}
+	if !this.Sandbox.Equal(that1.Sandbox) {
+		return false
+	}
+	if !this.Prometheus.Equal(that1.Prometheus) {
+		return false
+	}
 	return true
 }
 func (this *Check) Equal(that interface{}) bool {
@@ -1003,6 +1128,32 @@ func (this *Check) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.OutputMetricFormat != that1.OutputMetricFormat {
+		return false
+	}
+	if len(this.OutputMetricHandlers) != len(that1.OutputMetricHandlers) {
+		return false
+	}
+	for i := range this.OutputMetricHandlers {
+		if this.OutputMetricHandlers[i] != that1.OutputMetricHandlers[i] {
+			return false
+		}
+	}
+	if !this.Sandbox.Equal(that1.Sandbox) {
+		return false
+	}
+	if this.OutputTruncated != that1.OutputTruncated {
+		return false
+	}
+	if !this.Prometheus.Equal(that1.Prometheus) {
+		return false
+	}
+	if this.Stdout != that1.Stdout {
+		return false
+	}
+	if this.Stderr != that1.Stderr {
+		return false
+	}
 	if !bytes.Equal(this.ExtendedAttributes, that1.ExtendedAttributes) {
 		return false
 	}
@@ -1342,6 +1493,55 @@ func (m *CheckConfig) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.OutputMetricFormat) > 0 {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.OutputMetricFormat)))
+		i += copy(dAtA[i:], m.OutputMetricFormat)
+	}
+	if len(m.OutputMetricHandlers) > 0 {
+		for _, s := range m.OutputMetricHandlers {
+			dAtA[i] = 0xba
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Sandbox != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.Sandbox.Size()))
+		n4, err := m.Sandbox.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if m.Prometheus != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.Prometheus.Size()))
+		n5, err := m.Prometheus.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
 	return i, nil
 }
 
@@ -1488,11 +1688,11 @@ func (m *Check) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintCheck(dAtA, i, uint64(m.Subdue.Size()))
-		n4, err := m.Subdue.MarshalTo(dAtA[i:])
+		n6, err := m.Subdue.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n6
 	}
 	if len(m.Cron) > 0 {
 		dAtA[i] = 0x8a
@@ -1522,11 +1722,11 @@ func (m *Check) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintCheck(dAtA, i, uint64(m.ProxyRequests.Size()))
-		n5, err := m.ProxyRequests.MarshalTo(dAtA[i:])
+		n7, err := m.ProxyRequests.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n7
 	}
 	if m.RoundRobin {
 		dAtA[i] = 0xa8
@@ -1658,6 +1858,83 @@ func (m *Check) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if len(m.OutputMetricFormat) > 0 {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.OutputMetricFormat)))
+		i += copy(dAtA[i:], m.OutputMetricFormat)
+	}
+	if len(m.OutputMetricHandlers) > 0 {
+		for _, s := range m.OutputMetricHandlers {
+			dAtA[i] = 0xa2
+			i++
+			dAtA[i] = 0x2
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Sandbox != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.Sandbox.Size()))
+		n8, err := m.Sandbox.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.OutputTruncated {
+		dAtA[i] = 0xb0
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.OutputTruncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Prometheus != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(m.Prometheus.Size()))
+		n9, err := m.Prometheus.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if len(m.Stdout) > 0 {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Stdout)))
+		i += copy(dAtA[i:], m.Stdout)
+	}
+	if len(m.Stderr) > 0 {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintCheck(dAtA, i, uint64(len(m.Stderr)))
+		i += copy(dAtA[i:], m.Stderr)
+	}
 	if len(m.ExtendedAttributes) > 0 {
 		dAtA[i] = 0x9a
 		i++
@@ -1799,6 +2076,18 @@ func NewPopulatedCheckConfig(r randyCheck, easy bool) *CheckConfig {
 		this.ProxyRequests = NewPopulatedProxyRequests(r, easy)
 	}
 	this.RoundRobin = bool(bool(r.Intn(2) == 0))
+	this.OutputMetricFormat = string(randStringCheck(r))
+	v12 := r.Intn(10)
+	this.OutputMetricHandlers = make([]string, v12)
+	for i := 0; i < v12; i++ {
+		this.OutputMetricHandlers[i] = string(randStringCheck(r))
+	}
+	if r.Intn(10) != 0 {
+		this.Sandbox = NewPopulatedSandbox(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Prometheus = NewPopulatedPrometheusScrape(r, easy)
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1808,9 +2097,9 @@ func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 	this := &Check{}
 	this.Command = string(randStringCheck(r))
 	this.Environment = string(randStringCheck(r))
-	v12 := r.Intn(10)
-	this.Handlers = make([]string, v12)
-	for i := 0; i < v12; i++ {
+	v13 := r.Intn(10)
+	this.Handlers = make([]string, v13)
+	for i := 0; i < v13; i++ {
 		this.Handlers[i] = string(randStringCheck(r))
 	}
 	this.HighFlapThreshold = uint32(r.Uint32())
@@ -1819,23 +2108,23 @@ func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 	this.Name = string(randStringCheck(r))
 	this.Organization = string(randStringCheck(r))
 	this.Publish = bool(bool(r.Intn(2) == 0))
-	v13 := r.Intn(10)
-	this.RuntimeAssets = make([]string, v13)
-	for i := 0; i < v13; i++ {
-		this.RuntimeAssets[i] = string(randStringCheck(r))
-	}
 	v14 := r.Intn(10)
-	this.Subscriptions = make([]string, v14)
+	this.RuntimeAssets = make([]string, v14)
 	for i := 0; i < v14; i++ {
+		this.RuntimeAssets[i] = string(randStringCheck(r))
+	}
+	v15 := r.Intn(10)
+	this.Subscriptions = make([]string, v15)
+	for i := 0; i < v15; i++ {
 		this.Subscriptions[i] = string(randStringCheck(r))
 	}
 	this.ProxyEntityID = string(randStringCheck(r))
 	if r.Intn(10) != 0 {
-		v15 := r.Intn(5)
-		this.CheckHooks = make([]HookList, v15)
-		for i := 0; i < v15; i++ {
-			v16 := NewPopulatedHookList(r, easy)
-			this.CheckHooks[i] = *v16
+		v16 := r.Intn(5)
+		this.CheckHooks = make([]HookList, v16)
+		for i := 0; i < v16; i++ {
+			v17 := NewPopulatedHookList(r, easy)
+			this.CheckHooks[i] = *v17
 		}
 	}
 	this.Stdin = bool(bool(r.Intn(2) == 0))
@@ -1861,11 +2150,11 @@ func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 		this.Executed *= -1
 	}
 	if r.Intn(10) != 0 {
-		v17 := r.Intn(5)
-		this.History = make([]CheckHistory, v17)
-		for i := 0; i < v17; i++ {
-			v18 := NewPopulatedCheckHistory(r, easy)
-			this.History[i] = *v18
+		v18 := r.Intn(5)
+		this.History = make([]CheckHistory, v18)
+		for i := 0; i < v18; i++ {
+			v19 := NewPopulatedCheckHistory(r, easy)
+			this.History[i] = *v19
 		}
 	}
 	this.Issued = int64(r.Int63())
@@ -1888,21 +2177,36 @@ func NewPopulatedCheck(r randyCheck, easy bool) *Check {
 	if r.Intn(2) == 0 {
 		this.OccurrencesWatermark *= -1
 	}
-	v19 := r.Intn(10)
-	this.Silenced = make([]string, v19)
-	for i := 0; i < v19; i++ {
+	v20 := r.Intn(10)
+	this.Silenced = make([]string, v20)
+	for i := 0; i < v20; i++ {
 		this.Silenced[i] = string(randStringCheck(r))
 	}
 	if r.Intn(10) != 0 {
-		v20 := r.Intn(5)
-		this.Hooks = make([]*Hook, v20)
-		for i := 0; i < v20; i++ {
+		v21 := r.Intn(5)
+		this.Hooks = make([]*Hook, v21)
+		for i := 0; i < v21; i++ {
 			this.Hooks[i] = NewPopulatedHook(r, easy)
 		}
 	}
-	v21 := r.Intn(100)
-	this.ExtendedAttributes = make([]byte, v21)
-	for i := 0; i < v21; i++ {
+	this.OutputMetricFormat = string(randStringCheck(r))
+	v22 := r.Intn(10)
+	this.OutputMetricHandlers = make([]string, v22)
+	for i := 0; i < v22; i++ {
+		this.OutputMetricHandlers[i] = string(randStringCheck(r))
+	}
+	if r.Intn(10) != 0 {
+		this.Sandbox = NewPopulatedSandbox(r, easy)
+	}
+	this.OutputTruncated = bool(bool(r.Intn(2) == 0))
+	if r.Intn(10) != 0 {
+		this.Prometheus = NewPopulatedPrometheusScrape(r, easy)
+	}
+	this.Stdout = string(randStringCheck(r))
+	this.Stderr = string(randStringCheck(r))
+	v23 := r.Intn(100)
+	this.ExtendedAttributes = make([]byte, v23)
+	for i := 0; i < v23; i++ {
 		this.ExtendedAttributes[i] = byte(r.Intn(256))
 	}
 	if !easy && r.Intn(10) != 0 {
@@ -1941,9 +2245,9 @@ func randUTF8RuneCheck(r randyCheck) rune {
 	return rune(ru + 61)
 }
 func randStringCheck(r randyCheck) string {
-	v22 := r.Intn(100)
-	tmps := make([]rune, v22)
-	for i := 0; i < v22; i++ {
+	v24 := r.Intn(100)
+	tmps := make([]rune, v24)
+	for i := 0; i < v24; i++ {
 		tmps[i] = randUTF8RuneCheck(r)
 	}
 	return string(tmps)
@@ -1965,11 +2269,11 @@ func randFieldCheck(dAtA []byte, r randyCheck, fieldNumber int, wire int) []byte
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateCheck(dAtA, uint64(key))
-		v23 := r.Int63()
+		v25 := r.Int63()
 		if r.Intn(2) == 0 {
-			v23 *= -1
+			v25 *= -1
 		}
-		dAtA = encodeVarintPopulateCheck(dAtA, uint64(v23))
+		dAtA = encodeVarintPopulateCheck(dAtA, uint64(v25))
 	case 1:
 		dAtA = encodeVarintPopulateCheck(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -2121,6 +2425,24 @@ func (m *CheckConfig) Size() (n int) {
 	if m.RoundRobin {
 		n += 3
 	}
+	l = len(m.OutputMetricFormat)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if len(m.OutputMetricHandlers) > 0 {
+		for _, s := range m.OutputMetricHandlers {
+			l = len(s)
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	if m.Sandbox != nil {
+		l = m.Sandbox.Size()
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.Prometheus != nil {
+		l = m.Prometheus.Size()
+		n += 2 + l + sovCheck(uint64(l))
+	}
 	return n
 }
 
@@ -2257,6 +2579,35 @@ func (m *Check) Size() (n int) {
 			n += 2 + l + sovCheck(uint64(l))
 		}
 	}
+	l = len(m.OutputMetricFormat)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if len(m.OutputMetricHandlers) > 0 {
+		for _, s := range m.OutputMetricHandlers {
+			l = len(s)
+			n += 2 + l + sovCheck(uint64(l))
+		}
+	}
+	if m.Sandbox != nil {
+		l = m.Sandbox.Size()
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	if m.OutputTruncated {
+		n += 3
+	}
+	if m.Prometheus != nil {
+		l = m.Prometheus.Size()
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.Stdout)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
+	l = len(m.Stderr)
+	if l > 0 {
+		n += 2 + l + sovCheck(uint64(l))
+	}
 	l = len(m.ExtendedAttributes)
 	if l > 0 {
 		n += 2 + l + sovCheck(uint64(l))
@@ -3125,6 +3476,130 @@ func (m *CheckConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RoundRobin = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputMetricFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputMetricFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputMetricHandlers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputMetricHandlers = append(m.OutputMetricHandlers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sandbox", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sandbox == nil {
+				m.Sandbox = &Sandbox{}
+			}
+			if err := m.Sandbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prometheus == nil {
+				m.Prometheus = &PrometheusScrape{}
+			}
+			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCheck(dAtA[iNdEx:])
@@ -3981,6 +4456,208 @@ func (m *Check) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 35:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputMetricFormat", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputMetricFormat = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 36:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputMetricHandlers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputMetricHandlers = append(m.OutputMetricHandlers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 37:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sandbox", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sandbox == nil {
+				m.Sandbox = &Sandbox{}
+			}
+			if err := m.Sandbox.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 38:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputTruncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OutputTruncated = bool(v != 0)
+		case 39:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prometheus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Prometheus == nil {
+				m.Prometheus = &PrometheusScrape{}
+			}
+			if err := m.Prometheus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stdout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stdout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stderr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCheck
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCheck
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stderr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedAttributes", wireType)
@@ -4229,70 +4906,83 @@ var (
 func init() { proto.RegisterFile("check.proto", fileDescriptorCheck) }
 
 var fileDescriptorCheck = []byte{
-	// 1032 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0x23, 0x45,
-	0x17, 0x9d, 0x8e, 0x63, 0x27, 0x29, 0xc7, 0x49, 0x5c, 0x49, 0x66, 0x6a, 0x3c, 0xdf, 0xe7, 0x6e,
-	0x3c, 0x20, 0x79, 0x41, 0x3c, 0x68, 0x22, 0x40, 0xac, 0x50, 0x3a, 0x33, 0x28, 0x68, 0x22, 0x0d,
-	0x6a, 0x46, 0x8a, 0xc4, 0xa6, 0xd5, 0xee, 0xae, 0xb8, 0x4b, 0x69, 0x57, 0x99, 0xaa, 0xea, 0xfc,
-	0xb0, 0xe2, 0x11, 0x58, 0xf2, 0x08, 0x6c, 0xd8, 0xf3, 0x08, 0xb3, 0x84, 0x17, 0x68, 0x81, 0xd9,
-	0xf9, 0x09, 0x58, 0xa2, 0xba, 0x5d, 0xf6, 0xb8, 0x13, 0x10, 0x5b, 0x90, 0x66, 0x95, 0x3a, 0xe7,
-	0x9e, 0x5b, 0x75, 0xfb, 0xd6, 0x3d, 0x71, 0xa1, 0x66, 0x9c, 0xd2, 0xf8, 0x62, 0x30, 0x91, 0x42,
-	0x0b, 0xdc, 0x54, 0x94, 0xab, 0x7c, 0xa0, 0x6f, 0x26, 0x54, 0x75, 0x0e, 0x46, 0x4c, 0xa7, 0xf9,
-	0x70, 0x10, 0x8b, 0xf1, 0x93, 0x91, 0x18, 0x89, 0x27, 0xa0, 0x19, 0xe6, 0xe7, 0x80, 0x00, 0xc0,
-	0xaa, 0xcc, 0xed, 0x34, 0x23, 0xa5, 0xa8, 0xb6, 0x00, 0xa5, 0x42, 0xd8, 0x4d, 0x3b, 0x6d, 0xcd,
-	0xc6, 0x34, 0xbc, 0x62, 0x3c, 0x11, 0x57, 0x25, 0xd5, 0xfb, 0xd1, 0x41, 0x9b, 0xc7, 0xe6, 0xdc,
-	0x80, 0x7e, 0x9d, 0x53, 0xa5, 0xf1, 0x47, 0xa8, 0x11, 0x0b, 0x7e, 0xce, 0x46, 0xc4, 0xf1, 0x9c,
-	0x7e, 0xf3, 0x29, 0x19, 0x2c, 0x55, 0x32, 0x00, 0xe9, 0x31, 0xc4, 0xfd, 0xd5, 0xd7, 0x85, 0xeb,
-	0x04, 0x56, 0x8d, 0x3f, 0x40, 0x0d, 0x38, 0x56, 0x91, 0x15, 0xaf, 0xd6, 0x6f, 0x3e, 0xc5, 0x95,
-	0xbc, 0x23, 0x13, 0x82, 0x8c, 0x7b, 0x81, 0xd5, 0xe1, 0x43, 0x54, 0x37, 0xb5, 0x29, 0x52, 0x83,
-	0x84, 0x07, 0x95, 0x84, 0x13, 0x21, 0x96, 0xcf, 0xb9, 0x17, 0x94, 0xda, 0xde, 0x77, 0x0e, 0x6a,
-	0x7d, 0x21, 0xc5, 0xf5, 0x8d, 0xad, 0x57, 0x61, 0x1f, 0xb5, 0x29, 0xd7, 0x4c, 0xdf, 0x84, 0x91,
-	0xd6, 0x92, 0x0d, 0x73, 0x4d, 0x15, 0x71, 0xbc, 0x5a, 0x7f, 0xc3, 0xdf, 0x9f, 0x15, 0xee, 0xdd,
-	0x60, 0xb0, 0x53, 0x52, 0x47, 0x0b, 0x06, 0xef, 0xa1, 0xba, 0x9a, 0x64, 0xd1, 0x0d, 0x59, 0xf1,
-	0x9c, 0xfe, 0x7a, 0x50, 0x02, 0xfc, 0x1e, 0xda, 0x82, 0x45, 0x18, 0x8b, 0x4b, 0x2a, 0xa3, 0x11,
-	0x25, 0x35, 0xcf, 0xe9, 0xb7, 0x82, 0x16, 0xb0, 0xc7, 0x96, 0xec, 0x7d, 0xbb, 0x86, 0x9a, 0x4b,
-	0x7d, 0xc1, 0x04, 0xad, 0xc5, 0x62, 0x3c, 0x8e, 0x78, 0x02, 0x2d, 0xdc, 0x08, 0xe6, 0x10, 0x7b,
-	0xa8, 0x49, 0xf9, 0x25, 0x93, 0x82, 0x8f, 0x29, 0xd7, 0x70, 0xd8, 0x46, 0xb0, 0x4c, 0xe1, 0x3e,
-	0x5a, 0x4f, 0x23, 0x9e, 0x64, 0x54, 0x96, 0x6d, 0xd9, 0xf0, 0x37, 0x67, 0x85, 0xbb, 0xe0, 0x82,
-	0xc5, 0x0a, 0x0f, 0xd0, 0x6e, 0xca, 0x46, 0x69, 0x78, 0x9e, 0x45, 0x93, 0x50, 0xa7, 0x92, 0xaa,
-	0x54, 0x64, 0x09, 0x59, 0x85, 0x0a, 0xdb, 0x26, 0xf4, 0x59, 0x16, 0x4d, 0x5e, 0xcd, 0x03, 0xb8,
-	0x83, 0xd6, 0x19, 0xd7, 0x54, 0x5e, 0x46, 0x19, 0xa9, 0x83, 0x68, 0x81, 0xf1, 0xfb, 0x08, 0x67,
-	0xe2, 0xea, 0xf6, 0x56, 0x0d, 0x50, 0xed, 0x64, 0xe2, 0xaa, 0xba, 0x13, 0x46, 0xab, 0x3c, 0x1a,
-	0x53, 0xb2, 0x06, 0xe5, 0xc3, 0x1a, 0xf7, 0xd0, 0xa6, 0x90, 0xa3, 0x88, 0xb3, 0x6f, 0x22, 0xcd,
-	0x04, 0x27, 0xeb, 0x10, 0xab, 0x70, 0xa6, 0x2f, 0x93, 0x7c, 0x98, 0x31, 0x95, 0x92, 0x0d, 0x68,
-	0xf3, 0x1c, 0xe2, 0x4f, 0xd0, 0x96, 0xcc, 0x39, 0x0c, 0xa7, 0x9d, 0x21, 0x04, 0xdf, 0x8e, 0x67,
-	0x85, 0x7b, 0x2b, 0x12, 0xb4, 0x2c, 0x3e, 0x2a, 0x87, 0xe8, 0x63, 0xd4, 0x52, 0xf9, 0x50, 0xc5,
-	0x92, 0x4d, 0xcc, 0x21, 0x8a, 0x34, 0x21, 0xb3, 0x3d, 0x2b, 0xdc, 0x6a, 0x20, 0xa8, 0x42, 0xfc,
-	0x21, 0xc2, 0xcf, 0xaf, 0x35, 0xe5, 0x09, 0x4d, 0xde, 0x0c, 0x02, 0xd9, 0xf4, 0x9c, 0xfe, 0xa6,
-	0x5f, 0x9f, 0x15, 0xae, 0x73, 0x10, 0xfc, 0x85, 0x00, 0x9f, 0xa2, 0xed, 0x89, 0x19, 0xbf, 0xd0,
-	0x8e, 0x15, 0x4b, 0x48, 0xcb, 0x7c, 0xab, 0xff, 0xee, 0xb4, 0x70, 0xcb, 0xc9, 0x7c, 0x0e, 0x91,
-	0xcf, 0x9f, 0xcd, 0x0a, 0xf7, 0xb6, 0x36, 0x68, 0x4d, 0x96, 0x14, 0x09, 0x7e, 0x61, 0x4d, 0x1f,
-	0x96, 0x46, 0xd8, 0x02, 0x23, 0xec, 0xdf, 0x31, 0xc2, 0x29, 0x53, 0xda, 0xdf, 0x35, 0x36, 0x98,
-	0x15, 0xee, 0x72, 0x46, 0x80, 0x00, 0x18, 0x4d, 0x39, 0xc4, 0x3a, 0x61, 0x9c, 0x6c, 0xdb, 0x21,
-	0x36, 0x00, 0x7f, 0x8a, 0x1a, 0x2a, 0x1f, 0x26, 0x39, 0x25, 0x3b, 0xe0, 0xe7, 0x47, 0x95, 0xdd,
-	0x5f, 0xb1, 0x31, 0x3d, 0x83, 0xff, 0x07, 0x67, 0x29, 0xe5, 0x3e, 0x9a, 0x15, 0xae, 0x95, 0x07,
-	0xf6, 0xaf, 0xb9, 0xee, 0x58, 0x0a, 0x4e, 0xda, 0xe5, 0x75, 0x9b, 0x35, 0xde, 0x41, 0x35, 0xad,
-	0x33, 0x82, 0x3d, 0xa7, 0x5f, 0x0b, 0xcc, 0xd2, 0x5c, 0xae, 0xb9, 0x15, 0x91, 0x6b, 0xb2, 0x0b,
-	0x73, 0x33, 0x87, 0xf8, 0x08, 0x6d, 0x95, 0x5d, 0x90, 0xd6, 0xb1, 0x64, 0x0f, 0x0a, 0xe9, 0x54,
-	0x0a, 0xa9, 0x78, 0xda, 0xb6, 0x69, 0x61, 0x71, 0x17, 0x35, 0xa5, 0xc8, 0x79, 0x12, 0x4a, 0x31,
-	0x64, 0x9c, 0xec, 0xc3, 0xf7, 0x21, 0xa0, 0x02, 0xc3, 0xf4, 0x7e, 0x41, 0xa8, 0x0e, 0x16, 0x7c,
-	0x6b, 0xbe, 0xff, 0x84, 0xf9, 0xde, 0xba, 0xe8, 0x5f, 0xe8, 0x22, 0x33, 0xa5, 0x49, 0x2e, 0xcb,
-	0x19, 0xba, 0xef, 0x39, 0x7d, 0x27, 0x58, 0x60, 0x13, 0xa3, 0xd7, 0x34, 0xce, 0x35, 0x4d, 0xc8,
-	0x03, 0x28, 0x78, 0x81, 0xf1, 0x33, 0xb4, 0x96, 0x32, 0xa5, 0x85, 0xbc, 0x21, 0x04, 0x7a, 0xff,
-	0xf0, 0xee, 0x9b, 0xe1, 0xa4, 0x14, 0xf8, 0xdb, 0xb6, 0xff, 0xf3, 0x8c, 0x60, 0xbe, 0xc0, 0xf7,
-	0x51, 0x83, 0x29, 0x95, 0xd3, 0x84, 0x3c, 0x84, 0xfd, 0x2d, 0x32, 0xbc, 0xc8, 0xf5, 0x24, 0xd7,
-	0xa4, 0x03, 0xbd, 0xb3, 0xa8, 0xbc, 0xa8, 0x48, 0x53, 0xf2, 0x08, 0xe8, 0x12, 0x18, 0xb5, 0x59,
-	0xe4, 0x8a, 0xfc, 0x0f, 0x1a, 0x68, 0x91, 0x71, 0x99, 0x16, 0x3a, 0xca, 0x42, 0x90, 0x85, 0x71,
-	0x1a, 0xf1, 0x11, 0x25, 0xff, 0x2f, 0x5d, 0x06, 0x91, 0x2f, 0x4d, 0xe0, 0x18, 0x78, 0xfc, 0x18,
-	0xad, 0x65, 0x91, 0xd2, 0xa1, 0xb8, 0x20, 0x5d, 0x53, 0x8c, 0x8f, 0xa6, 0x85, 0xdb, 0x38, 0x8d,
-	0x94, 0x7e, 0xf9, 0x22, 0x68, 0x98, 0xd0, 0xcb, 0x0b, 0xf3, 0x0f, 0x45, 0xc4, 0x71, 0x2e, 0x25,
-	0xe5, 0x31, 0x55, 0xc4, 0x85, 0xaa, 0x97, 0x29, 0x7c, 0x88, 0xf6, 0x97, 0x60, 0x78, 0x15, 0x69,
-	0x2a, 0xc7, 0x91, 0xbc, 0x20, 0x1e, 0x68, 0xf7, 0x96, 0x82, 0x67, 0xf3, 0x18, 0xf6, 0xd0, 0xba,
-	0x62, 0x99, 0x21, 0x13, 0xf2, 0x0e, 0xf8, 0xa9, 0x7c, 0x68, 0x2d, 0x58, 0x7c, 0x30, 0x7f, 0x38,
-	0xf5, 0xa0, 0xdb, 0xed, 0x3b, 0x93, 0x6e, 0x33, 0x4a, 0xd5, 0xdf, 0xfc, 0xd2, 0xc5, 0xff, 0xf0,
-	0x4b, 0xd7, 0xf3, 0xed, 0xc3, 0xf0, 0xe4, 0xcd, 0xfd, 0xd8, 0xce, 0x3a, 0x95, 0xce, 0x2e, 0x4f,
-	0xc6, 0x4a, 0x75, 0x32, 0xfc, 0xc7, 0x7f, 0xfc, 0xd6, 0x75, 0x7e, 0x98, 0x76, 0x9d, 0x9f, 0xa6,
-	0x5d, 0xe7, 0xf5, 0xb4, 0xeb, 0xfc, 0x3c, 0xed, 0x3a, 0xbf, 0x4e, 0xbb, 0xce, 0xf7, 0xbf, 0x77,
-	0xef, 0x7d, 0x55, 0x87, 0x8a, 0x87, 0x0d, 0x78, 0x89, 0x1e, 0xfe, 0x19, 0x00, 0x00, 0xff, 0xff,
-	0x17, 0x87, 0xfe, 0xc1, 0x00, 0x0b, 0x00, 0x00,
+	// 1248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0x41, 0x8f, 0xd3, 0xc6,
+	0x17, 0xc7, 0x84, 0xcd, 0xee, 0x4e, 0x36, 0xbb, 0xd9, 0x21, 0x0b, 0x43, 0x80, 0x38, 0xff, 0x00,
+	0xff, 0xa6, 0x12, 0x2c, 0x08, 0xd4, 0x56, 0x3d, 0x55, 0x78, 0x01, 0x81, 0xa0, 0x02, 0x19, 0x24,
+	0xa4, 0xf6, 0x60, 0x39, 0xf6, 0x6c, 0x6c, 0xad, 0x3d, 0xe3, 0xce, 0x8c, 0xd9, 0xdd, 0x7e, 0x8a,
+	0x1e, 0xfb, 0x01, 0x7a, 0xe8, 0xa1, 0xbd, 0xf7, 0x23, 0x70, 0xec, 0x27, 0xb0, 0xda, 0xf4, 0x96,
+	0x4f, 0xd0, 0x63, 0x35, 0xcf, 0x93, 0x60, 0x67, 0x8b, 0x2a, 0x95, 0x1e, 0x5a, 0x89, 0x53, 0xe6,
+	0xf7, 0x7e, 0xbf, 0x37, 0x7e, 0x7e, 0x7e, 0xef, 0xcd, 0x04, 0xb5, 0x82, 0x88, 0x06, 0x07, 0xbb,
+	0x99, 0xe0, 0x8a, 0xe3, 0x96, 0xa4, 0x4c, 0xe6, 0xbb, 0xea, 0x38, 0xa3, 0xb2, 0x77, 0x63, 0x12,
+	0xab, 0x28, 0x1f, 0xef, 0x06, 0x3c, 0xbd, 0x39, 0xe1, 0x13, 0x7e, 0x13, 0x34, 0xe3, 0x7c, 0x1f,
+	0x10, 0x00, 0x58, 0x95, 0xbe, 0xbd, 0x96, 0x2f, 0x25, 0x55, 0x06, 0xa0, 0x88, 0x73, 0xb3, 0x69,
+	0xaf, 0x93, 0x09, 0x9e, 0x52, 0x15, 0xd1, 0x5c, 0x1a, 0x4b, 0x5b, 0xfa, 0x2c, 0x1c, 0xf3, 0x23,
+	0x03, 0xb7, 0x55, 0x9c, 0x52, 0xef, 0x30, 0x66, 0x21, 0x3f, 0x2c, 0x4d, 0xc3, 0x1f, 0x2d, 0xb4,
+	0xb1, 0xa7, 0x03, 0x73, 0xe9, 0x57, 0x39, 0x95, 0x0a, 0x7f, 0x8c, 0x9a, 0x01, 0x67, 0xfb, 0xf1,
+	0x84, 0x58, 0x03, 0x6b, 0xd4, 0xba, 0x4d, 0x76, 0x2b, 0xa1, 0xee, 0x82, 0x74, 0x0f, 0x78, 0xe7,
+	0xcc, 0xeb, 0xc2, 0xb6, 0x5c, 0xa3, 0xc6, 0xb7, 0x50, 0x13, 0xe2, 0x92, 0xe4, 0xf4, 0xa0, 0x31,
+	0x6a, 0xdd, 0xc6, 0x35, 0xbf, 0xbb, 0x9a, 0x02, 0x8f, 0x53, 0xae, 0xd1, 0xe1, 0x3b, 0x68, 0x45,
+	0x07, 0x2f, 0x49, 0x03, 0x1c, 0xce, 0xd7, 0x1c, 0x1e, 0x72, 0x5e, 0x7d, 0xce, 0x29, 0xb7, 0xd4,
+	0x0e, 0xbf, 0xb1, 0x50, 0xfb, 0x99, 0xe0, 0x47, 0xc7, 0x26, 0x5e, 0x89, 0x1d, 0xb4, 0x4d, 0x99,
+	0x8a, 0xd5, 0xb1, 0xe7, 0x2b, 0x25, 0xe2, 0x71, 0xae, 0xa8, 0x24, 0xd6, 0xa0, 0x31, 0x5a, 0x77,
+	0x76, 0x66, 0x85, 0x7d, 0x92, 0x74, 0x3b, 0xa5, 0xe9, 0xee, 0xc2, 0x82, 0xbb, 0x68, 0x45, 0x66,
+	0x89, 0x7f, 0x4c, 0x4e, 0x0f, 0xac, 0xd1, 0x9a, 0x5b, 0x02, 0x7c, 0x0d, 0x6d, 0xc2, 0xc2, 0x0b,
+	0xf8, 0x2b, 0x2a, 0xfc, 0x09, 0x25, 0x8d, 0x81, 0x35, 0x6a, 0xbb, 0x6d, 0xb0, 0xee, 0x19, 0xe3,
+	0xf0, 0x87, 0x75, 0xd4, 0xaa, 0xe4, 0x05, 0x13, 0xb4, 0x1a, 0xf0, 0x34, 0xf5, 0x59, 0x08, 0x29,
+	0x5c, 0x77, 0xe7, 0x10, 0x0f, 0x50, 0x8b, 0xb2, 0x57, 0xb1, 0xe0, 0x2c, 0xa5, 0x4c, 0xc1, 0xc3,
+	0xd6, 0xdd, 0xaa, 0x09, 0x8f, 0xd0, 0x5a, 0xe4, 0xb3, 0x30, 0xa1, 0xa2, 0x4c, 0xcb, 0xba, 0xb3,
+	0x31, 0x2b, 0xec, 0x85, 0xcd, 0x5d, 0xac, 0xf0, 0x2e, 0x3a, 0x1b, 0xc5, 0x93, 0xc8, 0xdb, 0x4f,
+	0xfc, 0xcc, 0x53, 0x91, 0xa0, 0x32, 0xe2, 0x49, 0x48, 0xce, 0x40, 0x84, 0xdb, 0x9a, 0x7a, 0x90,
+	0xf8, 0xd9, 0x8b, 0x39, 0x81, 0x7b, 0x68, 0x2d, 0x66, 0x8a, 0x8a, 0x57, 0x7e, 0x42, 0x56, 0x40,
+	0xb4, 0xc0, 0xf8, 0x3a, 0xc2, 0x09, 0x3f, 0x5c, 0xde, 0xaa, 0x09, 0xaa, 0x4e, 0xc2, 0x0f, 0xeb,
+	0x3b, 0x61, 0x74, 0x86, 0xf9, 0x29, 0x25, 0xab, 0x10, 0x3e, 0xac, 0xf1, 0x10, 0x6d, 0x70, 0x31,
+	0xf1, 0x59, 0xfc, 0xb5, 0xaf, 0x62, 0xce, 0xc8, 0x1a, 0x70, 0x35, 0x9b, 0xce, 0x4b, 0x96, 0x8f,
+	0x93, 0x58, 0x46, 0x64, 0x1d, 0xd2, 0x3c, 0x87, 0xf8, 0x53, 0xb4, 0x29, 0x72, 0x06, 0xc5, 0x69,
+	0x6a, 0x08, 0xc1, 0xbb, 0xe3, 0x59, 0x61, 0x2f, 0x31, 0x6e, 0xdb, 0x60, 0xa8, 0x28, 0x89, 0x3f,
+	0x41, 0x6d, 0x99, 0x8f, 0x65, 0x20, 0xe2, 0x4c, 0x3f, 0x44, 0x92, 0x16, 0x78, 0x6e, 0xcf, 0x0a,
+	0xbb, 0x4e, 0xb8, 0x75, 0x88, 0x3f, 0x42, 0xf8, 0xfe, 0x91, 0xa2, 0x2c, 0xa4, 0xe1, 0x9b, 0x42,
+	0x20, 0x1b, 0x03, 0x6b, 0xb4, 0xe1, 0xac, 0xcc, 0x0a, 0xdb, 0xba, 0xe1, 0xfe, 0x89, 0x00, 0x3f,
+	0x41, 0x5b, 0x99, 0x2e, 0x3f, 0xcf, 0x94, 0x55, 0x1c, 0x92, 0xb6, 0x7e, 0x57, 0xe7, 0xea, 0xb4,
+	0xb0, 0xcb, 0xca, 0xbc, 0x0f, 0xcc, 0xa3, 0x7b, 0xb3, 0xc2, 0x5e, 0xd6, 0xba, 0xed, 0xac, 0xa2,
+	0x08, 0xf1, 0x63, 0x33, 0x15, 0xbc, 0xb2, 0x11, 0x36, 0xa1, 0x11, 0x76, 0x4e, 0x34, 0xc2, 0x93,
+	0x58, 0x2a, 0xe7, 0xac, 0x6e, 0x83, 0x59, 0x61, 0x57, 0x3d, 0x5c, 0x04, 0x40, 0x6b, 0xca, 0x22,
+	0x56, 0x61, 0xcc, 0xc8, 0x96, 0x29, 0x62, 0x0d, 0xf0, 0x67, 0xa8, 0x29, 0xf3, 0x71, 0x98, 0x53,
+	0xd2, 0x81, 0x7e, 0xbe, 0x58, 0xdb, 0xfd, 0x45, 0x9c, 0xd2, 0x97, 0x30, 0x0f, 0x5e, 0x46, 0x94,
+	0x39, 0x68, 0x56, 0xd8, 0x46, 0xee, 0x9a, 0x5f, 0xfd, 0xb9, 0x03, 0xc1, 0x19, 0xd9, 0x2e, 0x3f,
+	0xb7, 0x5e, 0xe3, 0x0e, 0x6a, 0x28, 0x95, 0x10, 0x3c, 0xb0, 0x46, 0x0d, 0x57, 0x2f, 0xf5, 0xc7,
+	0xd5, 0x5f, 0x85, 0xe7, 0x8a, 0x9c, 0x85, 0xba, 0x99, 0x43, 0x7c, 0x17, 0x6d, 0x96, 0x59, 0x10,
+	0xa6, 0x63, 0x49, 0x17, 0x02, 0xe9, 0xd5, 0x02, 0xa9, 0xf5, 0xb4, 0x49, 0xd3, 0x1c, 0x62, 0x1b,
+	0xb5, 0x04, 0xcf, 0x59, 0xe8, 0x09, 0x3e, 0x8e, 0x19, 0xd9, 0x81, 0xf7, 0x43, 0x60, 0x72, 0xb5,
+	0x05, 0xdf, 0x42, 0x5d, 0x9e, 0xab, 0x2c, 0x57, 0x5e, 0x4a, 0x95, 0x88, 0x03, 0x6f, 0x9f, 0x8b,
+	0xd4, 0x57, 0xe4, 0x1c, 0xc4, 0x8c, 0x4b, 0xee, 0x73, 0xa0, 0x1e, 0x00, 0x83, 0x9f, 0xa1, 0x73,
+	0x75, 0x8f, 0x45, 0xdb, 0x9d, 0x87, 0x02, 0xea, 0xcd, 0x0a, 0xfb, 0x2d, 0x0a, 0xb7, 0x5b, 0xdd,
+	0xef, 0xa1, 0xb1, 0xe2, 0x47, 0x68, 0xd5, 0x4c, 0x5b, 0x42, 0xe0, 0x05, 0xbb, 0xb5, 0x17, 0x7c,
+	0x5e, 0x72, 0xce, 0x05, 0x3d, 0x35, 0xf5, 0x5c, 0x32, 0xe2, 0xeb, 0x3c, 0x8d, 0x15, 0x4d, 0x33,
+	0x75, 0xec, 0xce, 0xfd, 0xf1, 0x97, 0x08, 0xbd, 0x19, 0xe5, 0xe4, 0x02, 0xec, 0x76, 0x79, 0x39,
+	0x5d, 0x86, 0x7e, 0x1e, 0x08, 0x3f, 0xa3, 0xce, 0x25, 0xb3, 0x6d, 0xf7, 0x8d, 0x63, 0x65, 0xe7,
+	0xca, 0x76, 0xc3, 0xef, 0x36, 0xd1, 0x0a, 0x8c, 0xab, 0xf7, 0x83, 0xea, 0x3f, 0x31, 0xa8, 0xde,
+	0x4f, 0x9c, 0x7f, 0xe3, 0xc4, 0xe9, 0xa1, 0xb5, 0x30, 0x17, 0x65, 0x0d, 0xe9, 0x29, 0x63, 0xb9,
+	0x0b, 0xac, 0x39, 0x7a, 0x44, 0x83, 0x5c, 0xd1, 0x90, 0x9c, 0x87, 0x80, 0x17, 0x18, 0xdf, 0x43,
+	0xab, 0x51, 0x2c, 0x15, 0x17, 0xc7, 0x84, 0x40, 0xee, 0x2f, 0x9c, 0xbc, 0x5f, 0x3d, 0x2c, 0x05,
+	0xce, 0x96, 0xc9, 0xff, 0xdc, 0xc3, 0x9d, 0x2f, 0xf0, 0x39, 0xd4, 0x8c, 0xa5, 0xcc, 0x69, 0x08,
+	0xc3, 0xa1, 0xe1, 0x1a, 0xa4, 0xed, 0xe5, 0x6c, 0x22, 0x3d, 0xc8, 0x9d, 0x41, 0xe5, 0x87, 0xf2,
+	0x15, 0x25, 0x17, 0xc1, 0x5c, 0x02, 0xad, 0xd6, 0x8b, 0x5c, 0x92, 0x4b, 0x90, 0x40, 0x83, 0x74,
+	0x97, 0x29, 0xae, 0xfc, 0xc4, 0x03, 0x99, 0x17, 0x44, 0x3e, 0x9b, 0x50, 0x72, 0xb9, 0xec, 0x32,
+	0x60, 0x9e, 0x6b, 0x62, 0x0f, 0xec, 0xf8, 0x0a, 0x5a, 0x4d, 0x7c, 0xa9, 0x3c, 0x7e, 0x40, 0xfa,
+	0x3a, 0x18, 0x07, 0x4d, 0x0b, 0xbb, 0xf9, 0xc4, 0x97, 0xea, 0xe9, 0x63, 0xb7, 0xa9, 0xa9, 0xa7,
+	0x07, 0x7a, 0xa0, 0xf0, 0x20, 0xc8, 0x85, 0xa0, 0x2c, 0xa0, 0x92, 0xd8, 0x10, 0x75, 0xd5, 0x84,
+	0xef, 0xa0, 0x9d, 0x0a, 0xf4, 0x0e, 0x7d, 0x45, 0x45, 0xea, 0x8b, 0x03, 0x32, 0x00, 0x6d, 0xb7,
+	0x42, 0xbe, 0x9c, 0x73, 0x78, 0x80, 0xd6, 0x64, 0x9c, 0x68, 0x63, 0x48, 0xfe, 0x07, 0xfd, 0x54,
+	0x5e, 0x4a, 0x17, 0x56, 0x7c, 0x63, 0x7e, 0xc9, 0x1c, 0x42, 0xb6, 0xb7, 0x4f, 0x54, 0xba, 0xf1,
+	0x28, 0x55, 0x6f, 0x3d, 0x48, 0xae, 0xfc, 0x8d, 0x83, 0xe4, 0xea, 0xbb, 0x1f, 0x24, 0xd7, 0xde,
+	0xf1, 0x20, 0x79, 0x84, 0x3a, 0xe6, 0xd1, 0x4a, 0xe4, 0x2c, 0xf0, 0x75, 0x45, 0xfe, 0x5f, 0xd7,
+	0xb2, 0xd3, 0x9f, 0x15, 0x76, 0x6f, 0x99, 0xab, 0x6c, 0xb1, 0x55, 0x72, 0x2f, 0xe6, 0xd4, 0xd2,
+	0x99, 0xf4, 0xc1, 0x3f, 0x7a, 0x26, 0xe1, 0xeb, 0xba, 0x12, 0x43, 0xdd, 0xca, 0x23, 0x18, 0x6d,
+	0xdd, 0x59, 0x61, 0x77, 0x4a, 0x4b, 0xc5, 0xc3, 0x68, 0x8c, 0x9a, 0x0a, 0x41, 0x3e, 0xac, 0xa9,
+	0xa9, 0x10, 0x4b, 0x6a, 0x2a, 0xc4, 0x5b, 0x2e, 0x7a, 0xc1, 0x5f, 0x5c, 0xf4, 0x86, 0x0e, 0xda,
+	0xa8, 0x36, 0x63, 0xa5, 0x59, 0xac, 0x5a, 0xb3, 0x54, 0x9b, 0xfd, 0x74, 0xbd, 0xd9, 0x9d, 0x2b,
+	0xbf, 0xff, 0xda, 0xb7, 0xbe, 0x9f, 0xf6, 0xad, 0x9f, 0xa6, 0x7d, 0xeb, 0xf5, 0xb4, 0x6f, 0xfd,
+	0x3c, 0xed, 0x5b, 0xbf, 0x4c, 0xfb, 0xd6, 0xb7, 0xbf, 0xf5, 0x4f, 0x7d, 0xb1, 0x02, 0x69, 0x1b,
+	0x37, 0xe1, 0x8f, 0xd8, 0x9d, 0x3f, 0x06, 0x00, 0xb9, 0xfb, 0x32, 0x42, 0x20, 0x0e, 0x00, 0x00,
 }
@@ -47,11 +47,24 @@ func TestCheckConfig(t *testing.T) {
 	c.Ttl = 10
 	assert.Error(t, c.Validate())
 
-	// Valid check
+	// Invalid output metric format
 	c.Ttl = 90
+	c.OutputMetricFormat = "foo"
+	assert.Error(t, c.Validate())
+	c.OutputMetricFormat = GraphiteOutputMetricFormat
+
+	// Valid check
 	assert.NoError(t, c.Validate())
 }
 
+func TestValidateOutputMetricFormat(t *testing.T) {
+	for _, format := range OutputMetricFormats {
+		assert.NoError(t, ValidateOutputMetricFormat(format))
+	}
+	assert.Error(t, ValidateOutputMetricFormat(""))
+	assert.Error(t, ValidateOutputMetricFormat("graphite"))
+}
+
 func TestScheduleValidation(t *testing.T) {
 	c := FixtureCheck("check")
 
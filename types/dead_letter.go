@@ -0,0 +1,57 @@
+package types
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// DeadLetterQueueName is the name of the queue holding the events that
+	// handlers failed to process.
+	DeadLetterQueueName = "deadletters"
+
+	// DeadLetterReplayQueueName is the name of the queue holding the dead
+	// letters that operators asked to replay.
+	DeadLetterReplayQueueName = "deadletterReplays"
+)
+
+// GetOrganization returns the organization the entity is associated with.
+func (d *DeadLetter) GetOrganization() string {
+	return d.Event.Entity.GetOrganization()
+}
+
+// GetEnvironment returns the environment the entity is associated with.
+func (d *DeadLetter) GetEnvironment() string {
+	return d.Event.Entity.GetEnvironment()
+}
+
+// Validate returns an error if the dead letter does not pass validation tests.
+func (d *DeadLetter) Validate() error {
+	if d.Name == "" {
+		return errors.New("name must not be empty")
+	}
+
+	if d.Handler == "" {
+		return errors.New("handler must not be empty")
+	}
+
+	if d.Event.Entity == nil {
+		return errors.New("event must contain an entity")
+	}
+
+	return nil
+}
+
+// FixtureDeadLetter returns a testing fixture for a DeadLetter object.
+func FixtureDeadLetter(name string, handler string) *DeadLetter {
+	event := FixtureEvent("agent", "check")
+
+	return &DeadLetter{
+		Name:      name,
+		Handler:   handler,
+		Attempts:  3,
+		Message:   "pipe handler execution returned non-zero exit status 1",
+		Event:     *event,
+		Timestamp: time.Now().Unix(),
+	}
+}
@@ -0,0 +1,652 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dead_letter.proto
+
+package types
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// DeadLetter is an event that a handler failed to process, even after retrying
+// according to its retry policy.
+type DeadLetter struct {
+	// Name is the unique identifier for a dead letter.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Handler is the name of the handler that failed to process the event.
+	Handler string `protobuf:"bytes,2,opt,name=handler,proto3" json:"handler,omitempty"`
+	// Attempts is the number of times the handler was executed.
+	Attempts uint32 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Message is the details of the last failure.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Timestamp refers to the instant in-which the last failure occurred.
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Event is the event that the handler failed to process.
+	Event Event `protobuf:"bytes,6,opt,name=event" json:"event"`
+}
+
+func (m *DeadLetter) Reset()                    { *m = DeadLetter{} }
+func (m *DeadLetter) String() string            { return proto.CompactTextString(m) }
+func (*DeadLetter) ProtoMessage()               {}
+func (*DeadLetter) Descriptor() ([]byte, []int) { return fileDescriptorDeadLetter, []int{0} }
+
+func (m *DeadLetter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetHandler() string {
+	if m != nil {
+		return m.Handler
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetEvent() Event {
+	if m != nil {
+		return m.Event
+	}
+	return Event{}
+}
+
+func init() {
+	proto.RegisterType((*DeadLetter)(nil), "sensu.types.DeadLetter")
+}
+func (this *DeadLetter) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*DeadLetter)
+	if !ok {
+		that2, ok := that.(DeadLetter)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Handler != that1.Handler {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	if this.Message != that1.Message {
+		return false
+	}
+	if this.Timestamp != that1.Timestamp {
+		return false
+	}
+	if !this.Event.Equal(&that1.Event) {
+		return false
+	}
+	return true
+}
+func (m *DeadLetter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeadLetter) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintDeadLetter(dAtA, i, uint64(len(m.Name)))
+		i += copy(dAtA[i:], m.Name)
+	}
+	if len(m.Handler) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintDeadLetter(dAtA, i, uint64(len(m.Handler)))
+		i += copy(dAtA[i:], m.Handler)
+	}
+	if m.Attempts != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintDeadLetter(dAtA, i, uint64(m.Attempts))
+	}
+	if len(m.Message) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintDeadLetter(dAtA, i, uint64(len(m.Message)))
+		i += copy(dAtA[i:], m.Message)
+	}
+	if m.Timestamp != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintDeadLetter(dAtA, i, uint64(m.Timestamp))
+	}
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintDeadLetter(dAtA, i, uint64(m.Event.Size()))
+	n1, err := m.Event.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	return i, nil
+}
+
+func encodeVarintDeadLetter(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedDeadLetter(r randyDeadLetter, easy bool) *DeadLetter {
+	this := &DeadLetter{}
+	this.Name = string(randStringDeadLetter(r))
+	this.Handler = string(randStringDeadLetter(r))
+	this.Attempts = uint32(r.Uint32())
+	this.Message = string(randStringDeadLetter(r))
+	this.Timestamp = int64(r.Int63())
+	if r.Intn(2) == 0 {
+		this.Timestamp *= -1
+	}
+	v1 := NewPopulatedEvent(r, easy)
+	this.Event = *v1
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyDeadLetter interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneDeadLetter(r randyDeadLetter) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringDeadLetter(r randyDeadLetter) string {
+	v2 := r.Intn(100)
+	tmps := make([]rune, v2)
+	for i := 0; i < v2; i++ {
+		tmps[i] = randUTF8RuneDeadLetter(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedDeadLetter(r randyDeadLetter, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldDeadLetter(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldDeadLetter(dAtA []byte, r randyDeadLetter, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateDeadLetter(dAtA, uint64(key))
+		v3 := r.Int63()
+		if r.Intn(2) == 0 {
+			v3 *= -1
+		}
+		dAtA = encodeVarintPopulateDeadLetter(dAtA, uint64(v3))
+	case 1:
+		dAtA = encodeVarintPopulateDeadLetter(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateDeadLetter(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateDeadLetter(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateDeadLetter(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateDeadLetter(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *DeadLetter) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDeadLetter(uint64(l))
+	}
+	l = len(m.Handler)
+	if l > 0 {
+		n += 1 + l + sovDeadLetter(uint64(l))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovDeadLetter(uint64(m.Attempts))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovDeadLetter(uint64(l))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovDeadLetter(uint64(m.Timestamp))
+	}
+	l = m.Event.Size()
+	n += 1 + l + sovDeadLetter(uint64(l))
+	return n
+}
+
+func sovDeadLetter(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozDeadLetter(x uint64) (n int) {
+	return sovDeadLetter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DeadLetter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeadLetter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeadLetter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeadLetter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handler", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handler = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeadLetter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthDeadLetter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDeadLetter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDeadLetter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeadLetter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthDeadLetter
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowDeadLetter
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipDeadLetter(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthDeadLetter = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDeadLetter   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("dead_letter.proto", fileDescriptorDeadLetter) }
+
+var fileDescriptorDeadLetter = []byte{
+	// 262 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x8f, 0x3d, 0x4e, 0xc3, 0x40,
+	0x10, 0x85, 0x33, 0xc4, 0x0e, 0x64, 0x2d, 0x0a, 0xb6, 0x5a, 0x59, 0x68, 0xb1, 0xa0, 0x71, 0xc3,
+	0x46, 0x82, 0x1b, 0x44, 0xd0, 0x51, 0xb9, 0xa4, 0x41, 0x6b, 0x3c, 0x38, 0x91, 0xb2, 0xb6, 0xe5,
+	0x1d, 0x23, 0x71, 0x13, 0x8e, 0xc0, 0x11, 0xa8, 0xa8, 0x53, 0x72, 0x02, 0x04, 0xe6, 0x12, 0x94,
+	0x28, 0x63, 0x7e, 0xd2, 0xcd, 0x37, 0xfb, 0xbe, 0xd5, 0x3c, 0x71, 0x50, 0xa0, 0x2d, 0x6e, 0x56,
+	0x48, 0x84, 0xad, 0x69, 0xda, 0x9a, 0x6a, 0x19, 0x79, 0xac, 0x7c, 0x67, 0xe8, 0xa1, 0x41, 0x1f,
+	0x9f, 0x96, 0x4b, 0x5a, 0x74, 0xb9, 0xb9, 0xad, 0xdd, 0xac, 0xac, 0xcb, 0x7a, 0xc6, 0x99, 0xbc,
+	0xbb, 0x63, 0x62, 0xe0, 0x69, 0x70, 0xe3, 0x08, 0xef, 0xb1, 0xa2, 0x01, 0x8e, 0x5f, 0x40, 0x88,
+	0x0b, 0xb4, 0xc5, 0x15, 0xff, 0x2e, 0xa5, 0x08, 0x2a, 0xeb, 0x50, 0x41, 0x02, 0xe9, 0x34, 0xe3,
+	0x59, 0x2a, 0xb1, 0xbb, 0xb0, 0x55, 0xb1, 0xc2, 0x56, 0xed, 0xf0, 0xfa, 0x17, 0x65, 0x2c, 0xf6,
+	0x2c, 0x11, 0xba, 0x86, 0xbc, 0x1a, 0x27, 0x90, 0xee, 0x67, 0x7f, 0xbc, 0xb1, 0x1c, 0x7a, 0x6f,
+	0x4b, 0x54, 0xc1, 0x60, 0xfd, 0xa0, 0x3c, 0x14, 0x53, 0x5a, 0x3a, 0xf4, 0x64, 0x5d, 0xa3, 0xc2,
+	0x04, 0xd2, 0x71, 0xf6, 0xbf, 0x90, 0x46, 0x84, 0x7c, 0x9f, 0x9a, 0x24, 0x90, 0x46, 0x67, 0xd2,
+	0x6c, 0x35, 0x35, 0x97, 0x9b, 0x97, 0x79, 0xb0, 0x7e, 0x3b, 0x1a, 0x65, 0x43, 0x6c, 0x7e, 0xf2,
+	0xf5, 0xa1, 0xe1, 0xa9, 0xd7, 0xf0, 0xdc, 0x6b, 0x58, 0xf7, 0x1a, 0x5e, 0x7b, 0x0d, 0xef, 0xbd,
+	0x86, 0xc7, 0x4f, 0x3d, 0xba, 0x0e, 0xd9, 0xcb, 0x27, 0x5c, 0xf6, 0xfc, 0x7b, 0x00, 0x80, 0x65,
+	0xd8, 0xe9, 0x4a, 0x01, 0x00, 0x00,
+}
@@ -14,8 +14,21 @@ const (
 
 	// EntityProxyClass is the name of the class given to proxy entities.
 	EntityProxyClass = "proxy"
+
+	// EntityBackendClass is the name of the class given to backend entities.
+	EntityBackendClass = "backend"
+
+	// SubscriptionsUpdateType is the message type of the subscriptions updates
+	// pushed to the agents.
+	SubscriptionsUpdateType = "subscriptions_update"
 )
 
+// A SubscriptionsUpdate replaces the subscriptions of a connected agent.
+type SubscriptionsUpdate struct {
+	// Subscriptions are the new subscriptions of the agent
+	Subscriptions []string `json:"subscriptions"`
+}
+
 // Validate returns an error if the entity is invalid.
 func (e *Entity) Validate() error {
 	if err := ValidateName(e.ID); err != nil {
@@ -35,6 +35,9 @@ type Entity struct {
 	ExtendedAttributes []byte `protobuf:"bytes,12,opt,name=extended_attributes,json=extendedAttributes,proto3" json:"-"`
 	// Redact contains the fields to redact on the agent
 	Redact []string `protobuf:"bytes,13,rep,name=redact" json:"redact,omitempty"`
+	// ManagedSubscriptions is true once the subscriptions were set through the
+	// API, after which the backend no longer takes them from the keepalives
+	ManagedSubscriptions bool `protobuf:"varint,14,opt,name=managed_subscriptions,json=managedSubscriptions,proto3" json:"managed_subscriptions,omitempty"`
 }
 
 func (m *Entity) Reset()                    { *m = Entity{} }
@@ -133,6 +136,13 @@ func (m *Entity) GetRedact() []string {
 	return nil
 }
 
+func (m *Entity) GetManagedSubscriptions() bool {
+	if m != nil {
+		return m.ManagedSubscriptions
+	}
+	return false
+}
+
 // System contains information about the system that the Agent process
 // is running on, used for additional Entity context.
 type System struct {
@@ -143,6 +153,17 @@ type System struct {
 	PlatformVersion string  `protobuf:"bytes,5,opt,name=platform_version,json=platformVersion,proto3" json:"platform_version,omitempty"`
 	Network         Network `protobuf:"bytes,6,opt,name=network" json:"network"`
 	Arch            string  `protobuf:"bytes,7,opt,name=arch,proto3" json:"arch,omitempty"`
+	// CPUs is the number of logical CPUs, collected by the cpu collector.
+	CPUs uint32 `protobuf:"varint,8,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	// Memory is the total amount of memory, in bytes, collected by the memory
+	// collector.
+	Memory uint64 `protobuf:"varint,9,opt,name=memory,proto3" json:"memory,omitempty"`
+	// Uptime is the number of seconds since boot, collected by the uptime
+	// collector.
+	Uptime uint64 `protobuf:"varint,10,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// KernelVersion is the version of the OS kernel, collected by the kernel
+	// collector.
+	KernelVersion string `protobuf:"bytes,11,opt,name=kernel_version,json=kernelVersion,proto3" json:"kernel_version,omitempty"`
 }
 
 func (m *System) Reset()                    { *m = System{} }
@@ -199,6 +220,34 @@ func (m *System) GetArch() string {
 	return ""
 }
 
+func (m *System) GetCPUs() uint32 {
+	if m != nil {
+		return m.CPUs
+	}
+	return 0
+}
+
+func (m *System) GetMemory() uint64 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *System) GetUptime() uint64 {
+	if m != nil {
+		return m.Uptime
+	}
+	return 0
+}
+
+func (m *System) GetKernelVersion() string {
+	if m != nil {
+		return m.KernelVersion
+	}
+	return ""
+}
+
 // Network contains information about the system network interfaces
 // that the Agent process is running on, used for additional Entity
 // context.
@@ -350,6 +399,9 @@ func (this *Entity) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.ManagedSubscriptions != that1.ManagedSubscriptions {
+		return false
+	}
 	return true
 }
 func (this *System) Equal(that interface{}) bool {
@@ -398,6 +450,18 @@ func (this *System) Equal(that interface{}) bool {
 	if this.Arch != that1.Arch {
 		return false
 	}
+	if this.CPUs != that1.CPUs {
+		return false
+	}
+	if this.Memory != that1.Memory {
+		return false
+	}
+	if this.Uptime != that1.Uptime {
+		return false
+	}
+	if this.KernelVersion != that1.KernelVersion {
+		return false
+	}
 	return true
 }
 func (this *Network) Equal(that interface{}) bool {
@@ -623,6 +687,16 @@ func (m *Entity) MarshalTo(dAtA []byte) (int, error) {
 			i += copy(dAtA[i:], s)
 		}
 	}
+	if m.ManagedSubscriptions {
+		dAtA[i] = 0x70
+		i++
+		if m.ManagedSubscriptions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -685,6 +759,27 @@ func (m *System) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintEntity(dAtA, i, uint64(len(m.Arch)))
 		i += copy(dAtA[i:], m.Arch)
 	}
+	if m.CPUs != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(m.CPUs))
+	}
+	if m.Memory != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(m.Memory))
+	}
+	if m.Uptime != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(m.Uptime))
+	}
+	if len(m.KernelVersion) > 0 {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintEntity(dAtA, i, uint64(len(m.KernelVersion)))
+		i += copy(dAtA[i:], m.KernelVersion)
+	}
 	return i, nil
 }
 
@@ -828,6 +923,7 @@ func NewPopulatedEntity(r randyEntity, easy bool) *Entity {
 	for i := 0; i < v5; i++ {
 		this.Redact[i] = string(randStringEntity(r))
 	}
+	this.ManagedSubscriptions = bool(bool(r.Intn(2) == 0))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -843,6 +939,10 @@ func NewPopulatedSystem(r randyEntity, easy bool) *System {
 	v6 := NewPopulatedNetwork(r, easy)
 	this.Network = *v6
 	this.Arch = string(randStringEntity(r))
+	this.CPUs = uint32(r.Uint32())
+	this.Memory = uint64(uint64(r.Uint32()))
+	this.Uptime = uint64(uint64(r.Uint32()))
+	this.KernelVersion = string(randStringEntity(r))
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -1009,6 +1109,9 @@ func (m *Entity) Size() (n int) {
 			n += 1 + l + sovEntity(uint64(l))
 		}
 	}
+	if m.ManagedSubscriptions {
+		n += 2
+	}
 	return n
 }
 
@@ -1041,6 +1144,19 @@ func (m *System) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEntity(uint64(l))
 	}
+	if m.CPUs != 0 {
+		n += 1 + sovEntity(uint64(m.CPUs))
+	}
+	if m.Memory != 0 {
+		n += 1 + sovEntity(uint64(m.Memory))
+	}
+	if m.Uptime != 0 {
+		n += 1 + sovEntity(uint64(m.Uptime))
+	}
+	l = len(m.KernelVersion)
+	if l > 0 {
+		n += 1 + l + sovEntity(uint64(l))
+	}
 	return n
 }
 
@@ -1480,6 +1596,26 @@ func (m *Entity) Unmarshal(dAtA []byte) error {
 			}
 			m.Redact = append(m.Redact, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManagedSubscriptions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ManagedSubscriptions = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEntity(dAtA[iNdEx:])
@@ -1734,6 +1870,92 @@ func (m *System) Unmarshal(dAtA []byte) error {
 			}
 			m.Arch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUs", wireType)
+			}
+			m.CPUs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPUs |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			m.Memory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Memory |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
+			}
+			m.Uptime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Uptime |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KernelVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEntity
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEntity
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KernelVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEntity(dAtA[iNdEx:])
@@ -2160,46 +2382,51 @@ var (
 func init() { proto.RegisterFile("entity.proto", fileDescriptorEntity) }
 
 var fileDescriptorEntity = []byte{
-	// 656 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x4d, 0x6e, 0x13, 0x4b,
-	0x10, 0x4e, 0xfb, 0xdf, 0x65, 0x3b, 0x2f, 0xe9, 0x44, 0x51, 0xbf, 0x44, 0x6f, 0x3c, 0xf2, 0x5b,
-	0x30, 0x10, 0xc5, 0x11, 0x01, 0xc1, 0x3a, 0x26, 0x20, 0x65, 0x01, 0x88, 0x0e, 0x62, 0x81, 0x90,
-	0xac, 0xb6, 0xa7, 0x6c, 0x8f, 0x62, 0xf7, 0x58, 0xdd, 0xed, 0x80, 0xd9, 0x71, 0x0b, 0x8e, 0xc0,
-	0x11, 0x38, 0x42, 0x96, 0x9c, 0xc0, 0x02, 0xb3, 0xf3, 0x01, 0x10, 0x4b, 0x34, 0x3d, 0x63, 0xc7,
-	0x13, 0xb1, 0xfb, 0xbe, 0xaf, 0xbe, 0xaa, 0x2e, 0x57, 0x95, 0x07, 0xaa, 0x28, 0x4d, 0x60, 0xa6,
-	0xcd, 0xb1, 0x0a, 0x4d, 0x48, 0x2b, 0x1a, 0xa5, 0x9e, 0x34, 0xcd, 0x74, 0x8c, 0x7a, 0xff, 0xa8,
-	0x1f, 0x98, 0xc1, 0xa4, 0xd3, 0xec, 0x86, 0xa3, 0xe3, 0x7e, 0xd8, 0x0f, 0x8f, 0xad, 0xa7, 0x33,
-	0xe9, 0x59, 0x66, 0x89, 0x45, 0x71, 0x6e, 0xe3, 0x53, 0x0e, 0x0a, 0x4f, 0x6d, 0x31, 0xba, 0x07,
-	0x99, 0xc0, 0x67, 0xc4, 0x25, 0x5e, 0xb9, 0x55, 0x98, 0xcf, 0xea, 0x99, 0xf3, 0x33, 0x9e, 0x09,
-	0x7c, 0xba, 0x0b, 0xf9, 0xee, 0x50, 0x68, 0xcd, 0x32, 0x51, 0x88, 0xc7, 0x84, 0xde, 0x87, 0x82,
-	0x9e, 0x6a, 0x83, 0x23, 0x96, 0x75, 0x89, 0x57, 0x39, 0xd9, 0x69, 0xae, 0x75, 0xd1, 0xbc, 0xb0,
-	0xa1, 0x56, 0xee, 0x7a, 0x56, 0xdf, 0xe0, 0x89, 0x91, 0x3e, 0x86, 0x9a, 0x9e, 0x74, 0x74, 0x57,
-	0x05, 0x63, 0x13, 0x84, 0x52, 0xb3, 0x9c, 0x9b, 0xf5, 0xca, 0xad, 0xed, 0xc5, 0xac, 0x9e, 0x0e,
-	0xf0, 0x34, 0xa5, 0x07, 0x50, 0x1e, 0x0a, 0x6d, 0xda, 0x1a, 0x51, 0xb2, 0xbc, 0x4b, 0xbc, 0x2c,
-	0x2f, 0x45, 0xc2, 0x05, 0xa2, 0xa4, 0x0e, 0x80, 0x8f, 0x0a, 0xfb, 0x81, 0x36, 0xa8, 0x58, 0xc1,
-	0x25, 0x5e, 0x89, 0xaf, 0x29, 0xf4, 0x1c, 0x36, 0x97, 0x4c, 0x89, 0xa8, 0x1e, 0x2b, 0xda, 0x86,
-	0x0f, 0x52, 0x0d, 0x9f, 0xa5, 0x2c, 0x49, 0xe3, 0xb7, 0x12, 0xe9, 0x21, 0x6c, 0x5f, 0x22, 0x8e,
-	0xc5, 0x30, 0xb8, 0xc2, 0xb6, 0x09, 0x46, 0x18, 0x4e, 0x0c, 0x2b, 0xb9, 0xc4, 0xab, 0xf1, 0xad,
-	0x55, 0xe0, 0x75, 0xac, 0x53, 0x17, 0x2a, 0x28, 0xaf, 0x02, 0x15, 0xca, 0x11, 0x4a, 0xc3, 0xca,
-	0x76, 0x78, 0xeb, 0x12, 0x6d, 0x40, 0x35, 0x54, 0x7d, 0x21, 0x83, 0x8f, 0x71, 0x5f, 0x60, 0x2d,
-	0x29, 0x8d, 0x52, 0xc8, 0x4d, 0x34, 0x2a, 0x56, 0xb1, 0x31, 0x8b, 0xe9, 0x23, 0xd8, 0xc1, 0x0f,
-	0x06, 0xa5, 0x8f, 0x7e, 0x5b, 0x18, 0xa3, 0x82, 0xce, 0xc4, 0xa0, 0x66, 0x55, 0x97, 0x78, 0xd5,
-	0x56, 0x7e, 0x31, 0xab, 0x93, 0x23, 0x4e, 0x97, 0x8e, 0xd3, 0x95, 0x81, 0xee, 0x41, 0x41, 0xa1,
-	0x2f, 0xba, 0x86, 0xd5, 0xa2, 0xc1, 0xf3, 0x84, 0x35, 0x7e, 0x11, 0x28, 0xc4, 0x0b, 0xa3, 0xfb,
-	0x50, 0x1a, 0x84, 0xda, 0x48, 0x31, 0xc2, 0xf8, 0x12, 0xf8, 0x8a, 0x47, 0xf7, 0x11, 0x26, 0x47,
-	0x10, 0xdf, 0xc7, 0xcb, 0x0b, 0x9e, 0x09, 0x75, 0x94, 0x33, 0x1e, 0x0a, 0xd3, 0x0b, 0x55, 0x7c,
-	0x0b, 0x65, 0xbe, 0xe2, 0xf4, 0x0e, 0xfc, 0xb3, 0xc4, 0xed, 0x9e, 0x18, 0x05, 0xc3, 0x29, 0xcb,
-	0x59, 0xcb, 0xe6, 0x52, 0x7e, 0x66, 0x55, 0x7a, 0x17, 0xb6, 0x56, 0xc6, 0x2b, 0x54, 0x3a, 0x9a,
-	0x47, 0xde, 0x3a, 0x57, 0x05, 0xde, 0xc4, 0x32, 0x7d, 0x08, 0x45, 0x89, 0xe6, 0x7d, 0xa8, 0x2e,
-	0xed, 0xb6, 0x2b, 0x27, 0xbb, 0xa9, 0x4d, 0xbe, 0x88, 0x63, 0xc9, 0x0a, 0x97, 0xd6, 0x68, 0x90,
-	0x42, 0x75, 0x07, 0x76, 0xf9, 0x65, 0x6e, 0x71, 0xe3, 0x1d, 0x14, 0x13, 0x37, 0x7d, 0x05, 0x10,
-	0x48, 0x83, 0xaa, 0x27, 0xba, 0xa8, 0x19, 0x71, 0xb3, 0x5e, 0xe5, 0xe4, 0xbf, 0xbf, 0xd5, 0x3d,
-	0x5f, 0xba, 0x5a, 0x34, 0x7a, 0x60, 0x31, 0xab, 0xaf, 0x25, 0xf2, 0x35, 0xdc, 0x90, 0xb0, 0x75,
-	0x3b, 0x27, 0xea, 0x62, 0x6d, 0xb6, 0x16, 0xd3, 0x7f, 0x21, 0x3b, 0x12, 0xdd, 0x64, 0xb0, 0xc5,
-	0xf9, 0xac, 0x9e, 0x7d, 0x7e, 0xfa, 0x84, 0x47, 0x1a, 0x3d, 0x84, 0xb2, 0xf0, 0x7d, 0x85, 0x5a,
-	0xa3, 0x66, 0x59, 0xfb, 0x6f, 0xa9, 0x2d, 0x66, 0xf5, 0x1b, 0x91, 0xdf, 0xc0, 0xc6, 0x3d, 0xd8,
-	0x4c, 0x5f, 0x31, 0x65, 0x50, 0x1c, 0x08, 0xe9, 0x0f, 0x51, 0x25, 0x0f, 0x2e, 0x69, 0xeb, 0xff,
-	0xdf, 0x3f, 0x1c, 0xf2, 0x65, 0xee, 0x90, 0xaf, 0x73, 0x87, 0x5c, 0xcf, 0x1d, 0xf2, 0x6d, 0xee,
-	0x90, 0xef, 0x73, 0x87, 0x7c, 0xfe, 0xe9, 0x6c, 0xbc, 0xcd, 0xdb, 0x5f, 0xdc, 0x29, 0xd8, 0x4f,
-	0xc4, 0x83, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x10, 0xf5, 0x07, 0xd9, 0x6e, 0x04, 0x00, 0x00,
+	// 734 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xdd, 0x8e, 0xdb, 0x44,
+	0x14, 0xae, 0x13, 0x6f, 0x12, 0x9f, 0x6c, 0xc2, 0x76, 0xba, 0x54, 0x43, 0x0b, 0xb6, 0x15, 0x84,
+	0x30, 0x54, 0x4d, 0xc5, 0x16, 0xc1, 0x75, 0xd3, 0x82, 0xb4, 0x17, 0xfc, 0xcd, 0x02, 0x17, 0x08,
+	0x29, 0x9a, 0xd8, 0x67, 0xb3, 0xd6, 0xc6, 0x33, 0xd1, 0xcc, 0x78, 0x21, 0x3c, 0x09, 0x8f, 0xc0,
+	0x13, 0x20, 0x1e, 0xa1, 0x97, 0x3c, 0x81, 0x05, 0xe6, 0x2e, 0xbc, 0x00, 0x97, 0xc8, 0x63, 0x27,
+	0x8d, 0x57, 0xbd, 0x3b, 0xdf, 0x77, 0xbe, 0x33, 0x39, 0xe7, 0x7c, 0xc7, 0x81, 0x63, 0x14, 0x26,
+	0x35, 0x9b, 0xe9, 0x5a, 0x49, 0x23, 0xc9, 0x50, 0xa3, 0xd0, 0xf9, 0xd4, 0x6c, 0xd6, 0xa8, 0x1f,
+	0x3c, 0x5e, 0xa6, 0xe6, 0x2a, 0x5f, 0x4c, 0x63, 0x99, 0x3d, 0x59, 0xca, 0xa5, 0x7c, 0x62, 0x35,
+	0x8b, 0xfc, 0xd2, 0x22, 0x0b, 0x6c, 0x54, 0xd7, 0x4e, 0x7e, 0x77, 0xa1, 0xf7, 0x99, 0x7d, 0x8c,
+	0xdc, 0x87, 0x4e, 0x9a, 0x50, 0x27, 0x74, 0x22, 0x6f, 0xd6, 0x2b, 0x8b, 0xa0, 0x73, 0xfe, 0x82,
+	0x75, 0xd2, 0x84, 0x9c, 0xc2, 0x51, 0xbc, 0xe2, 0x5a, 0xd3, 0x4e, 0x95, 0x62, 0x35, 0x20, 0x1f,
+	0x41, 0x4f, 0x6f, 0xb4, 0xc1, 0x8c, 0x76, 0x43, 0x27, 0x1a, 0x9e, 0xdd, 0x9b, 0x1e, 0x74, 0x31,
+	0xbd, 0xb0, 0xa9, 0x99, 0xfb, 0xb2, 0x08, 0xee, 0xb0, 0x46, 0x48, 0x3e, 0x85, 0x91, 0xce, 0x17,
+	0x3a, 0x56, 0xe9, 0xda, 0xa4, 0x52, 0x68, 0xea, 0x86, 0xdd, 0xc8, 0x9b, 0xdd, 0xdd, 0x16, 0x41,
+	0x3b, 0xc1, 0xda, 0x90, 0x3c, 0x04, 0x6f, 0xc5, 0xb5, 0x99, 0x6b, 0x44, 0x41, 0x8f, 0x42, 0x27,
+	0xea, 0xb2, 0x41, 0x45, 0x5c, 0x20, 0x0a, 0xe2, 0x03, 0x24, 0xa8, 0x70, 0x99, 0x6a, 0x83, 0x8a,
+	0xf6, 0x42, 0x27, 0x1a, 0xb0, 0x03, 0x86, 0x9c, 0xc3, 0x78, 0x87, 0x14, 0xaf, 0xde, 0xa3, 0x7d,
+	0xdb, 0xf0, 0xc3, 0x56, 0xc3, 0x2f, 0x5a, 0x92, 0xa6, 0xf1, 0x5b, 0x85, 0xe4, 0x11, 0xdc, 0xbd,
+	0x46, 0x5c, 0xf3, 0x55, 0x7a, 0x83, 0x73, 0x93, 0x66, 0x28, 0x73, 0x43, 0x07, 0xa1, 0x13, 0x8d,
+	0xd8, 0xc9, 0x3e, 0xf1, 0x6d, 0xcd, 0x93, 0x10, 0x86, 0x28, 0x6e, 0x52, 0x25, 0x45, 0x86, 0xc2,
+	0x50, 0xcf, 0x2e, 0xef, 0x90, 0x22, 0x13, 0x38, 0x96, 0x6a, 0xc9, 0x45, 0xfa, 0x4b, 0xdd, 0x17,
+	0x58, 0x49, 0x8b, 0x23, 0x04, 0xdc, 0x5c, 0xa3, 0xa2, 0x43, 0x9b, 0xb3, 0x31, 0xf9, 0x04, 0xee,
+	0xe1, 0xcf, 0x06, 0x45, 0x82, 0xc9, 0x9c, 0x1b, 0xa3, 0xd2, 0x45, 0x6e, 0x50, 0xd3, 0xe3, 0xd0,
+	0x89, 0x8e, 0x67, 0x47, 0xdb, 0x22, 0x70, 0x1e, 0x33, 0xb2, 0x53, 0x3c, 0xdb, 0x0b, 0xc8, 0x7d,
+	0xe8, 0x29, 0x4c, 0x78, 0x6c, 0xe8, 0xa8, 0x5a, 0x3c, 0x6b, 0x10, 0x79, 0x0a, 0x6f, 0x66, 0x5c,
+	0xf0, 0x25, 0x26, 0xf3, 0xb6, 0x3f, 0x63, 0xbb, 0xcc, 0xd3, 0x26, 0x79, 0x71, 0x98, 0x9b, 0xfc,
+	0xdb, 0x81, 0x5e, 0xed, 0x32, 0x79, 0x00, 0x83, 0x2b, 0xa9, 0x8d, 0xe0, 0x19, 0xd6, 0xe7, 0xc3,
+	0xf6, 0xb8, 0x3a, 0x2a, 0xd9, 0x5c, 0x4e, 0x7d, 0x54, 0x5f, 0x5d, 0xb0, 0x8e, 0xd4, 0x55, 0xcd,
+	0x7a, 0xc5, 0xcd, 0xa5, 0x54, 0xf5, 0x01, 0x79, 0x6c, 0x8f, 0xc9, 0xfb, 0xf0, 0xc6, 0x2e, 0x9e,
+	0x5f, 0xf2, 0x2c, 0x5d, 0x6d, 0xa8, 0x6b, 0x25, 0xe3, 0x1d, 0xfd, 0xb9, 0x65, 0xc9, 0x07, 0x70,
+	0xb2, 0x17, 0xde, 0xa0, 0xd2, 0xa9, 0xac, 0xcf, 0xc3, 0x63, 0xfb, 0x07, 0xbe, 0xaf, 0x69, 0xf2,
+	0x31, 0xf4, 0x05, 0x9a, 0x9f, 0xa4, 0xba, 0xb6, 0x27, 0x32, 0x3c, 0x3b, 0x6d, 0xd9, 0xff, 0x65,
+	0x9d, 0x6b, 0x7c, 0xdf, 0x49, 0xab, 0xed, 0x73, 0x15, 0x5f, 0xd9, 0x8b, 0xf1, 0x98, 0x8d, 0xc9,
+	0xdb, 0xe0, 0xc6, 0xeb, 0x5c, 0xd7, 0xbe, 0xcf, 0x06, 0x65, 0x11, 0xb8, 0xcf, 0xbf, 0xfe, 0x4e,
+	0x33, 0xcb, 0x56, 0x3b, 0xce, 0x30, 0x93, 0x6a, 0x63, 0x0d, 0x77, 0x59, 0x83, 0x2a, 0x3e, 0x5f,
+	0x57, 0x27, 0x63, 0x5d, 0x76, 0x59, 0x83, 0xc8, 0x7b, 0x30, 0xbe, 0x46, 0x25, 0x70, 0xb5, 0x1f,
+	0xa0, 0x76, 0x7a, 0x54, 0xb3, 0x4d, 0xfb, 0x93, 0x1f, 0xa1, 0xdf, 0xb4, 0x48, 0xbe, 0x01, 0x48,
+	0x85, 0x41, 0x75, 0xc9, 0x63, 0xd4, 0xd4, 0x09, 0xbb, 0xd1, 0xf0, 0xec, 0x9d, 0xd7, 0x0d, 0x73,
+	0xbe, 0x53, 0xcd, 0x48, 0x35, 0xd5, 0xb6, 0x08, 0x0e, 0x0a, 0xd9, 0x41, 0x3c, 0x11, 0x70, 0x72,
+	0xbb, 0xa6, 0x1a, 0xfd, 0xc0, 0x50, 0x1b, 0x93, 0xb7, 0xa0, 0x9b, 0xf1, 0xb8, 0x71, 0xb3, 0x5f,
+	0x16, 0x41, 0xf7, 0x8b, 0x67, 0xcf, 0x59, 0xc5, 0x91, 0x47, 0xe0, 0xf1, 0x24, 0x51, 0xa8, 0x35,
+	0x6a, 0xda, 0xb5, 0xdf, 0xf5, 0x68, 0x5b, 0x04, 0xaf, 0x48, 0xf6, 0x2a, 0x9c, 0x7c, 0x08, 0xe3,
+	0xf6, 0xf7, 0x46, 0x28, 0xf4, 0xaf, 0xb8, 0x48, 0x56, 0xa8, 0x9a, 0x1f, 0xdc, 0xc1, 0xd9, 0xbb,
+	0xff, 0xfd, 0xed, 0x3b, 0xbf, 0x95, 0xbe, 0xf3, 0x47, 0xe9, 0x3b, 0x2f, 0x4b, 0xdf, 0xf9, 0xb3,
+	0xf4, 0x9d, 0xbf, 0x4a, 0xdf, 0xf9, 0xf5, 0x1f, 0xff, 0xce, 0x0f, 0x47, 0x76, 0xe2, 0x45, 0xcf,
+	0xfe, 0x99, 0x3d, 0xfd, 0x7f, 0x00, 0x8a, 0x74, 0xe0, 0x93, 0x18, 0x05, 0x00, 0x00,
 }
@@ -1,9 +1,23 @@
 package types
 
 import (
+	"errors"
 	"time"
 )
 
+// MetricsErrorCheck is the check name under which the errors about events
+// without a check, such as metrics events, are stored.
+const MetricsErrorCheck = "_metrics"
+
+// CheckName returns the name of the check of the event the error is about, or
+// MetricsErrorCheck if the event has no check.
+func (perr *Error) CheckName() string {
+	if !perr.Event.HasCheck() {
+		return MetricsErrorCheck
+	}
+	return perr.Event.Check.Name
+}
+
 // GetOrganization returns the organization the entity is associated with.
 func (perr *Error) GetOrganization() string {
 	return perr.Event.Entity.GetOrganization()
@@ -14,6 +28,19 @@ func (perr *Error) GetEnvironment() string {
 	return perr.Event.Entity.GetEnvironment()
 }
 
+// Validate returns an error if the error does not pass validation tests.
+func (perr *Error) Validate() error {
+	if perr.Name == "" {
+		return errors.New("name must not be empty")
+	}
+
+	if perr.Event.Entity == nil {
+		return errors.New("event must contain an entity")
+	}
+
+	return nil
+}
+
 // FixtureError returns a testing fixture for an Error object.
 func FixtureError(name string, message string) *Error {
 	event := FixtureEvent("agent", "check")
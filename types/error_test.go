@@ -11,3 +11,24 @@ func TestFixtureError(t *testing.T) {
 	assert.Equal(t, "handler", err.Name)
 	assert.Contains(t, err.Message, "handler failed")
 }
+
+func TestErrorValidate(t *testing.T) {
+	err := FixtureError("handler", "handler failed to execute")
+	assert.NoError(t, err.Validate())
+
+	err.Name = ""
+	assert.Error(t, err.Validate())
+
+	err = FixtureError("handler", "handler failed to execute")
+	err.Event.Entity = nil
+	assert.Error(t, err.Validate())
+}
+
+func TestErrorCheckName(t *testing.T) {
+	err := FixtureError("handler", "handler failed to execute")
+	assert.Equal(t, "check", err.CheckName())
+
+	err.Event.Check = nil
+	err.Event.Metrics = FixtureMetrics()
+	assert.Equal(t, MetricsErrorCheck, err.CheckName())
+}
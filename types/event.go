@@ -73,7 +73,7 @@ func (e *Event) UnmarshalJSON(b []byte) error {
 
 // Validate returns an error if the event does not pass validation tests.
 func (e *Event) Validate() error {
-	if e.Check == nil || e.Entity == nil {
+	if e.Entity == nil || (e.Check == nil && e.Metrics == nil) {
 		return errors.New("malformed event")
 	}
 
@@ -81,8 +81,16 @@ func (e *Event) Validate() error {
 		return errors.New("entity " + err.Error())
 	}
 
-	if err := e.Check.Validate(); err != nil {
-		return errors.New("check " + err.Error())
+	if e.HasCheck() {
+		if err := e.Check.Validate(); err != nil {
+			return errors.New("check " + err.Error())
+		}
+	}
+
+	if e.HasMetrics() {
+		if err := e.Metrics.Validate(); err != nil {
+			return errors.New("metrics " + err.Error())
+		}
 	}
 
 	for _, hook := range e.Hooks {
@@ -113,7 +121,8 @@ func (e *Event) IsIncident() bool {
 func (e *Event) IsResolution() bool {
 	// Try to retrieve the previous status in the check history and verify if it
 	// was a non-zero status, therefore indicating a resolution
-	isResolution := (len(e.Check.History) > 0 &&
+	isResolution := (e.HasCheck() &&
+		len(e.Check.History) > 0 &&
 		e.Check.History[len(e.Check.History)-1].Status != 0 &&
 		!e.IsIncident())
 
@@ -122,7 +131,7 @@ func (e *Event) IsResolution() bool {
 
 // IsSilenced determines if an event has any silenced entries
 func (e *Event) IsSilenced() bool {
-	return len(e.Check.Silenced) > 0
+	return e.HasCheck() && len(e.Check.Silenced) > 0
 }
 
 // Get implements govaluate.Parameters
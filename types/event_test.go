@@ -36,6 +36,17 @@ func TestEventValidate(t *testing.T) {
 	assert.NoError(t, event.Validate())
 }
 
+func TestEventValidateMetrics(t *testing.T) {
+	event := &Event{Entity: FixtureEntity("entity")}
+	assert.Error(t, event.Validate())
+
+	event.Metrics = FixtureMetrics()
+	assert.NoError(t, event.Validate())
+
+	event.Entity = nil
+	assert.Error(t, event.Validate())
+}
+
 func TestMarshalJSON(t *testing.T) {
 	event := FixtureEvent("entity", "check")
 	_, err := json.Marshal(event)
@@ -4,6 +4,8 @@ import (
 	"errors"
 	fmt "fmt"
 	"net/url"
+
+	"github.com/sensu/sensu-go/types/dynamic"
 )
 
 const (
@@ -11,6 +13,14 @@ const (
 	// commands via STDIN
 	HandlerPipeType = "pipe"
 
+	// HandlerGRPCType represents handlers that send event data to a registered
+	// extension over gRPC
+	HandlerGRPCType = "grpc"
+
+	// HandlerHTTPType represents handlers that send event data to an HTTP
+	// endpoint, such as a webhook
+	HandlerHTTPType = "http"
+
 	// HandlerSetType represents handlers that groups event handlers, making it
 	// easy to manage groups of actions that should be executed for certain types
 	// of events.
@@ -23,6 +33,18 @@ const (
 	// HandlerUDPType represents handlers that send event data to a remote UDP
 	// socket
 	HandlerUDPType = "udp"
+
+	// OverflowPolicyDropNewest drops the events handled while the queue of the
+	// handler is full
+	OverflowPolicyDropNewest = "drop_newest"
+
+	// OverflowPolicyDropOldest drops the oldest queued events to make room for
+	// the events handled while the queue of the handler is full
+	OverflowPolicyDropOldest = "drop_oldest"
+
+	// OverflowPolicyBlock blocks the pipeline until the queue of the handler
+	// has room for the events handled
+	OverflowPolicyBlock = "block"
 )
 
 // Validate returns an error if the handler does not pass validation tests.
@@ -43,9 +65,146 @@ func (h *Handler) Validate() error {
 		return errors.New("organization must be set")
 	}
 
+	if h.Type == HandlerGRPCType && h.Extension == "" {
+		return errors.New("extension must be set for grpc handlers")
+	}
+
+	if h.Type == HandlerHTTPType {
+		if h.HTTP == nil {
+			return errors.New("http must be set for http handlers")
+		}
+		if err := h.HTTP.Validate(); err != nil {
+			return errors.New("handler http " + err.Error())
+		}
+	}
+
+	if h.Concurrency != nil {
+		if err := h.Concurrency.Validate(); err != nil {
+			return errors.New("handler concurrency " + err.Error())
+		}
+	}
+
+	if h.RetryPolicy != nil {
+		if err := h.RetryPolicy.Validate(); err != nil {
+			return errors.New("handler retry policy " + err.Error())
+		}
+	}
+
+	for _, assetName := range h.RuntimeAssets {
+		if err := ValidateAssetName(assetName); err != nil {
+			return fmt.Errorf("asset's %s", err)
+		}
+	}
+
+	return nil
+}
+
+// Validate returns an error if the HTTP configuration does not pass
+// validation tests.
+func (h *HandlerHTTP) Validate() error {
+	u, err := url.Parse(h.URL)
+	if err != nil {
+		return fmt.Errorf("url is invalid: %s", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.New("url scheme must be http or https")
+	}
+
+	if h.MaxStatus != 0 && h.MaxStatus < h.MinStatus {
+		return errors.New("max status must be greater than the min status")
+	}
+
+	return nil
+}
+
+// RedactHeaders returns a copy of the handler whose HTTP header values, which
+// may hold credentials, are redacted.
+func (h *Handler) RedactHeaders() *Handler {
+	redacted := *h
+	if h.HTTP != nil && len(h.HTTP.Headers) > 0 {
+		http := *h.HTTP
+		http.Headers = make(map[string]string, len(h.HTTP.Headers))
+		for name := range h.HTTP.Headers {
+			http.Headers[name] = dynamic.Redacted
+		}
+		redacted.HTTP = &http
+	}
+	return &redacted
+}
+
+// RestoreHeaders sets the redacted HTTP header values of the handler back to
+// the ones of the stored handler, so a redacted handler can be updated.
+func (h *Handler) RestoreHeaders(stored *Handler) {
+	if h.HTTP == nil || stored.HTTP == nil {
+		return
+	}
+	for name, value := range h.HTTP.Headers {
+		if storedValue, ok := stored.HTTP.Headers[name]; ok && value == dynamic.Redacted {
+			h.HTTP.Headers[name] = storedValue
+		}
+	}
+}
+
+// IsSuccessStatus returns true if the response status code of an HTTP handler
+// is in its expected range, 200 to 299 by default.
+func (h *HandlerHTTP) IsSuccessStatus(status int) bool {
+	min, max := h.MinStatus, h.MaxStatus
+	if min == 0 {
+		min = 200
+	}
+	if max == 0 {
+		max = 299
+	}
+
+	return status >= int(min) && status <= int(max)
+}
+
+// Validate returns an error if the concurrency settings do not pass
+// validation tests.
+func (c *HandlerConcurrency) Validate() error {
+	if c.Limit == 0 {
+		return errors.New("limit must be greater than 0")
+	}
+
+	switch c.OverflowPolicy {
+	case "", OverflowPolicyDropNewest, OverflowPolicyDropOldest, OverflowPolicyBlock:
+	default:
+		return fmt.Errorf("overflow policy must be one of %s, %s or %s",
+			OverflowPolicyDropNewest, OverflowPolicyDropOldest, OverflowPolicyBlock)
+	}
+
+	return nil
+}
+
+// Validate returns an error if the retry policy does not pass validation tests.
+func (p *HandlerRetryPolicy) Validate() error {
+	if p.MaxBackoff != 0 && p.MaxBackoff < p.InitialBackoff {
+		return errors.New("max backoff must be greater than the initial backoff")
+	}
+
 	return nil
 }
 
+// IsRetryableExitCode returns true if a pipe handler exiting with the given
+// status should be retried.
+func (p *HandlerRetryPolicy) IsRetryableExitCode(status int) bool {
+	if status == 0 {
+		return false
+	}
+
+	if len(p.RetryableExitCodes) == 0 {
+		return true
+	}
+
+	for _, code := range p.RetryableExitCodes {
+		if int(code) == status {
+			return true
+		}
+	}
+
+	return false
+}
+
 // FixtureHandler returns a Handler fixture for testing.
 func FixtureHandler(name string) *Handler {
 	return &Handler{
@@ -68,6 +227,24 @@ func FixtureSocketHandler(name string, proto string) *Handler {
 	return handler
 }
 
+// FixtureGRPCHandler returns a Handler fixture for testing.
+func FixtureGRPCHandler(name string, extension string) *Handler {
+	handler := FixtureHandler(name)
+	handler.Type = HandlerGRPCType
+	handler.Command = ""
+	handler.Extension = extension
+	return handler
+}
+
+// FixtureHTTPHandler returns a Handler fixture for testing.
+func FixtureHTTPHandler(name string, endpoint string) *Handler {
+	handler := FixtureHandler(name)
+	handler.Type = HandlerHTTPType
+	handler.Command = ""
+	handler.HTTP = &HandlerHTTP{URL: endpoint}
+	return handler
+}
+
 // FixtureSetHandler returns a Handler fixture for testing.
 func FixtureSetHandler(name string, handlers ...string) *Handler {
 	handler := FixtureHandler(name)
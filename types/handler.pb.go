@@ -39,6 +39,17 @@ type Handler struct {
 	Environment string `protobuf:"bytes,10,opt,name=environment,proto3" json:"environment,omitempty"`
 	// Organization indicates to which org a handler belongs to
 	Organization string `protobuf:"bytes,11,opt,name=organization,proto3" json:"organization,omitempty"`
+	// RetryPolicy describes how failed executions of the handler are retried.
+	RetryPolicy *HandlerRetryPolicy `protobuf:"bytes,12,opt,name=retry_policy,json=retryPolicy" json:"retry_policy,omitempty"`
+	// Extension is the name of the extension invoked by a grpc handler.
+	Extension string `protobuf:"bytes,13,opt,name=extension,proto3" json:"extension,omitempty"`
+	// HTTP contains configuration for an HTTP handler.
+	HTTP *HandlerHTTP `protobuf:"bytes,14,opt,name=http" json:"http,omitempty"`
+	// Concurrency limits the concurrent executions of the handler, which are
+	// queued when the limit is reached.
+	Concurrency *HandlerConcurrency `protobuf:"bytes,15,opt,name=concurrency" json:"concurrency,omitempty"`
+	// RuntimeAssets are a list of assets required to execute the handler.
+	RuntimeAssets []string `protobuf:"bytes,16,rep,name=runtime_assets,json=runtimeAssets" json:"runtime_assets"`
 }
 
 func (m *Handler) Reset()                    { *m = Handler{} }
@@ -123,6 +134,41 @@ func (m *Handler) GetOrganization() string {
 	return ""
 }
 
+func (m *Handler) GetRetryPolicy() *HandlerRetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *Handler) GetExtension() string {
+	if m != nil {
+		return m.Extension
+	}
+	return ""
+}
+
+func (m *Handler) GetHTTP() *HandlerHTTP {
+	if m != nil {
+		return m.HTTP
+	}
+	return nil
+}
+
+func (m *Handler) GetConcurrency() *HandlerConcurrency {
+	if m != nil {
+		return m.Concurrency
+	}
+	return nil
+}
+
+func (m *Handler) GetRuntimeAssets() []string {
+	if m != nil {
+		return m.RuntimeAssets
+	}
+	return nil
+}
+
 // HandlerSocket contains configuration for a TCP or UDP handler.
 type HandlerSocket struct {
 	// Host is the socket peer address.
@@ -150,9 +196,162 @@ func (m *HandlerSocket) GetPort() uint32 {
 	return 0
 }
 
+// HandlerHTTP contains configuration for an HTTP handler.
+type HandlerHTTP struct {
+	// URL is the address the event data is sent to.
+	URL string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Method is the HTTP method of the requests, POST by default.
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// Headers are the HTTP headers added to the requests.
+	Headers map[string]string `protobuf:"bytes,3,rep,name=headers" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// TLS contains the TLS options used for https URLs.
+	TLS *TLSOptions `protobuf:"bytes,4,opt,name=tls" json:"tls,omitempty"`
+	// MinStatus is the lowest response status code considered as a success,
+	// 200 by default.
+	MinStatus uint32 `protobuf:"varint,5,opt,name=min_status,json=minStatus,proto3" json:"min_status,omitempty"`
+	// MaxStatus is the highest response status code considered as a success,
+	// 299 by default.
+	MaxStatus uint32 `protobuf:"varint,6,opt,name=max_status,json=maxStatus,proto3" json:"max_status,omitempty"`
+}
+
+func (m *HandlerHTTP) Reset()                    { *m = HandlerHTTP{} }
+func (m *HandlerHTTP) String() string            { return proto.CompactTextString(m) }
+func (*HandlerHTTP) ProtoMessage()               {}
+func (*HandlerHTTP) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{2} }
+
+func (m *HandlerHTTP) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *HandlerHTTP) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *HandlerHTTP) GetHeaders() map[string]string {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+func (m *HandlerHTTP) GetTLS() *TLSOptions {
+	if m != nil {
+		return m.TLS
+	}
+	return nil
+}
+
+func (m *HandlerHTTP) GetMinStatus() uint32 {
+	if m != nil {
+		return m.MinStatus
+	}
+	return 0
+}
+
+func (m *HandlerHTTP) GetMaxStatus() uint32 {
+	if m != nil {
+		return m.MaxStatus
+	}
+	return 0
+}
+
+// HandlerConcurrency limits the concurrent executions of a handler.
+type HandlerConcurrency struct {
+	// Limit is the maximum number of concurrent executions of the handler.
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// QueueSize is the maximum number of events waiting for the handler, 100 by
+	// default.
+	QueueSize uint32 `protobuf:"varint,2,opt,name=queue_size,json=queueSize,proto3" json:"queue_size,omitempty"`
+	// OverflowPolicy determines what happens to the events handled when the
+	// queue is full: drop_newest (default), drop_oldest or block.
+	OverflowPolicy string `protobuf:"bytes,3,opt,name=overflow_policy,json=overflowPolicy,proto3" json:"overflow_policy,omitempty"`
+}
+
+func (m *HandlerConcurrency) Reset()                    { *m = HandlerConcurrency{} }
+func (m *HandlerConcurrency) String() string            { return proto.CompactTextString(m) }
+func (*HandlerConcurrency) ProtoMessage()               {}
+func (*HandlerConcurrency) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{3} }
+
+func (m *HandlerConcurrency) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *HandlerConcurrency) GetQueueSize() uint32 {
+	if m != nil {
+		return m.QueueSize
+	}
+	return 0
+}
+
+func (m *HandlerConcurrency) GetOverflowPolicy() string {
+	if m != nil {
+		return m.OverflowPolicy
+	}
+	return ""
+}
+
+// HandlerRetryPolicy describes how failed executions of a handler are retried.
+type HandlerRetryPolicy struct {
+	// MaxAttempts is the maximum number of times the handler is executed for an
+	// event, including the first execution.
+	MaxAttempts uint32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// InitialBackoff is the delay, in seconds, before the first retry.
+	InitialBackoff uint32 `protobuf:"varint,2,opt,name=initial_backoff,json=initialBackoff,proto3" json:"initial_backoff,omitempty"`
+	// MaxBackoff is the maximum delay, in seconds, between two retries.
+	MaxBackoff uint32 `protobuf:"varint,3,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+	// RetryableExitCodes is the list of exit codes of a pipe handler that are
+	// retried. Any non-zero exit code is retried if empty.
+	RetryableExitCodes []uint32 `protobuf:"varint,4,rep,packed,name=retryable_exit_codes,json=retryableExitCodes" json:"retryable_exit_codes"`
+}
+
+func (m *HandlerRetryPolicy) Reset()                    { *m = HandlerRetryPolicy{} }
+func (m *HandlerRetryPolicy) String() string            { return proto.CompactTextString(m) }
+func (*HandlerRetryPolicy) ProtoMessage()               {}
+func (*HandlerRetryPolicy) Descriptor() ([]byte, []int) { return fileDescriptorHandler, []int{4} }
+
+func (m *HandlerRetryPolicy) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *HandlerRetryPolicy) GetInitialBackoff() uint32 {
+	if m != nil {
+		return m.InitialBackoff
+	}
+	return 0
+}
+
+func (m *HandlerRetryPolicy) GetMaxBackoff() uint32 {
+	if m != nil {
+		return m.MaxBackoff
+	}
+	return 0
+}
+
+func (m *HandlerRetryPolicy) GetRetryableExitCodes() []uint32 {
+	if m != nil {
+		return m.RetryableExitCodes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Handler)(nil), "sensu.types.Handler")
 	proto.RegisterType((*HandlerSocket)(nil), "sensu.types.HandlerSocket")
+	proto.RegisterType((*HandlerHTTP)(nil), "sensu.types.HandlerHTTP")
+	proto.RegisterType((*HandlerConcurrency)(nil), "sensu.types.HandlerConcurrency")
+	proto.RegisterType((*HandlerRetryPolicy)(nil), "sensu.types.HandlerRetryPolicy")
 }
 func (this *Handler) Equal(that interface{}) bool {
 	if that == nil {
@@ -227,6 +426,26 @@ func (this *Handler) Equal(that interface{}) bool {
 	if this.Organization != that1.Organization {
 		return false
 	}
+	if !this.RetryPolicy.Equal(that1.RetryPolicy) {
+		return false
+	}
+	if this.Extension != that1.Extension {
+		return false
+	}
+	if !this.HTTP.Equal(that1.HTTP) {
+		return false
+	}
+	if !this.Concurrency.Equal(that1.Concurrency) {
+		return false
+	}
+	if len(this.RuntimeAssets) != len(that1.RuntimeAssets) {
+		return false
+	}
+	for i := range this.RuntimeAssets {
+		if this.RuntimeAssets[i] != that1.RuntimeAssets[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *HandlerSocket) Equal(that interface{}) bool {
@@ -262,6 +481,136 @@ func (this *HandlerSocket) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *HandlerHTTP) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*HandlerHTTP)
+	if !ok {
+		that2, ok := that.(HandlerHTTP)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if len(this.Headers) != len(that1.Headers) {
+		return false
+	}
+	for i := range this.Headers {
+		if this.Headers[i] != that1.Headers[i] {
+			return false
+		}
+	}
+	if !this.TLS.Equal(that1.TLS) {
+		return false
+	}
+	if this.MinStatus != that1.MinStatus {
+		return false
+	}
+	if this.MaxStatus != that1.MaxStatus {
+		return false
+	}
+	return true
+}
+func (this *HandlerConcurrency) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*HandlerConcurrency)
+	if !ok {
+		that2, ok := that.(HandlerConcurrency)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	if this.QueueSize != that1.QueueSize {
+		return false
+	}
+	if this.OverflowPolicy != that1.OverflowPolicy {
+		return false
+	}
+	return true
+}
+func (this *HandlerRetryPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*HandlerRetryPolicy)
+	if !ok {
+		that2, ok := that.(HandlerRetryPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.MaxAttempts != that1.MaxAttempts {
+		return false
+	}
+	if this.InitialBackoff != that1.InitialBackoff {
+		return false
+	}
+	if this.MaxBackoff != that1.MaxBackoff {
+		return false
+	}
+	if len(this.RetryableExitCodes) != len(that1.RetryableExitCodes) {
+		return false
+	}
+	for i := range this.RetryableExitCodes {
+		if this.RetryableExitCodes[i] != that1.RetryableExitCodes[i] {
+			return false
+		}
+	}
+	return true
+}
 func (m *Handler) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -373,6 +722,59 @@ func (m *Handler) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintHandler(dAtA, i, uint64(len(m.Organization)))
 		i += copy(dAtA[i:], m.Organization)
 	}
+	if m.RetryPolicy != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.RetryPolicy.Size()))
+		n2, err := m.RetryPolicy.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.Extension) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Extension)))
+		i += copy(dAtA[i:], m.Extension)
+	}
+	if m.HTTP != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.HTTP.Size()))
+		n3, err := m.HTTP.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if m.Concurrency != nil {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Concurrency.Size()))
+		n4, err := m.Concurrency.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.RuntimeAssets) > 0 {
+		for _, s := range m.RuntimeAssets {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x1
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -405,11 +807,162 @@ func (m *HandlerSocket) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintHandler(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *HandlerHTTP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandlerHTTP) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.URL) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
+	}
+	if len(m.Method) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.Method)))
+		i += copy(dAtA[i:], m.Method)
+	}
+	if len(m.Headers) > 0 {
+		for k, _ := range m.Headers {
+			dAtA[i] = 0x1a
+			i++
+			v := m.Headers[k]
+			mapSize := 1 + len(k) + sovHandler(uint64(len(k))) + 1 + len(v) + sovHandler(uint64(len(v)))
+			i = encodeVarintHandler(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintHandler(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if m.TLS != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.TLS.Size()))
+		n5, err := m.TLS.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.MinStatus != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.MinStatus))
+	}
+	if m.MaxStatus != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.MaxStatus))
+	}
+	return i, nil
+}
+
+func (m *HandlerConcurrency) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandlerConcurrency) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.Limit))
+	}
+	if m.QueueSize != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.QueueSize))
+	}
+	if len(m.OverflowPolicy) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(len(m.OverflowPolicy)))
+		i += copy(dAtA[i:], m.OverflowPolicy)
+	}
+	return i, nil
+}
+
+func (m *HandlerRetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandlerRetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.MaxAttempts))
+	}
+	if m.InitialBackoff != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.InitialBackoff))
+	}
+	if m.MaxBackoff != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(m.MaxBackoff))
+	}
+	if len(m.RetryableExitCodes) > 0 {
+		dAtA7 := make([]byte, len(m.RetryableExitCodes)*10)
+		var j6 int
+		for _, num := range m.RetryableExitCodes {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintHandler(dAtA, i, uint64(j6))
+		i += copy(dAtA[i:], dAtA7[:j6])
+	}
+	return i, nil
+}
+
+func encodeVarintHandler(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return offset + 1
@@ -441,6 +994,21 @@ func NewPopulatedHandler(r randyHandler, easy bool) *Handler {
 	}
 	this.Environment = string(randStringHandler(r))
 	this.Organization = string(randStringHandler(r))
+	if r.Intn(10) != 0 {
+		this.RetryPolicy = NewPopulatedHandlerRetryPolicy(r, easy)
+	}
+	this.Extension = string(randStringHandler(r))
+	if r.Intn(10) != 0 {
+		this.HTTP = NewPopulatedHandlerHTTP(r, easy)
+	}
+	if r.Intn(10) != 0 {
+		this.Concurrency = NewPopulatedHandlerConcurrency(r, easy)
+	}
+	v4 := r.Intn(10)
+	this.RuntimeAssets = make([]string, v4)
+	for i := 0; i < v4; i++ {
+		this.RuntimeAssets[i] = string(randStringHandler(r))
+	}
 	if !easy && r.Intn(10) != 0 {
 	}
 	return this
@@ -455,6 +1023,52 @@ func NewPopulatedHandlerSocket(r randyHandler, easy bool) *HandlerSocket {
 	return this
 }
 
+func NewPopulatedHandlerHTTP(r randyHandler, easy bool) *HandlerHTTP {
+	this := &HandlerHTTP{}
+	this.URL = string(randStringHandler(r))
+	this.Method = string(randStringHandler(r))
+	if r.Intn(10) != 0 {
+		v5 := r.Intn(10)
+		this.Headers = make(map[string]string)
+		for i := 0; i < v5; i++ {
+			this.Headers[randStringHandler(r)] = randStringHandler(r)
+		}
+	}
+	if r.Intn(10) != 0 {
+		this.TLS = NewPopulatedTLSOptions(r, easy)
+	}
+	this.MinStatus = uint32(r.Uint32())
+	this.MaxStatus = uint32(r.Uint32())
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedHandlerConcurrency(r randyHandler, easy bool) *HandlerConcurrency {
+	this := &HandlerConcurrency{}
+	this.Limit = uint32(r.Uint32())
+	this.QueueSize = uint32(r.Uint32())
+	this.OverflowPolicy = string(randStringHandler(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedHandlerRetryPolicy(r randyHandler, easy bool) *HandlerRetryPolicy {
+	this := &HandlerRetryPolicy{}
+	this.MaxAttempts = uint32(r.Uint32())
+	this.InitialBackoff = uint32(r.Uint32())
+	this.MaxBackoff = uint32(r.Uint32())
+	v6 := r.Intn(10)
+	this.RetryableExitCodes = make([]uint32, v6)
+	for i := 0; i < v6; i++ {
+		this.RetryableExitCodes[i] = uint32(r.Uint32())
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
 type randyHandler interface {
 	Float32() float32
 	Float64() float64
@@ -474,9 +1088,9 @@ func randUTF8RuneHandler(r randyHandler) rune {
 	return rune(ru + 61)
 }
 func randStringHandler(r randyHandler) string {
-	v4 := r.Intn(100)
-	tmps := make([]rune, v4)
-	for i := 0; i < v4; i++ {
+	v7 := r.Intn(100)
+	tmps := make([]rune, v7)
+	for i := 0; i < v7; i++ {
 		tmps[i] = randUTF8RuneHandler(r)
 	}
 	return string(tmps)
@@ -498,11 +1112,11 @@ func randFieldHandler(dAtA []byte, r randyHandler, fieldNumber int, wire int) []
 	switch wire {
 	case 0:
 		dAtA = encodeVarintPopulateHandler(dAtA, uint64(key))
-		v5 := r.Int63()
+		v8 := r.Int63()
 		if r.Intn(2) == 0 {
-			v5 *= -1
+			v8 *= -1
 		}
-		dAtA = encodeVarintPopulateHandler(dAtA, uint64(v5))
+		dAtA = encodeVarintPopulateHandler(dAtA, uint64(v8))
 	case 1:
 		dAtA = encodeVarintPopulateHandler(dAtA, uint64(key))
 		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
@@ -579,6 +1193,28 @@ func (m *Handler) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovHandler(uint64(l))
 	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Extension)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.HTTP != nil {
+		l = m.HTTP.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.Concurrency != nil {
+		l = m.Concurrency.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.RuntimeAssets) > 0 {
+		for _, s := range m.RuntimeAssets {
+			l = len(s)
+			n += 2 + l + sovHandler(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -595,6 +1231,76 @@ func (m *HandlerSocket) Size() (n int) {
 	return n
 }
 
+func (m *HandlerHTTP) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for k, v := range m.Headers {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovHandler(uint64(len(k))) + 1 + len(v) + sovHandler(uint64(len(v)))
+			n += mapEntrySize + 1 + sovHandler(uint64(mapEntrySize))
+		}
+	}
+	if m.TLS != nil {
+		l = m.TLS.Size()
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	if m.MinStatus != 0 {
+		n += 1 + sovHandler(uint64(m.MinStatus))
+	}
+	if m.MaxStatus != 0 {
+		n += 1 + sovHandler(uint64(m.MaxStatus))
+	}
+	return n
+}
+
+func (m *HandlerConcurrency) Size() (n int) {
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovHandler(uint64(m.Limit))
+	}
+	if m.QueueSize != 0 {
+		n += 1 + sovHandler(uint64(m.QueueSize))
+	}
+	l = len(m.OverflowPolicy)
+	if l > 0 {
+		n += 1 + l + sovHandler(uint64(l))
+	}
+	return n
+}
+
+func (m *HandlerRetryPolicy) Size() (n int) {
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovHandler(uint64(m.MaxAttempts))
+	}
+	if m.InitialBackoff != 0 {
+		n += 1 + sovHandler(uint64(m.InitialBackoff))
+	}
+	if m.MaxBackoff != 0 {
+		n += 1 + sovHandler(uint64(m.MaxBackoff))
+	}
+	if len(m.RetryableExitCodes) > 0 {
+		l = 0
+		for _, e := range m.RetryableExitCodes {
+			l += sovHandler(uint64(e))
+		}
+		n += 1 + sovHandler(uint64(l)) + l
+	}
+	return n
+}
+
 func sovHandler(x uint64) (n int) {
 	for {
 		n++
@@ -950,59 +1656,42 @@ func (m *Handler) Unmarshal(dAtA []byte) error {
 			}
 			m.Organization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipHandler(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthHandler
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *HandlerSocket) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowHandler
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &HandlerRetryPolicy{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: HandlerSocket: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HandlerSocket: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Extension", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1027,13 +1716,13 @@ func (m *HandlerSocket) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Host = string(dAtA[iNdEx:postIndex])
+			m.Extension = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTP", wireType)
 			}
-			m.Port = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowHandler
@@ -1043,11 +1732,768 @@ func (m *HandlerSocket) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Port |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HTTP == nil {
+				m.HTTP = &HandlerHTTP{}
+			}
+			if err := m.HTTP.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Concurrency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Concurrency == nil {
+				m.Concurrency = &HandlerConcurrency{}
+			}
+			if err := m.Concurrency.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeAssets", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RuntimeAssets = append(m.RuntimeAssets, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerSocket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerSocket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerSocket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerHTTP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerHTTP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerHTTP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Headers == nil {
+				m.Headers = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHandler
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHandler
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthHandler
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHandler
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthHandler
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipHandler(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthHandler
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Headers[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TLS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TLS == nil {
+				m.TLS = &TLSOptions{}
+			}
+			if err := m.TLS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStatus", wireType)
+			}
+			m.MinStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStatus |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStatus", wireType)
+			}
+			m.MaxStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStatus |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerConcurrency) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerConcurrency: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerConcurrency: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueSize", wireType)
+			}
+			m.QueueSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueSize |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverflowPolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHandler
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OverflowPolicy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HandlerRetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HandlerRetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HandlerRetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBackoff", wireType)
+			}
+			m.InitialBackoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialBackoff |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoff", wireType)
+			}
+			m.MaxBackoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBackoff |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHandler
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (uint32(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RetryableExitCodes = append(m.RetryableExitCodes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHandler
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthHandler
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHandler
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (uint32(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RetryableExitCodes = append(m.RetryableExitCodes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryableExitCodes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHandler(dAtA[iNdEx:])
@@ -1177,28 +2623,61 @@ var (
 func init() { proto.RegisterFile("handler.proto", fileDescriptorHandler) }
 
 var fileDescriptorHandler = []byte{
-	// 366 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x3f, 0x4e, 0xc3, 0x30,
-	0x14, 0xc6, 0x71, 0xff, 0xa5, 0x75, 0x9a, 0xc5, 0x93, 0xd5, 0x21, 0x8d, 0x8a, 0x10, 0x59, 0x48,
-	0x25, 0x18, 0x60, 0xee, 0xc4, 0x1c, 0x24, 0x06, 0x16, 0xe4, 0xb6, 0x6e, 0x1a, 0x51, 0xdb, 0x95,
-	0xed, 0x44, 0x82, 0x93, 0x70, 0x04, 0x8e, 0xc0, 0x11, 0x3a, 0x72, 0x82, 0x0a, 0xc2, 0xd6, 0x13,
-	0xc0, 0x86, 0xec, 0x24, 0xa5, 0xdd, 0xbe, 0xef, 0xf7, 0x3e, 0x3f, 0xf9, 0xbd, 0x07, 0xbd, 0x25,
-	0xe1, 0xf3, 0x15, 0x95, 0xd1, 0x5a, 0x0a, 0x2d, 0x90, 0xab, 0x28, 0x57, 0x59, 0xa4, 0x9f, 0xd7,
-	0x54, 0x0d, 0x2e, 0x92, 0x54, 0x2f, 0xb3, 0x69, 0x34, 0x13, 0x6c, 0x9c, 0x88, 0x44, 0x8c, 0x6d,
-	0x66, 0x9a, 0x2d, 0xac, 0xb3, 0xc6, 0xaa, 0xf2, 0xed, 0xe8, 0xb7, 0x01, 0x9d, 0xdb, 0xb2, 0x1b,
-	0x42, 0xb0, 0xc5, 0x09, 0xa3, 0x18, 0x04, 0x20, 0xec, 0xc5, 0x56, 0x1b, 0x66, 0xfa, 0xe2, 0x46,
-	0xc9, 0x8c, 0x46, 0x18, 0x3a, 0x2c, 0xd3, 0x44, 0x0b, 0x89, 0x9b, 0x16, 0xd7, 0xd6, 0x54, 0x66,
-	0x82, 0x31, 0xc2, 0xe7, 0xb8, 0x55, 0x56, 0x2a, 0x6b, 0x2a, 0x3a, 0x65, 0x54, 0x64, 0x1a, 0xb7,
-	0x03, 0x10, 0x7a, 0x71, 0x6d, 0xd1, 0x0d, 0xec, 0x28, 0x31, 0x7b, 0xa2, 0x1a, 0x77, 0x02, 0x10,
-	0xba, 0x97, 0x83, 0xe8, 0x60, 0x9c, 0xa8, 0xfa, 0xdb, 0x9d, 0x4d, 0x4c, 0x5a, 0x9b, 0xed, 0x10,
-	0xc4, 0x55, 0x1e, 0x85, 0xb0, 0x5b, 0x2d, 0x42, 0x61, 0x27, 0x68, 0x86, 0xbd, 0x49, 0x7f, 0xb7,
-	0x1d, 0xee, 0x59, 0xbc, 0x57, 0xe8, 0x0c, 0x3a, 0x8b, 0x74, 0xa5, 0x4d, 0xb0, 0x6b, 0x83, 0xee,
-	0x6e, 0x3b, 0xac, 0x51, 0x5c, 0x0b, 0x74, 0x0e, 0xbb, 0x94, 0xe7, 0x8f, 0x39, 0x91, 0x0a, 0xf7,
-	0xfe, 0x1b, 0xd6, 0x2c, 0x76, 0x28, 0xcf, 0xef, 0x89, 0x54, 0x28, 0x80, 0x2e, 0xe5, 0x79, 0x2a,
-	0x05, 0x67, 0x94, 0x6b, 0x0c, 0xed, 0xac, 0x87, 0x08, 0x8d, 0x60, 0x5f, 0xc8, 0x84, 0xf0, 0xf4,
-	0x85, 0xe8, 0x54, 0x70, 0xec, 0xda, 0xc8, 0x11, 0x1b, 0x5d, 0x43, 0xef, 0x68, 0x3c, 0xb3, 0xec,
-	0xa5, 0x50, 0xba, 0x3e, 0x80, 0xd1, 0x86, 0xad, 0x85, 0xd4, 0xf6, 0x00, 0x5e, 0x6c, 0xf5, 0xe4,
-	0xf4, 0xe7, 0xcb, 0x07, 0x6f, 0x85, 0x0f, 0xde, 0x0b, 0x1f, 0x6c, 0x0a, 0x1f, 0x7c, 0x14, 0x3e,
-	0xf8, 0x2c, 0x7c, 0xf0, 0xfa, 0xed, 0x9f, 0x3c, 0xb4, 0xed, 0xe6, 0xa6, 0x1d, 0x7b, 0xe0, 0xab,
-	0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x67, 0x62, 0x55, 0xbc, 0x2d, 0x02, 0x00, 0x00,
+	// 890 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xc1, 0x8e, 0x1b, 0x45,
+	0x10, 0x65, 0x32, 0x5e, 0x7b, 0xdd, 0x63, 0x7b, 0x97, 0x66, 0x43, 0x26, 0x2b, 0xe2, 0x31, 0x46,
+	0x51, 0x7c, 0x08, 0x8e, 0xb4, 0x08, 0x25, 0xe4, 0x96, 0x89, 0x02, 0x2b, 0xb4, 0x12, 0xd1, 0x78,
+	0xc9, 0x81, 0x8b, 0x69, 0x8f, 0xdb, 0xeb, 0xd6, 0xce, 0x74, 0x9b, 0xee, 0x1e, 0x63, 0xef, 0x97,
+	0xf0, 0x03, 0x48, 0x48, 0xfc, 0x00, 0x9f, 0x90, 0x0b, 0x12, 0x5f, 0x30, 0x02, 0x73, 0x9b, 0x2f,
+	0xe0, 0x06, 0xea, 0xee, 0x19, 0xbb, 0x97, 0xec, 0xde, 0xaa, 0x5e, 0xbd, 0x57, 0xae, 0xaa, 0xae,
+	0x1a, 0x83, 0xf6, 0x1c, 0xd1, 0x69, 0x82, 0xf9, 0x70, 0xc1, 0x99, 0x64, 0xd0, 0x13, 0x98, 0x8a,
+	0x6c, 0x28, 0xd7, 0x0b, 0x2c, 0x8e, 0x3f, 0xbd, 0x20, 0x72, 0x9e, 0x4d, 0x86, 0x31, 0x4b, 0x9f,
+	0x5c, 0xb0, 0x0b, 0xf6, 0x44, 0x73, 0x26, 0xd9, 0x4c, 0x7b, 0xda, 0xd1, 0x96, 0xd1, 0x1e, 0x37,
+	0x65, 0x22, 0x8c, 0xd9, 0xff, 0x77, 0x0f, 0x34, 0x4e, 0x4d, 0x62, 0x08, 0x41, 0x8d, 0xa2, 0x14,
+	0xfb, 0x4e, 0xcf, 0x19, 0x34, 0x23, 0x6d, 0x2b, 0x4c, 0xfd, 0x84, 0x7f, 0xc7, 0x60, 0xca, 0x86,
+	0x3e, 0x68, 0xa4, 0x99, 0x44, 0x92, 0x71, 0xdf, 0xd5, 0x70, 0xe5, 0xaa, 0x48, 0xcc, 0xd2, 0x14,
+	0xd1, 0xa9, 0x5f, 0x33, 0x91, 0xd2, 0x55, 0x11, 0x49, 0x52, 0xcc, 0x32, 0xe9, 0xef, 0xf5, 0x9c,
+	0x41, 0x3b, 0xaa, 0x5c, 0xf8, 0x0c, 0xd4, 0x05, 0x8b, 0x2f, 0xb1, 0xf4, 0xeb, 0x3d, 0x67, 0xe0,
+	0x9d, 0x1c, 0x0f, 0xad, 0xce, 0x86, 0x65, 0x6d, 0x23, 0xcd, 0x08, 0x6b, 0x6f, 0xf3, 0xc0, 0x89,
+	0x4a, 0x3e, 0x1c, 0x80, 0xfd, 0x72, 0x26, 0xc2, 0x6f, 0xf4, 0xdc, 0x41, 0x33, 0x6c, 0x15, 0x79,
+	0xb0, 0xc5, 0xa2, 0xad, 0x05, 0x1f, 0x82, 0xc6, 0x8c, 0x24, 0x52, 0x11, 0xf7, 0x35, 0xd1, 0x2b,
+	0xf2, 0xa0, 0x82, 0xa2, 0xca, 0x80, 0x8f, 0xc0, 0x3e, 0xa6, 0xcb, 0xf1, 0x12, 0x71, 0xe1, 0x37,
+	0x77, 0x09, 0x2b, 0x2c, 0x6a, 0x60, 0xba, 0x7c, 0x83, 0xb8, 0x80, 0x3d, 0xe0, 0x61, 0xba, 0x24,
+	0x9c, 0xd1, 0x14, 0x53, 0xe9, 0x03, 0xdd, 0xab, 0x0d, 0xc1, 0x3e, 0x68, 0x31, 0x7e, 0x81, 0x28,
+	0xb9, 0x42, 0x92, 0x30, 0xea, 0x7b, 0x9a, 0x72, 0x0d, 0x83, 0x13, 0xd0, 0xe2, 0x58, 0xf2, 0xf5,
+	0x78, 0xc1, 0x12, 0x12, 0xaf, 0xfd, 0x96, 0xee, 0x3f, 0xb8, 0xa9, 0xff, 0x48, 0xf1, 0x5e, 0x6b,
+	0x5a, 0xd8, 0x55, 0x43, 0x28, 0xf2, 0xe0, 0x43, 0x5b, 0xfc, 0x98, 0xa5, 0x44, 0xe2, 0x74, 0x21,
+	0xd7, 0x91, 0xc7, 0x77, 0x64, 0xf8, 0x39, 0x68, 0xe2, 0x95, 0xc4, 0x54, 0xa8, 0x22, 0xda, 0xaa,
+	0x88, 0xf0, 0x5e, 0x91, 0x07, 0x1f, 0x6c, 0x41, 0x4b, 0xb8, 0x63, 0xc2, 0x33, 0x50, 0x9b, 0x4b,
+	0xb9, 0xf0, 0x3b, 0xba, 0x24, 0xff, 0xa6, 0x92, 0x4e, 0xcf, 0xcf, 0x5f, 0x9b, 0x5a, 0x36, 0x79,
+	0x50, 0x53, 0x5e, 0x91, 0x07, 0x1d, 0xa5, 0xb2, 0x52, 0xea, 0x2c, 0xf0, 0x7b, 0xe0, 0xc5, 0x8c,
+	0xc6, 0x19, 0xe7, 0x98, 0xc6, 0x6b, 0xff, 0xe0, 0xf6, 0x3e, 0x5f, 0xee, 0x68, 0xe1, 0x83, 0xb2,
+	0xcf, 0xbb, 0x96, 0xd6, 0x6e, 0xd3, 0x82, 0xe1, 0x17, 0xa0, 0xc3, 0x33, 0xaa, 0x56, 0x6a, 0x8c,
+	0x84, 0xc0, 0x52, 0xf8, 0x87, 0xfa, 0xfd, 0xa0, 0xaa, 0xe9, 0x7a, 0x24, 0x6a, 0x97, 0xfe, 0x0b,
+	0xed, 0xf6, 0x9f, 0x82, 0xf6, 0xb5, 0x25, 0x53, 0x2b, 0x3f, 0x67, 0x42, 0x56, 0x67, 0xa0, 0x6c,
+	0x85, 0x2d, 0x18, 0x97, 0xfa, 0x0c, 0xda, 0x91, 0xb6, 0xfb, 0x3f, 0xbb, 0xc0, 0xb3, 0x66, 0x01,
+	0xef, 0x03, 0x37, 0xe3, 0x89, 0x91, 0x85, 0x8d, 0x4d, 0x1e, 0xb8, 0xdf, 0x46, 0x67, 0x91, 0xc2,
+	0xe0, 0x63, 0x50, 0x4f, 0xb1, 0x9c, 0xb3, 0xa9, 0xb9, 0xa3, 0xf0, 0xa8, 0xc8, 0x83, 0x43, 0x83,
+	0x58, 0x1d, 0x95, 0x1c, 0xf8, 0x06, 0x34, 0xe6, 0x18, 0x4d, 0xd5, 0xb6, 0xba, 0x3d, 0x77, 0xe0,
+	0x9d, 0x3c, 0xbc, 0x6d, 0xfe, 0xc3, 0x53, 0xc3, 0x7b, 0x45, 0x25, 0x5f, 0x87, 0x77, 0x8b, 0x3c,
+	0x78, 0xbf, 0x54, 0x5a, 0x69, 0xab, 0x64, 0xf0, 0x2b, 0xe0, 0xca, 0x44, 0xe8, 0xcb, 0xf4, 0x4e,
+	0xee, 0x5d, 0xcb, 0x79, 0x7e, 0x36, 0xfa, 0x66, 0xa1, 0x96, 0x52, 0x84, 0x1f, 0x95, 0x4f, 0xea,
+	0x9e, 0x9f, 0x8d, 0x8a, 0x3c, 0x68, 0xcb, 0xc4, 0x4e, 0xa6, 0x32, 0xc0, 0xa7, 0x00, 0xa4, 0x84,
+	0x8e, 0x85, 0x44, 0x32, 0x13, 0xe6, 0x9e, 0x43, 0xbf, 0xc8, 0x83, 0xa3, 0x1d, 0x6a, 0xaf, 0x55,
+	0x4a, 0xe8, 0x48, 0x83, 0x5a, 0x88, 0x56, 0x95, 0xb0, 0x6e, 0x09, 0xd1, 0xea, 0x26, 0x21, 0x5a,
+	0x19, 0xe1, 0xf1, 0x73, 0xd0, 0xb2, 0x5b, 0x85, 0x87, 0xc0, 0xbd, 0xc4, 0xeb, 0xf2, 0x89, 0x94,
+	0x09, 0x8f, 0xc0, 0xde, 0x12, 0x25, 0x59, 0xf5, 0xa5, 0x32, 0xce, 0xf3, 0x3b, 0xcf, 0x9c, 0xfe,
+	0xaf, 0x0e, 0x80, 0xef, 0xae, 0x97, 0x12, 0x24, 0x24, 0x25, 0xe6, 0x9d, 0xdb, 0x91, 0x71, 0x54,
+	0x85, 0x3f, 0x64, 0x38, 0xc3, 0x63, 0x41, 0xae, 0x4c, 0xae, 0xb2, 0xc2, 0x1d, 0x6a, 0x57, 0xa8,
+	0xd1, 0x11, 0xb9, 0xc2, 0xf0, 0x4b, 0x70, 0xc0, 0x96, 0x98, 0xcf, 0x12, 0xf6, 0x63, 0x75, 0xcf,
+	0xfa, 0xe3, 0x18, 0x3e, 0x28, 0xf2, 0xe0, 0xfe, 0xff, 0x42, 0x56, 0x8a, 0x4e, 0x15, 0x32, 0x07,
+	0xdb, 0xff, 0x7d, 0x57, 0xad, 0x75, 0xf4, 0xf0, 0x63, 0xd0, 0x52, 0x33, 0x42, 0x52, 0xcb, 0x44,
+	0x59, 0xb4, 0x97, 0xa2, 0xd5, 0x8b, 0x12, 0x82, 0x8f, 0xc0, 0x01, 0xa1, 0x44, 0x12, 0x94, 0x8c,
+	0x27, 0x28, 0xbe, 0x64, 0xb3, 0x59, 0xb9, 0xae, 0x9d, 0x12, 0x0e, 0x0d, 0x0a, 0x03, 0xa0, 0x74,
+	0x5b, 0x92, 0xab, 0x49, 0xea, 0x61, 0x2a, 0xc2, 0xd7, 0xe0, 0x48, 0x7f, 0x43, 0xd0, 0x24, 0xc1,
+	0x63, 0xbc, 0x22, 0x72, 0x1c, 0xb3, 0x29, 0x56, 0x9b, 0xe3, 0x56, 0xe3, 0xb8, 0x29, 0x1e, 0xc1,
+	0x2d, 0xfa, 0x6a, 0x45, 0xe4, 0x4b, 0x85, 0x85, 0x9f, 0xfc, 0xf3, 0x57, 0xd7, 0xf9, 0x65, 0xd3,
+	0x75, 0x7e, 0xdb, 0x74, 0x9d, 0xb7, 0x9b, 0xae, 0xf3, 0xc7, 0xa6, 0xeb, 0xfc, 0xb9, 0xe9, 0x3a,
+	0x3f, 0xfd, 0xdd, 0x7d, 0xef, 0xbb, 0x3d, 0xbd, 0x7e, 0x93, 0xba, 0xfe, 0x33, 0xfa, 0xec, 0xbf,
+	0x01, 0x00, 0xbb, 0xc9, 0xa5, 0x46, 0xe4, 0x06, 0x00, 0x00,
 }
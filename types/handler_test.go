@@ -18,6 +18,18 @@ func TestFixtureSetHandler(t *testing.T) {
 	assert.NoError(t, handler.Validate())
 }
 
+func TestFixtureGRPCHandler(t *testing.T) {
+	handler := FixtureGRPCHandler("handler", "extension")
+	assert.Equal(t, "extension", handler.Extension)
+	assert.NoError(t, handler.Validate())
+}
+
+func TestFixtureHTTPHandler(t *testing.T) {
+	handler := FixtureHTTPHandler("handler", "http://localhost/hook")
+	assert.Equal(t, "http://localhost/hook", handler.HTTP.URL)
+	assert.NoError(t, handler.Validate())
+}
+
 func TestFixtureSocketHandler(t *testing.T) {
 	handler := FixtureSocketHandler("handler", "tcp")
 	assert.Equal(t, "handler", handler.Name)
@@ -48,4 +60,104 @@ func TestHandlerValidate(t *testing.T) {
 
 	// Valid handler
 	assert.NoError(t, h.Validate())
+
+	// Invalid retry policy
+	h.RetryPolicy = &HandlerRetryPolicy{InitialBackoff: 10, MaxBackoff: 5}
+	assert.Error(t, h.Validate())
+
+	// Valid retry policy
+	h.RetryPolicy.MaxBackoff = 60
+	assert.NoError(t, h.Validate())
+
+	// Invalid concurrency limit
+	h.Concurrency = &HandlerConcurrency{}
+	assert.Error(t, h.Validate())
+
+	// Invalid overflow policy
+	h.Concurrency = &HandlerConcurrency{Limit: 2, OverflowPolicy: "drop_all"}
+	assert.Error(t, h.Validate())
+
+	// Valid concurrency
+	h.Concurrency.OverflowPolicy = OverflowPolicyDropOldest
+	assert.NoError(t, h.Validate())
+
+	// Missing extension
+	h.Type = HandlerGRPCType
+	assert.Error(t, h.Validate())
+
+	// Valid grpc handler
+	h.Extension = "extension"
+	assert.NoError(t, h.Validate())
+
+	// Missing http configuration
+	h.Type = HandlerHTTPType
+	assert.Error(t, h.Validate())
+
+	// Invalid url
+	h.HTTP = &HandlerHTTP{URL: "localhost:8080"}
+	assert.Error(t, h.Validate())
+
+	// Invalid status range
+	h.HTTP = &HandlerHTTP{URL: "https://localhost:8080/hook", MinStatus: 200, MaxStatus: 100}
+	assert.Error(t, h.Validate())
+
+	// Valid http handler
+	h.HTTP.MaxStatus = 204
+	assert.NoError(t, h.Validate())
+
+	// Invalid runtime asset
+	h.RuntimeAssets = []string{"BAD--a!!!---ASDFASDF$$$$"}
+	assert.Error(t, h.Validate())
+
+	// Valid runtime asset
+	h.RuntimeAssets = []string{"slack"}
+	assert.NoError(t, h.Validate())
+}
+
+func TestHandlerRedactHeaders(t *testing.T) {
+	handler := FixtureHTTPHandler("handler", "https://example.com")
+	handler.HTTP.Headers = map[string]string{"Authorization": "Bearer secret"}
+
+	redacted := handler.RedactHeaders()
+	assert.Equal(t, map[string]string{"Authorization": "REDACTED"}, redacted.HTTP.Headers)
+	assert.Equal(t, "Bearer secret", handler.HTTP.Headers["Authorization"])
+
+	// The redacted values are restored, the new ones kept
+	redacted.HTTP.Headers["X-Mirror"] = "mirror"
+	redacted.RestoreHeaders(handler)
+	assert.Equal(t, map[string]string{
+		"Authorization": "Bearer secret",
+		"X-Mirror":      "mirror",
+	}, redacted.HTTP.Headers)
+
+	// Handlers without HTTP configuration are left untouched
+	pipe := FixtureHandler("pipe")
+	assert.Equal(t, pipe, pipe.RedactHeaders())
+	pipe.RestoreHeaders(handler)
+	assert.Nil(t, pipe.HTTP)
+}
+
+func TestHandlerHTTPIsSuccessStatus(t *testing.T) {
+	h := &HandlerHTTP{}
+	assert.True(t, h.IsSuccessStatus(200))
+	assert.True(t, h.IsSuccessStatus(299))
+	assert.False(t, h.IsSuccessStatus(302))
+	assert.False(t, h.IsSuccessStatus(500))
+
+	h.MinStatus = 200
+	h.MaxStatus = 399
+	assert.True(t, h.IsSuccessStatus(302))
+	assert.False(t, h.IsSuccessStatus(404))
+}
+
+func TestHandlerRetryPolicyIsRetryableExitCode(t *testing.T) {
+	policy := &HandlerRetryPolicy{}
+	assert.False(t, policy.IsRetryableExitCode(0))
+	assert.True(t, policy.IsRetryableExitCode(1))
+	assert.True(t, policy.IsRetryableExitCode(2))
+
+	policy.RetryableExitCodes = []uint32{2, 75}
+	assert.False(t, policy.IsRetryableExitCode(1))
+	assert.True(t, policy.IsRetryableExitCode(2))
+	assert.True(t, policy.IsRetryableExitCode(75))
 }
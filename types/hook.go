@@ -34,6 +34,11 @@ func (h *Hook) Validate() error {
 	return nil
 }
 
+// URIPath returns the path component of a HookConfig URI.
+func (c *HookConfig) URIPath() string {
+	return fmt.Sprintf("/hooks/%s", url.PathEscape(c.Name))
+}
+
 // Validate returns an error if the hook does not pass validation tests.
 func (c *HookConfig) Validate() error {
 	if err := ValidateName(c.Name); err != nil {
@@ -23,6 +23,12 @@ func (m *Mutator) Validate() error {
 		return errors.New("mutator organization must be set")
 	}
 
+	for _, assetName := range m.RuntimeAssets {
+		if err := ValidateAssetName(assetName); err != nil {
+			return fmt.Errorf("asset's %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -37,6 +43,8 @@ func (m *Mutator) Update(from *Mutator, fields ...string) error {
 			m.Timeout = from.Timeout
 		case "EnvVars":
 			m.EnvVars = append(m.EnvVars[0:0], from.EnvVars...)
+		case "RuntimeAssets":
+			m.RuntimeAssets = append(m.RuntimeAssets[0:0], from.RuntimeAssets...)
 		default:
 			return fmt.Errorf("unsupported field: %q", f)
 		}
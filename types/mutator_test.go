@@ -33,4 +33,12 @@ func TestMutatorValidate(t *testing.T) {
 
 	// Valid mutator
 	assert.NoError(t, m.Validate())
+
+	// Invalid runtime asset
+	m.RuntimeAssets = []string{"BAD--a!!!---ASDFASDF$$$$"}
+	assert.Error(t, m.Validate())
+
+	// Valid runtime asset
+	m.RuntimeAssets = []string{"jq"}
+	assert.NoError(t, m.Validate())
 }
@@ -0,0 +1,78 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+)
+
+const (
+	// PrometheusRelabelReplace sets the target label to the replacement when
+	// the source label matches the regular expression
+	PrometheusRelabelReplace = "replace"
+
+	// PrometheusRelabelLabelDrop removes the labels whose name matches the
+	// regular expression
+	PrometheusRelabelLabelDrop = "labeldrop"
+
+	// DefaultPrometheusRelabelRegex is the regular expression of the
+	// relabeling rules that do not specify one
+	DefaultPrometheusRelabelRegex = "(.*)"
+
+	// DefaultPrometheusRelabelReplacement is the replacement of the relabeling
+	// rules that do not specify one
+	DefaultPrometheusRelabelReplacement = "$1"
+)
+
+// PrometheusRegexp compiles a regular expression of a Prometheus scrape, which
+// is anchored at both ends.
+func PrometheusRegexp(expr string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + expr + ")$")
+}
+
+// Validate returns an error if the scrape configuration is invalid.
+func (p *PrometheusScrape) Validate() error {
+	u, err := url.Parse(p.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("prometheus URL must be a HTTP or HTTPS URL: %q", p.URL)
+	}
+
+	for _, filters := range []map[string]string{p.AllowLabels, p.DenyLabels} {
+		for label, expr := range filters {
+			if _, err := PrometheusRegexp(expr); err != nil {
+				return fmt.Errorf("invalid regex for prometheus label %q: %s", label, err)
+			}
+		}
+	}
+
+	for _, relabel := range p.Relabel {
+		if err := relabel.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Validate returns an error if the relabeling rule is invalid.
+func (r *PrometheusRelabel) Validate() error {
+	switch r.Action {
+	case "", PrometheusRelabelReplace:
+		if r.SourceLabel == "" {
+			return errors.New("prometheus relabeling rule must have a source label")
+		}
+	case PrometheusRelabelLabelDrop:
+		if r.Regex == "" {
+			return errors.New("prometheus labeldrop rule must have a regex")
+		}
+	default:
+		return fmt.Errorf("unknown prometheus relabeling action: %q", r.Action)
+	}
+
+	if _, err := PrometheusRegexp(r.Regex); err != nil {
+		return fmt.Errorf("invalid prometheus relabeling regex: %s", err)
+	}
+
+	return nil
+}
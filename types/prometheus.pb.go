@@ -0,0 +1,1219 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: prometheus.proto
+
+package types
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// A PrometheusScrape configures a check that scrapes the metrics of a
+// Prometheus exposition endpoint, instead of executing a command.
+type PrometheusScrape struct {
+	// URL is the address of the endpoint.
+	URL string `protobuf:"bytes,1,opt,name=url,proto3" json:"url"`
+	// AllowLabels only keeps the metric points whose labels match every
+	// regular expression, by label name. The metric name is the __name__ label.
+	AllowLabels map[string]string `protobuf:"bytes,2,rep,name=allow_labels,json=allowLabels" json:"allow_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// DenyLabels drops the metric points having a label that matches one of the
+	// regular expressions, by label name. The metric name is the __name__ label.
+	DenyLabels map[string]string `protobuf:"bytes,3,rep,name=deny_labels,json=denyLabels" json:"deny_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Relabel is the list of relabeling rules applied, in order, to the labels
+	// of the metric points that pass the filters.
+	Relabel []PrometheusRelabel `protobuf:"bytes,4,rep,name=relabel" json:"relabel,omitempty"`
+}
+
+func (m *PrometheusScrape) Reset()                    { *m = PrometheusScrape{} }
+func (m *PrometheusScrape) String() string            { return proto.CompactTextString(m) }
+func (*PrometheusScrape) ProtoMessage()               {}
+func (*PrometheusScrape) Descriptor() ([]byte, []int) { return fileDescriptorPrometheus, []int{0} }
+
+func (m *PrometheusScrape) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *PrometheusScrape) GetAllowLabels() map[string]string {
+	if m != nil {
+		return m.AllowLabels
+	}
+	return nil
+}
+
+func (m *PrometheusScrape) GetDenyLabels() map[string]string {
+	if m != nil {
+		return m.DenyLabels
+	}
+	return nil
+}
+
+func (m *PrometheusScrape) GetRelabel() []PrometheusRelabel {
+	if m != nil {
+		return m.Relabel
+	}
+	return nil
+}
+
+// A PrometheusRelabel rewrites the labels of the scraped metric points.
+type PrometheusRelabel struct {
+	// Action is either "replace", the default, or "labeldrop".
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// SourceLabel is the label whose value is matched by the regular expression
+	// of a replace action.
+	SourceLabel string `protobuf:"bytes,2,opt,name=source_label,json=sourceLabel,proto3" json:"source_label,omitempty"`
+	// Regex is the regular expression, anchored at both ends, matched against
+	// the source label value of a replace action, or the label names of a
+	// labeldrop action. It defaults to "(.*)".
+	Regex string `protobuf:"bytes,3,opt,name=regex,proto3" json:"regex,omitempty"`
+	// TargetLabel is the label set by a replace action, which defaults to the
+	// source label.
+	TargetLabel string `protobuf:"bytes,4,opt,name=target_label,json=targetLabel,proto3" json:"target_label,omitempty"`
+	// Replacement is the value given to the target label, which can refer to
+	// the capture groups of the regular expression. It defaults to "$1". The
+	// target label is removed if the replacement is empty.
+	Replacement string `protobuf:"bytes,5,opt,name=replacement,proto3" json:"replacement,omitempty"`
+}
+
+func (m *PrometheusRelabel) Reset()                    { *m = PrometheusRelabel{} }
+func (m *PrometheusRelabel) String() string            { return proto.CompactTextString(m) }
+func (*PrometheusRelabel) ProtoMessage()               {}
+func (*PrometheusRelabel) Descriptor() ([]byte, []int) { return fileDescriptorPrometheus, []int{1} }
+
+func (m *PrometheusRelabel) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *PrometheusRelabel) GetSourceLabel() string {
+	if m != nil {
+		return m.SourceLabel
+	}
+	return ""
+}
+
+func (m *PrometheusRelabel) GetRegex() string {
+	if m != nil {
+		return m.Regex
+	}
+	return ""
+}
+
+func (m *PrometheusRelabel) GetTargetLabel() string {
+	if m != nil {
+		return m.TargetLabel
+	}
+	return ""
+}
+
+func (m *PrometheusRelabel) GetReplacement() string {
+	if m != nil {
+		return m.Replacement
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*PrometheusScrape)(nil), "sensu.types.PrometheusScrape")
+	proto.RegisterType((*PrometheusRelabel)(nil), "sensu.types.PrometheusRelabel")
+}
+func (this *PrometheusScrape) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*PrometheusScrape)
+	if !ok {
+		that2, ok := that.(PrometheusScrape)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.URL != that1.URL {
+		return false
+	}
+	if len(this.AllowLabels) != len(that1.AllowLabels) {
+		return false
+	}
+	for i := range this.AllowLabels {
+		if this.AllowLabels[i] != that1.AllowLabels[i] {
+			return false
+		}
+	}
+	if len(this.DenyLabels) != len(that1.DenyLabels) {
+		return false
+	}
+	for i := range this.DenyLabels {
+		if this.DenyLabels[i] != that1.DenyLabels[i] {
+			return false
+		}
+	}
+	if len(this.Relabel) != len(that1.Relabel) {
+		return false
+	}
+	for i := range this.Relabel {
+		if !this.Relabel[i].Equal(&that1.Relabel[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PrometheusRelabel) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*PrometheusRelabel)
+	if !ok {
+		that2, ok := that.(PrometheusRelabel)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if this.SourceLabel != that1.SourceLabel {
+		return false
+	}
+	if this.Regex != that1.Regex {
+		return false
+	}
+	if this.TargetLabel != that1.TargetLabel {
+		return false
+	}
+	if this.Replacement != that1.Replacement {
+		return false
+	}
+	return true
+}
+func (m *PrometheusScrape) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrometheusScrape) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.URL) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPrometheus(dAtA, i, uint64(len(m.URL)))
+		i += copy(dAtA[i:], m.URL)
+	}
+	if len(m.AllowLabels) > 0 {
+		for k, _ := range m.AllowLabels {
+			dAtA[i] = 0x12
+			i++
+			v := m.AllowLabels[k]
+			mapSize := 1 + len(k) + sovPrometheus(uint64(len(k))) + 1 + len(v) + sovPrometheus(uint64(len(v)))
+			i = encodeVarintPrometheus(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPrometheus(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPrometheus(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.DenyLabels) > 0 {
+		for k, _ := range m.DenyLabels {
+			dAtA[i] = 0x1a
+			i++
+			v := m.DenyLabels[k]
+			mapSize := 1 + len(k) + sovPrometheus(uint64(len(k))) + 1 + len(v) + sovPrometheus(uint64(len(v)))
+			i = encodeVarintPrometheus(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPrometheus(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPrometheus(dAtA, i, uint64(len(v)))
+			i += copy(dAtA[i:], v)
+		}
+	}
+	if len(m.Relabel) > 0 {
+		for _, msg := range m.Relabel {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintPrometheus(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PrometheusRelabel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrometheusRelabel) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Action) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPrometheus(dAtA, i, uint64(len(m.Action)))
+		i += copy(dAtA[i:], m.Action)
+	}
+	if len(m.SourceLabel) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPrometheus(dAtA, i, uint64(len(m.SourceLabel)))
+		i += copy(dAtA[i:], m.SourceLabel)
+	}
+	if len(m.Regex) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPrometheus(dAtA, i, uint64(len(m.Regex)))
+		i += copy(dAtA[i:], m.Regex)
+	}
+	if len(m.TargetLabel) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPrometheus(dAtA, i, uint64(len(m.TargetLabel)))
+		i += copy(dAtA[i:], m.TargetLabel)
+	}
+	if len(m.Replacement) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPrometheus(dAtA, i, uint64(len(m.Replacement)))
+		i += copy(dAtA[i:], m.Replacement)
+	}
+	return i, nil
+}
+
+func encodeVarintPrometheus(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedPrometheusScrape(r randyPrometheus, easy bool) *PrometheusScrape {
+	this := &PrometheusScrape{}
+	this.URL = string(randStringPrometheus(r))
+	if r.Intn(10) != 0 {
+		v1 := r.Intn(10)
+		this.AllowLabels = make(map[string]string)
+		for i := 0; i < v1; i++ {
+			this.AllowLabels[randStringPrometheus(r)] = randStringPrometheus(r)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v2 := r.Intn(10)
+		this.DenyLabels = make(map[string]string)
+		for i := 0; i < v2; i++ {
+			this.DenyLabels[randStringPrometheus(r)] = randStringPrometheus(r)
+		}
+	}
+	if r.Intn(10) != 0 {
+		v3 := r.Intn(5)
+		this.Relabel = make([]PrometheusRelabel, v3)
+		for i := 0; i < v3; i++ {
+			v4 := NewPopulatedPrometheusRelabel(r, easy)
+			this.Relabel[i] = *v4
+		}
+	}
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+func NewPopulatedPrometheusRelabel(r randyPrometheus, easy bool) *PrometheusRelabel {
+	this := &PrometheusRelabel{}
+	this.Action = string(randStringPrometheus(r))
+	this.SourceLabel = string(randStringPrometheus(r))
+	this.Regex = string(randStringPrometheus(r))
+	this.TargetLabel = string(randStringPrometheus(r))
+	this.Replacement = string(randStringPrometheus(r))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randyPrometheus interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RunePrometheus(r randyPrometheus) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringPrometheus(r randyPrometheus) string {
+	v5 := r.Intn(100)
+	tmps := make([]rune, v5)
+	for i := 0; i < v5; i++ {
+		tmps[i] = randUTF8RunePrometheus(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedPrometheus(r randyPrometheus, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldPrometheus(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldPrometheus(dAtA []byte, r randyPrometheus, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulatePrometheus(dAtA, uint64(key))
+		v6 := r.Int63()
+		if r.Intn(2) == 0 {
+			v6 *= -1
+		}
+		dAtA = encodeVarintPopulatePrometheus(dAtA, uint64(v6))
+	case 1:
+		dAtA = encodeVarintPopulatePrometheus(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulatePrometheus(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulatePrometheus(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulatePrometheus(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulatePrometheus(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *PrometheusScrape) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.URL)
+	if l > 0 {
+		n += 1 + l + sovPrometheus(uint64(l))
+	}
+	if len(m.AllowLabels) > 0 {
+		for k, v := range m.AllowLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPrometheus(uint64(len(k))) + 1 + len(v) + sovPrometheus(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPrometheus(uint64(mapEntrySize))
+		}
+	}
+	if len(m.DenyLabels) > 0 {
+		for k, v := range m.DenyLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovPrometheus(uint64(len(k))) + 1 + len(v) + sovPrometheus(uint64(len(v)))
+			n += mapEntrySize + 1 + sovPrometheus(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Relabel) > 0 {
+		for _, e := range m.Relabel {
+			l = e.Size()
+			n += 1 + l + sovPrometheus(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrometheusRelabel) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovPrometheus(uint64(l))
+	}
+	l = len(m.SourceLabel)
+	if l > 0 {
+		n += 1 + l + sovPrometheus(uint64(l))
+	}
+	l = len(m.Regex)
+	if l > 0 {
+		n += 1 + l + sovPrometheus(uint64(l))
+	}
+	l = len(m.TargetLabel)
+	if l > 0 {
+		n += 1 + l + sovPrometheus(uint64(l))
+	}
+	l = len(m.Replacement)
+	if l > 0 {
+		n += 1 + l + sovPrometheus(uint64(l))
+	}
+	return n
+}
+
+func sovPrometheus(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozPrometheus(x uint64) (n int) {
+	return sovPrometheus(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PrometheusScrape) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrometheus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrometheusScrape: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrometheusScrape: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrometheus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrometheus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrometheus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrometheus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllowLabels == nil {
+				m.AllowLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPrometheus
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPrometheus
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPrometheus
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPrometheus
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPrometheus
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPrometheus(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPrometheus
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AllowLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenyLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrometheus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrometheus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DenyLabels == nil {
+				m.DenyLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPrometheus
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPrometheus
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthPrometheus
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPrometheus
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= (uint64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthPrometheus
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipPrometheus(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthPrometheus
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.DenyLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relabel", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrometheus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPrometheus
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relabel = append(m.Relabel, PrometheusRelabel{})
+			if err := m.Relabel[len(m.Relabel)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrometheus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPrometheus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrometheusRelabel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPrometheus
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrometheusRelabel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrometheusRelabel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrometheus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrometheus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrometheus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrometheus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Regex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrometheus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrometheus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Regex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrometheus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrometheus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replacement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPrometheus
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPrometheus
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replacement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPrometheus(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPrometheus
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPrometheus(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPrometheus
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrometheus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPrometheus
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthPrometheus
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowPrometheus
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipPrometheus(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthPrometheus = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPrometheus   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("prometheus.proto", fileDescriptorPrometheus) }
+
+var fileDescriptorPrometheus = []byte{
+	// 465 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6e, 0xd4, 0x30,
+	0x18, 0x85, 0xeb, 0x09, 0x53, 0xc4, 0x9f, 0x8a, 0xa6, 0xa6, 0xa0, 0x74, 0x24, 0x9c, 0x51, 0xd9,
+	0x14, 0xa9, 0x4d, 0x25, 0xd8, 0x20, 0x50, 0x91, 0x88, 0x60, 0xd7, 0x05, 0x4a, 0xc5, 0x86, 0x4d,
+	0x95, 0x49, 0x7f, 0xd2, 0x11, 0x49, 0x1c, 0x1c, 0x07, 0xc8, 0x4d, 0xb8, 0x01, 0x1c, 0x81, 0x23,
+	0xcc, 0x92, 0x13, 0x44, 0x10, 0x76, 0x39, 0x01, 0x4b, 0x14, 0x3b, 0x33, 0x58, 0x83, 0x40, 0xea,
+	0x66, 0x64, 0x3f, 0xbd, 0xf7, 0x3e, 0xdb, 0xff, 0x04, 0x9c, 0x42, 0xf0, 0x0c, 0xe5, 0x25, 0x56,
+	0xa5, 0x5f, 0x08, 0x2e, 0x39, 0xb5, 0x4b, 0xcc, 0xcb, 0xca, 0x97, 0x75, 0x81, 0xe5, 0xe4, 0x28,
+	0x99, 0xcb, 0xcb, 0x6a, 0xe6, 0xc7, 0x3c, 0x3b, 0x4e, 0x78, 0xc2, 0x8f, 0x95, 0x67, 0x56, 0xbd,
+	0x51, 0x3b, 0xb5, 0x51, 0x2b, 0x9d, 0xdd, 0xef, 0x2c, 0x70, 0x5e, 0xae, 0x0a, 0xcf, 0x62, 0x11,
+	0x15, 0x48, 0xa7, 0x60, 0x55, 0x22, 0x75, 0xc9, 0x94, 0x1c, 0xdc, 0x08, 0x6e, 0xb6, 0x8d, 0x67,
+	0xbd, 0x0a, 0x4f, 0xbb, 0xc6, 0xeb, 0xd5, 0xb0, 0xff, 0xa1, 0xef, 0x60, 0x2b, 0x4a, 0x53, 0xfe,
+	0xe1, 0x3c, 0x8d, 0x66, 0x98, 0x96, 0xee, 0x68, 0x6a, 0x1d, 0xd8, 0x0f, 0x7c, 0xdf, 0x38, 0x89,
+	0xbf, 0x5e, 0xeb, 0x3f, 0xeb, 0x13, 0xa7, 0x2a, 0xf0, 0x22, 0x97, 0xa2, 0x0e, 0xd8, 0xa2, 0xf1,
+	0x36, 0xba, 0xc6, 0xbb, 0x63, 0x76, 0x1d, 0xf2, 0x6c, 0x2e, 0x31, 0x2b, 0x64, 0x1d, 0xda, 0xd1,
+	0x9f, 0x04, 0xcd, 0xc0, 0xbe, 0xc0, 0xbc, 0x5e, 0x12, 0x2d, 0x45, 0x3c, 0xfa, 0x3f, 0xf1, 0x39,
+	0xe6, 0xb5, 0x09, 0xbc, 0x3b, 0x00, 0x6f, 0x1b, 0x4d, 0x06, 0x0f, 0x2e, 0x56, 0x7e, 0x7a, 0x06,
+	0xd7, 0x05, 0x2a, 0x87, 0x7b, 0x4d, 0xa1, 0xd8, 0x3f, 0x50, 0xa1, 0x76, 0x05, 0x7b, 0x43, 0xf7,
+	0xce, 0x10, 0x33, 0x7a, 0x97, 0x4d, 0x93, 0xa7, 0xe0, 0xac, 0x3f, 0x02, 0x75, 0xc0, 0x7a, 0x8b,
+	0xb5, 0x7e, 0xec, 0xb0, 0x5f, 0xd2, 0x5d, 0x18, 0xbf, 0x8f, 0xd2, 0x0a, 0xdd, 0x91, 0xd2, 0xf4,
+	0xe6, 0xf1, 0xe8, 0x11, 0x99, 0x9c, 0xc0, 0xf6, 0xda, 0x95, 0xae, 0x12, 0xdf, 0xff, 0x3c, 0x82,
+	0x9d, 0xbf, 0x0e, 0x4e, 0x0f, 0x61, 0x33, 0x8a, 0xe5, 0x9c, 0xe7, 0xc3, 0xc0, 0x77, 0xbb, 0xc6,
+	0x73, 0xb4, 0x62, 0xdc, 0x61, 0xf0, 0xd0, 0x13, 0xd8, 0x2a, 0x79, 0x25, 0x62, 0xd4, 0xcf, 0xa7,
+	0x21, 0xc1, 0xa4, 0x9f, 0xa2, 0xa9, 0x9b, 0x53, 0xd4, 0xba, 0x3a, 0x34, 0xbd, 0x0f, 0x63, 0x81,
+	0x09, 0x7e, 0x74, 0x2d, 0x95, 0xbb, 0xd5, 0x35, 0xde, 0xb6, 0x12, 0x8c, 0x80, 0x76, 0xf4, 0x24,
+	0x19, 0x89, 0x04, 0xe5, 0xf9, 0x72, 0x0c, 0x2b, 0x92, 0xa9, 0x9b, 0x24, 0xad, 0x6b, 0xd2, 0x13,
+	0xb0, 0x05, 0x16, 0x69, 0x14, 0x63, 0x86, 0xb9, 0x74, 0xc7, 0x2a, 0xbd, 0xd7, 0x0f, 0xdf, 0x90,
+	0xcd, 0xb0, 0x21, 0x07, 0xf7, 0x7e, 0xfd, 0x60, 0xe4, 0x4b, 0xcb, 0xc8, 0xd7, 0x96, 0x91, 0x45,
+	0xcb, 0xc8, 0xb7, 0x96, 0x91, 0xef, 0x2d, 0x23, 0x9f, 0x7e, 0xb2, 0x8d, 0xd7, 0x63, 0xf5, 0x1f,
+	0x98, 0x6d, 0xaa, 0x4f, 0xe8, 0xe1, 0xef, 0x01, 0x00, 0x9f, 0xf9, 0x90, 0xa5, 0x92, 0x03, 0x00,
+	0x00,
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrometheusScrapeValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		scrape  PrometheusScrape
+		wantErr bool
+	}{
+		{
+			name:   "valid",
+			scrape: PrometheusScrape{URL: "http://localhost:9100/metrics"},
+		},
+		{
+			name:    "missing URL",
+			scrape:  PrometheusScrape{},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported scheme",
+			scrape:  PrometheusScrape{URL: "file:///metrics"},
+			wantErr: true,
+		},
+		{
+			name: "invalid filter",
+			scrape: PrometheusScrape{
+				URL:         "http://localhost:9100/metrics",
+				AllowLabels: map[string]string{"job": "("},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid relabeling",
+			scrape: PrometheusScrape{
+				URL: "http://localhost:9100/metrics",
+				Relabel: []PrometheusRelabel{
+					{SourceLabel: "instance", Regex: "(.*):.*", TargetLabel: "host"},
+					{Action: PrometheusRelabelLabelDrop, Regex: "instance"},
+				},
+			},
+		},
+		{
+			name: "replace without source label",
+			scrape: PrometheusScrape{
+				URL:     "http://localhost:9100/metrics",
+				Relabel: []PrometheusRelabel{{TargetLabel: "host"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown action",
+			scrape: PrometheusScrape{
+				URL:     "http://localhost:9100/metrics",
+				Relabel: []PrometheusRelabel{{Action: "keep", SourceLabel: "job"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.scrape.Validate()
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckConfigPrometheus(t *testing.T) {
+	c := FixtureCheckConfig("check")
+	c.Prometheus = &PrometheusScrape{URL: "http://localhost:9100/metrics"}
+	assert.Error(t, c.Validate())
+
+	c.Command = ""
+	assert.NoError(t, c.Validate())
+}
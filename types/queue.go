@@ -12,6 +12,14 @@ type Queue interface {
 	// Dequeue gets an Item from the queue. It returns the Item and any
 	// error encountered, or if the context is cancelled.
 	Dequeue(ctx context.Context) (QueueItem, error)
+
+	// List returns the values of the items waiting in the queue, in order,
+	// without dequeuing them.
+	List(ctx context.Context) ([]string, error)
+
+	// Remove deletes the first item waiting in the queue with the given
+	// value, without dequeuing it. It returns false if there is no such item.
+	Remove(ctx context.Context, value string) (bool, error)
 }
 
 // QueueItem represents an item retrieved from a Queue.
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"net/url"
 )
 
 const (
@@ -27,12 +28,18 @@ const (
 	// RuleTypeCheck access control for check objects
 	RuleTypeCheck = "checks"
 
+	// RuleTypeDeadLetter access control for dead letter objects
+	RuleTypeDeadLetter = "deadletters"
+
 	// RuleTypeEntity access control for entity objects
 	RuleTypeEntity = "entities"
 
 	// RuleTypeEnvironment access control for organization objects
 	RuleTypeEnvironment = "environments"
 
+	// RuleTypeError access control for error objects
+	RuleTypeError = "errors"
+
 	// RuleTypeEvent access control for event objects
 	RuleTypeEvent = "events"
 
@@ -117,6 +124,11 @@ func (r *Rule) Validate() error {
 	return nil
 }
 
+// URIPath returns the path component of a Role URI.
+func (r *Role) URIPath() string {
+	return fmt.Sprintf("/rbac/roles/%s", url.PathEscape(r.Name))
+}
+
 // Validate returns an error if the role is invalid.
 func (r *Role) Validate() error {
 	if err := ValidateNameStrict(r.Name); err != nil {
@@ -0,0 +1,670 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: sandbox.proto
+
+package types
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+
+import io "io"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// A Sandbox restricts the execution of a check command.
+type Sandbox struct {
+	// User is the name or ID of the Unix user the command runs as.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Group is the name or ID of the Unix group the command runs as, the
+	// primary group of the user by default.
+	Group string `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+	// CPUTime is the maximum CPU time, in seconds, of the command.
+	CPUTime uint64 `protobuf:"varint,3,opt,name=cpu_time,json=cpuTime,proto3" json:"cpu_time,omitempty"`
+	// Memory is the maximum size, in bytes, of the command virtual memory.
+	Memory uint64 `protobuf:"varint,4,opt,name=memory,proto3" json:"memory,omitempty"`
+	// OpenFiles is the maximum number of files the command can open.
+	OpenFiles uint64 `protobuf:"varint,5,opt,name=open_files,json=openFiles,proto3" json:"open_files,omitempty"`
+	// Processes is the maximum number of processes of the user running the
+	// command.
+	Processes uint64 `protobuf:"varint,6,opt,name=processes,proto3" json:"processes,omitempty"`
+	// MaxOutputSize is the maximum size, in bytes, of the command output, which
+	// is truncated beyond it.
+	MaxOutputSize uint64 `protobuf:"varint,7,opt,name=max_output_size,json=maxOutputSize,proto3" json:"max_output_size,omitempty"`
+}
+
+func (m *Sandbox) Reset()                    { *m = Sandbox{} }
+func (m *Sandbox) String() string            { return proto.CompactTextString(m) }
+func (*Sandbox) ProtoMessage()               {}
+func (*Sandbox) Descriptor() ([]byte, []int) { return fileDescriptorSandbox, []int{0} }
+
+func (m *Sandbox) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *Sandbox) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *Sandbox) GetCPUTime() uint64 {
+	if m != nil {
+		return m.CPUTime
+	}
+	return 0
+}
+
+func (m *Sandbox) GetMemory() uint64 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *Sandbox) GetOpenFiles() uint64 {
+	if m != nil {
+		return m.OpenFiles
+	}
+	return 0
+}
+
+func (m *Sandbox) GetProcesses() uint64 {
+	if m != nil {
+		return m.Processes
+	}
+	return 0
+}
+
+func (m *Sandbox) GetMaxOutputSize() uint64 {
+	if m != nil {
+		return m.MaxOutputSize
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Sandbox)(nil), "sensu.types.Sandbox")
+}
+func (this *Sandbox) Equal(that interface{}) bool {
+	if that == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	}
+
+	that1, ok := that.(*Sandbox)
+	if !ok {
+		that2, ok := that.(Sandbox)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		if this == nil {
+			return true
+		}
+		return false
+	} else if this == nil {
+		return false
+	}
+	if this.User != that1.User {
+		return false
+	}
+	if this.Group != that1.Group {
+		return false
+	}
+	if this.CPUTime != that1.CPUTime {
+		return false
+	}
+	if this.Memory != that1.Memory {
+		return false
+	}
+	if this.OpenFiles != that1.OpenFiles {
+		return false
+	}
+	if this.Processes != that1.Processes {
+		return false
+	}
+	if this.MaxOutputSize != that1.MaxOutputSize {
+		return false
+	}
+	return true
+}
+func (m *Sandbox) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sandbox) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintSandbox(dAtA, i, uint64(len(m.User)))
+		i += copy(dAtA[i:], m.User)
+	}
+	if len(m.Group) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintSandbox(dAtA, i, uint64(len(m.Group)))
+		i += copy(dAtA[i:], m.Group)
+	}
+	if m.CPUTime != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintSandbox(dAtA, i, uint64(m.CPUTime))
+	}
+	if m.Memory != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintSandbox(dAtA, i, uint64(m.Memory))
+	}
+	if m.OpenFiles != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintSandbox(dAtA, i, uint64(m.OpenFiles))
+	}
+	if m.Processes != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintSandbox(dAtA, i, uint64(m.Processes))
+	}
+	if m.MaxOutputSize != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintSandbox(dAtA, i, uint64(m.MaxOutputSize))
+	}
+	return i, nil
+}
+
+func encodeVarintSandbox(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func NewPopulatedSandbox(r randySandbox, easy bool) *Sandbox {
+	this := &Sandbox{}
+	this.User = string(randStringSandbox(r))
+	this.Group = string(randStringSandbox(r))
+	this.CPUTime = uint64(uint64(r.Uint32()))
+	this.Memory = uint64(uint64(r.Uint32()))
+	this.OpenFiles = uint64(uint64(r.Uint32()))
+	this.Processes = uint64(uint64(r.Uint32()))
+	this.MaxOutputSize = uint64(uint64(r.Uint32()))
+	if !easy && r.Intn(10) != 0 {
+	}
+	return this
+}
+
+type randySandbox interface {
+	Float32() float32
+	Float64() float64
+	Int63() int64
+	Int31() int32
+	Uint32() uint32
+	Intn(n int) int
+}
+
+func randUTF8RuneSandbox(r randySandbox) rune {
+	ru := r.Intn(62)
+	if ru < 10 {
+		return rune(ru + 48)
+	} else if ru < 36 {
+		return rune(ru + 55)
+	}
+	return rune(ru + 61)
+}
+func randStringSandbox(r randySandbox) string {
+	v1 := r.Intn(100)
+	tmps := make([]rune, v1)
+	for i := 0; i < v1; i++ {
+		tmps[i] = randUTF8RuneSandbox(r)
+	}
+	return string(tmps)
+}
+func randUnrecognizedSandbox(r randySandbox, maxFieldNumber int) (dAtA []byte) {
+	l := r.Intn(5)
+	for i := 0; i < l; i++ {
+		wire := r.Intn(4)
+		if wire == 3 {
+			wire = 5
+		}
+		fieldNumber := maxFieldNumber + r.Intn(100)
+		dAtA = randFieldSandbox(dAtA, r, fieldNumber, wire)
+	}
+	return dAtA
+}
+func randFieldSandbox(dAtA []byte, r randySandbox, fieldNumber int, wire int) []byte {
+	key := uint32(fieldNumber)<<3 | uint32(wire)
+	switch wire {
+	case 0:
+		dAtA = encodeVarintPopulateSandbox(dAtA, uint64(key))
+		v2 := r.Int63()
+		if r.Intn(2) == 0 {
+			v2 *= -1
+		}
+		dAtA = encodeVarintPopulateSandbox(dAtA, uint64(v2))
+	case 1:
+		dAtA = encodeVarintPopulateSandbox(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	case 2:
+		dAtA = encodeVarintPopulateSandbox(dAtA, uint64(key))
+		ll := r.Intn(100)
+		dAtA = encodeVarintPopulateSandbox(dAtA, uint64(ll))
+		for j := 0; j < ll; j++ {
+			dAtA = append(dAtA, byte(r.Intn(256)))
+		}
+	default:
+		dAtA = encodeVarintPopulateSandbox(dAtA, uint64(key))
+		dAtA = append(dAtA, byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)), byte(r.Intn(256)))
+	}
+	return dAtA
+}
+func encodeVarintPopulateSandbox(dAtA []byte, v uint64) []byte {
+	for v >= 1<<7 {
+		dAtA = append(dAtA, uint8(uint64(v)&0x7f|0x80))
+		v >>= 7
+	}
+	dAtA = append(dAtA, uint8(v))
+	return dAtA
+}
+func (m *Sandbox) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovSandbox(uint64(l))
+	}
+	l = len(m.Group)
+	if l > 0 {
+		n += 1 + l + sovSandbox(uint64(l))
+	}
+	if m.CPUTime != 0 {
+		n += 1 + sovSandbox(uint64(m.CPUTime))
+	}
+	if m.Memory != 0 {
+		n += 1 + sovSandbox(uint64(m.Memory))
+	}
+	if m.OpenFiles != 0 {
+		n += 1 + sovSandbox(uint64(m.OpenFiles))
+	}
+	if m.Processes != 0 {
+		n += 1 + sovSandbox(uint64(m.Processes))
+	}
+	if m.MaxOutputSize != 0 {
+		n += 1 + sovSandbox(uint64(m.MaxOutputSize))
+	}
+	return n
+}
+
+func sovSandbox(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozSandbox(x uint64) (n int) {
+	return sovSandbox(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Sandbox) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSandbox
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sandbox: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sandbox: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSandbox
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSandbox
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSandbox
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSandbox
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Group = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CPUTime", wireType)
+			}
+			m.CPUTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSandbox
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CPUTime |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+			}
+			m.Memory = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSandbox
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Memory |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenFiles", wireType)
+			}
+			m.OpenFiles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSandbox
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenFiles |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Processes", wireType)
+			}
+			m.Processes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSandbox
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Processes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutputSize", wireType)
+			}
+			m.MaxOutputSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSandbox
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOutputSize |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSandbox(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthSandbox
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSandbox(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSandbox
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSandbox
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+			return iNdEx, nil
+		case 1:
+			iNdEx += 8
+			return iNdEx, nil
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSandbox
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			iNdEx += length
+			if length < 0 {
+				return 0, ErrInvalidLengthSandbox
+			}
+			return iNdEx, nil
+		case 3:
+			for {
+				var innerWire uint64
+				var start int = iNdEx
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return 0, ErrIntOverflowSandbox
+					}
+					if iNdEx >= l {
+						return 0, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					innerWire |= (uint64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				innerWireType := int(innerWire & 0x7)
+				if innerWireType == 4 {
+					break
+				}
+				next, err := skipSandbox(dAtA[start:])
+				if err != nil {
+					return 0, err
+				}
+				iNdEx = start + next
+			}
+			return iNdEx, nil
+		case 4:
+			return iNdEx, nil
+		case 5:
+			iNdEx += 4
+			return iNdEx, nil
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+	}
+	panic("unreachable")
+}
+
+var (
+	ErrInvalidLengthSandbox = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSandbox   = fmt.Errorf("proto: integer overflow")
+)
+
+func init() { proto.RegisterFile("sandbox.proto", fileDescriptorSandbox) }
+
+var fileDescriptorSandbox = []byte{
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x41, 0x4a, 0x33, 0x31,
+	0x1c, 0xc5, 0xbf, 0xf4, 0x6b, 0x3b, 0x36, 0x52, 0x2b, 0x69, 0xc1, 0x28, 0x38, 0xa9, 0x0a, 0x52,
+	0xa1, 0xb6, 0x0b, 0x11, 0x37, 0xae, 0x2a, 0xba, 0x55, 0xac, 0x6e, 0xdc, 0x0c, 0x9d, 0x31, 0x1d,
+	0x07, 0x4c, 0x13, 0x26, 0x09, 0xb4, 0x3d, 0x89, 0x47, 0xf0, 0x08, 0x1e, 0xc1, 0xa5, 0x27, 0x08,
+	0x3a, 0xee, 0xe6, 0x04, 0xee, 0x94, 0xc9, 0x68, 0x1d, 0xdc, 0xe5, 0xfd, 0xde, 0xfb, 0x65, 0xf3,
+	0x87, 0x75, 0x39, 0x9a, 0xdc, 0xfa, 0x7c, 0xda, 0x13, 0x31, 0x57, 0x1c, 0x2d, 0x4b, 0x3a, 0x91,
+	0xba, 0xa7, 0x66, 0x82, 0xca, 0x8d, 0xfd, 0x30, 0x52, 0x77, 0xda, 0xef, 0x05, 0x9c, 0xf5, 0x43,
+	0x1e, 0xf2, 0xbe, 0xdd, 0xf8, 0x7a, 0x6c, 0x93, 0x0d, 0xf6, 0x95, 0xbb, 0xdb, 0x9f, 0x25, 0xe8,
+	0x0c, 0xf3, 0xdf, 0xd0, 0x2e, 0x2c, 0x6b, 0x49, 0x63, 0x0c, 0xda, 0xa0, 0x53, 0x1b, 0xa0, 0xd4,
+	0x90, 0x95, 0x2c, 0x77, 0x39, 0x8b, 0x14, 0x65, 0x42, 0xcd, 0x2e, 0x6d, 0x8f, 0xf6, 0x60, 0x25,
+	0x8c, 0xb9, 0x16, 0xb8, 0x64, 0x87, 0xcd, 0xd4, 0x90, 0x86, 0x05, 0x85, 0x65, 0xbe, 0x40, 0xc7,
+	0x70, 0x29, 0x10, 0xda, 0x53, 0x11, 0xa3, 0xf8, 0x7f, 0x1b, 0x74, 0xca, 0x83, 0xad, 0xc4, 0x10,
+	0xe7, 0xe4, 0xe2, 0xfa, 0x2a, 0x62, 0x34, 0x35, 0x04, 0xfd, 0xd4, 0x05, 0xd7, 0x09, 0x84, 0xce,
+	0x6a, 0xd4, 0x85, 0x55, 0x46, 0x19, 0x8f, 0x67, 0xb8, 0x6c, 0xdd, 0x56, 0x6a, 0xc8, 0x6a, 0x4e,
+	0x0a, 0xf3, 0xef, 0x0d, 0x3a, 0x82, 0x90, 0x0b, 0x3a, 0xf1, 0xc6, 0xd1, 0x3d, 0x95, 0xb8, 0x62,
+	0x0d, 0x9c, 0x1a, 0xd2, 0xfa, 0xa5, 0x05, 0xab, 0x96, 0xd1, 0xb3, 0x0c, 0xa2, 0x43, 0x58, 0x13,
+	0x31, 0x0f, 0xa8, 0x94, 0x54, 0xe2, 0xaa, 0xf5, 0xd6, 0x52, 0x43, 0x9a, 0x0b, 0x58, 0xd4, 0x16,
+	0x10, 0x9d, 0xc2, 0x06, 0x1b, 0x4d, 0x3d, 0xae, 0x95, 0xd0, 0xca, 0x93, 0xd1, 0x9c, 0x62, 0xc7,
+	0xca, 0x9b, 0xa9, 0x21, 0xeb, 0x7f, 0xaa, 0xc2, 0x17, 0x75, 0x36, 0x9a, 0x9e, 0xdb, 0x66, 0x18,
+	0xcd, 0xe9, 0x60, 0xe7, 0xe3, 0xcd, 0x05, 0x8f, 0x89, 0x0b, 0x9e, 0x12, 0x17, 0x3c, 0x27, 0x2e,
+	0x78, 0x49, 0x5c, 0xf0, 0x9a, 0xb8, 0xe0, 0xe1, 0xdd, 0xfd, 0x77, 0x53, 0xb1, 0x57, 0xf5, 0xab,
+	0xf6, 0x5a, 0x07, 0x5f, 0x03, 0x00, 0x99, 0x37, 0x58, 0x04, 0xfa, 0x01, 0x00, 0x00,
+}
@@ -2,7 +2,10 @@ package types
 
 // automatically generated file, do not edit!
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+)
 
 // typeMap is used to dynamically look up data types from strings.
 var typeMap = map[string]interface{}{
@@ -22,6 +25,8 @@ var typeMap = map[string]interface{}{
 	"check_request":          &CheckRequest{},
 	"Claims":                 &Claims{},
 	"claims":                 &Claims{},
+	"DeadLetter":             &DeadLetter{},
+	"dead_letter":            &DeadLetter{},
 	"Deregistration":         &Deregistration{},
 	"deregistration":         &Deregistration{},
 	"Entity":                 &Entity{},
@@ -36,6 +41,12 @@ var typeMap = map[string]interface{}{
 	"event_filter":           &EventFilter{},
 	"Handler":                &Handler{},
 	"handler":                &Handler{},
+	"HandlerConcurrency":     &HandlerConcurrency{},
+	"handler_concurrency":    &HandlerConcurrency{},
+	"HandlerHTTP":            &HandlerHTTP{},
+	"handler_h_t_t_p":        &HandlerHTTP{},
+	"HandlerRetryPolicy":     &HandlerRetryPolicy{},
+	"handler_retry_policy":   &HandlerRetryPolicy{},
 	"HandlerSocket":          &HandlerSocket{},
 	"handler_socket":         &HandlerSocket{},
 	"Hook":                   &Hook{},
@@ -60,12 +71,18 @@ var typeMap = map[string]interface{}{
 	"network_interface":      &NetworkInterface{},
 	"Organization":           &Organization{},
 	"organization":           &Organization{},
+	"PrometheusRelabel":      &PrometheusRelabel{},
+	"prometheus_relabel":     &PrometheusRelabel{},
+	"PrometheusScrape":       &PrometheusScrape{},
+	"prometheus_scrape":      &PrometheusScrape{},
 	"ProxyRequests":          &ProxyRequests{},
 	"proxy_requests":         &ProxyRequests{},
 	"Role":                   &Role{},
 	"role":                   &Role{},
 	"Rule":                   &Rule{},
 	"rule":                   &Rule{},
+	"Sandbox":                &Sandbox{},
+	"sandbox":                &Sandbox{},
 	"Silenced":               &Silenced{},
 	"silenced":               &Silenced{},
 	"System":                 &System{},
@@ -86,7 +103,7 @@ var typeMap = map[string]interface{}{
 	"wrapper":                &Wrapper{},
 }
 
-// ResolveResource returns a zero-valued resource, given a name.
+// ResolveResource returns a new zero-valued resource, given a name.
 // If the named type does not exist, or if the type is not a Resource,
 // then an error will be returned.
 func ResolveResource(name string) (Resource, error) {
@@ -94,7 +111,9 @@ func ResolveResource(name string) (Resource, error) {
 	if !ok {
 		return nil, fmt.Errorf("type could not be found: %q", name)
 	}
-	r, ok := t.(Resource)
+	// Return a new instance, so the resources resolved by the callers are
+	// never shared
+	r, ok := reflect.New(reflect.TypeOf(t).Elem()).Interface().(Resource)
 	if !ok {
 		return nil, fmt.Errorf("%q is not a Resource", name)
 	}
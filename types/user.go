@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	fmt "fmt"
+	"net/url"
 )
 
 // FixtureUser returns a testing fixture for an Entity object.
@@ -14,6 +15,11 @@ func FixtureUser(username string) *User {
 	}
 }
 
+// URIPath returns the path component of a User URI.
+func (u *User) URIPath() string {
+	return fmt.Sprintf("/rbac/users/%s", url.PathEscape(u.Username))
+}
+
 // Validate returns an error if the entity is invalid.
 func (u *User) Validate() error {
 	if err := ValidateNameStrict(u.Username); err != nil {
@@ -30,6 +30,8 @@ func validateHandlerType(t string) error {
 	switch t {
 	case
 		"pipe",
+		"grpc",
+		"http",
 		"tcp",
 		"udp",
 		"transport",
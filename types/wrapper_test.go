@@ -0,0 +1,35 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWrapperUnmarshalJSON(t *testing.T) {
+	data := `[
+		{"type": "Organization", "spec": {"name": "a"}},
+		{"type": "Organization", "spec": {"name": "b"}}
+	]`
+
+	var wrappers []Wrapper
+	require.NoError(t, json.Unmarshal([]byte(data), &wrappers))
+	require.Len(t, wrappers, 2)
+	assert.Equal(t, "a", wrappers[0].Value.(*Organization).Name)
+	assert.Equal(t, "b", wrappers[1].Value.(*Organization).Name)
+}
+
+func TestResolveResource(t *testing.T) {
+	first, err := ResolveResource("Organization")
+	require.NoError(t, err)
+	second, err := ResolveResource("organization")
+	require.NoError(t, err)
+	assert.False(t, first == second)
+
+	_, err = ResolveResource("Claims")
+	assert.Error(t, err)
+	_, err = ResolveResource("Unknown")
+	assert.Error(t, err)
+}
@@ -59,6 +59,11 @@ func (b *ExponentialBackoff) Retry(fn Func) error {
 			// Add a jitter (randomized delay) for the next attempt, to prevent
 			// potential collisions
 			wait = wait + time.Duration(rand.Float64()*float64(wait))
+
+			// Never wait longer than the maximal delay interval
+			if b.MaxDelayInterval != 0 && wait > b.MaxDelayInterval {
+				wait = b.MaxDelayInterval
+			}
 		} else {
 			// Save the current time, in order to measure the total execution time
 			b.start = time.Now()
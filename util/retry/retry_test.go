@@ -60,3 +60,18 @@ func TestExponentialBackoff(t *testing.T) {
 	sleepFn := mockBackoffFuncSleep()
 	assert.Equal(t, ErrMaxElapsedTime, b.Retry(sleepFn))
 }
+
+func TestExponentialBackoffMaxDelayInterval(t *testing.T) {
+	b := ExponentialBackoff{
+		InitialDelayInterval: 10 * time.Millisecond,
+		MaxDelayInterval:     10 * time.Millisecond,
+		MaxRetryAttempts:     6,
+		Multiplier:           10,
+	}
+
+	// Without a maximal delay interval, the last attempts would wait for
+	// several seconds
+	start := time.Now()
+	assert.NoError(t, b.Retry(mockBackoffFunc(6)))
+	assert.True(t, time.Since(start) < time.Second)
+}
@@ -1,4 +1,5 @@
-package schedulerd
+// Package schedule provides the timers used to schedule check executions.
+package schedule
 
 import (
 	"crypto/md5"
@@ -1,4 +1,4 @@
-package schedulerd
+package schedule
 
 import (
 	"testing"
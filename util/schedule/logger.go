@@ -0,0 +1,7 @@
+package schedule
+
+import "github.com/Sirupsen/logrus"
+
+var logger = logrus.WithFields(logrus.Fields{
+	"component": "schedule",
+})